@@ -1,35 +1,32 @@
 # --------------------------------------------------
-# Provison the service using reosource_instance
+# Provision and initialize the HPCS instance
 # --------------------------------------------------
 
-resource "ibm_resource_instance" "hpcs_instance" {
-  count    = (var.provision_instance == true ? 1 : 0)
-  name     = var.hpcs_instance_name
-  service  = "hs-crypto"
-  plan     = var.plan
-  location = var.location
-  parameters = {
-    units = var.units
+resource "ibm_hpcs" "hpcs_instance" {
+  name                 = var.hpcs_instance_name
+  location             = var.location
+  plan                 = var.plan
+  units                = var.units
+  signature_threshold  = var.signature_threshold
+  revocation_threshold = var.revocation_threshold
+
+  dynamic "admins" {
+    for_each = var.admins
+    content {
+      name  = admins.value.name
+      key   = admins.value.key
+      token = admins.value.token
+    }
   }
+  master_key_parts = var.master_key_parts
 }
-# data source of the hpcs instance
-data "ibm_resource_instance" "hpcs_instance" {
-  name     = (var.provision_instance == true ? ibm_resource_instance.hpcs_instance.0.name : var.hpcs_instance_name)
-  service  = "hs-crypto"
-  location = var.location
-}
-
-# ------------------------------------------------------------------------------------------------------------
-# #Intialization of the hpcs crypto service may be use some scripts and null_resource to intialize the service
-# -------------------------------------------------------------------------------------------------------------
 
 # --------------------------------
-# Cresting Keys for HPCS Instance
+# Creating Keys for HPCS Instance
 # --------------------------------
 
 resource "ibm_kms_key" "key" {
-  depends_on   = [null_resource.hpcs_init]
-  instance_id  = data.ibm_resource_instance.hpcs_instance.guid
+  instance_id  = ibm_hpcs.hpcs_instance.guid
   key_name     = var.key_name
   standard_key = false
   force_delete = true
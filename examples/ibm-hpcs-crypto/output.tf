@@ -1,6 +1,6 @@
 output "InstanceGUID" {
-  value = data.ibm_resource_instance.hpcs_instance.guid
+  value = ibm_hpcs.hpcs_instance.guid
 }
 output "keyID" {
   value = ibm_kms_key.key.id
-}
\ No newline at end of file
+}
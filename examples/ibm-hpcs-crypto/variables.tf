@@ -1,12 +1,5 @@
 # HPCS Instance Inputs
 
-# Enable `provision_instance` to true to create hpcs instance
-variable "provision_instance" {
-  type        = bool
-  default     = false
-  description = "Determines if the instance has to be created or not"
-}
-
 variable "hpcs_instance_name" {
   type        = string
   description = "Name of HPCS Instance"
@@ -26,6 +19,30 @@ variable "units" {
   description = "No of crypto units that has to be attached to the instance."
 }
 
+# HPCS Initialization Inputs
+
+variable "signature_threshold" {
+  default     = 1
+  type        = number
+  description = "Number of administrator signatures required to execute administrative commands"
+}
+variable "revocation_threshold" {
+  default     = 1
+  type        = number
+  description = "Number of administrator signatures required to remove an administrator"
+}
+variable "admins" {
+  type = list(object({
+    name  = string
+    key   = string
+    token = string
+  }))
+  description = "Administrators of the crypto units with the paths of their signature key files and the passwords of the files"
+}
+variable "master_key_parts" {
+  type        = list(string)
+  description = "Paths of the files of the base64 encoded master key parts"
+}
 
 # Key name that has to be created on the HPCS Instance
 variable "key_name" {
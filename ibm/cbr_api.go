@@ -0,0 +1,137 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v4/core"
+)
+
+// cbrZone is a network zone of the context based restrictions API
+type cbrZone struct {
+	ID               string       `json:"id,omitempty"`
+	CRN              string       `json:"crn,omitempty"`
+	Name             string       `json:"name"`
+	AccountID        string       `json:"account_id"`
+	Description      string       `json:"description,omitempty"`
+	Addresses        []cbrAddress `json:"addresses"`
+	Excluded         []cbrAddress `json:"excluded,omitempty"`
+	AddressCount     int          `json:"address_count,omitempty"`
+	ExcludedCount    int          `json:"excluded_count,omitempty"`
+	Href             string       `json:"href,omitempty"`
+	CreatedAt        string       `json:"created_at,omitempty"`
+	CreatedByID      string       `json:"created_by_id,omitempty"`
+	LastModifiedAt   string       `json:"last_modified_at,omitempty"`
+	LastModifiedByID string       `json:"last_modified_by_id,omitempty"`
+}
+
+// cbrAddress is an address of a network zone, the value of ipAddress,
+// ipRange, subnet and vpc addresses or the ref of serviceRef addresses
+type cbrAddress struct {
+	Type  string         `json:"type"`
+	Value string         `json:"value,omitempty"`
+	Ref   *cbrServiceRef `json:"ref,omitempty"`
+}
+
+type cbrServiceRef struct {
+	AccountID       string `json:"account_id"`
+	ServiceType     string `json:"service_type,omitempty"`
+	ServiceName     string `json:"service_name,omitempty"`
+	ServiceInstance string `json:"service_instance,omitempty"`
+	Location        string `json:"location,omitempty"`
+}
+
+// cbrRule is a rule of the context based restrictions API, the contexts of
+// the rule are allowed to access the resources of the rule
+type cbrRule struct {
+	ID               string            `json:"id,omitempty"`
+	CRN              string            `json:"crn,omitempty"`
+	Description      string            `json:"description,omitempty"`
+	Contexts         []cbrRuleContext  `json:"contexts"`
+	Resources        []cbrRuleResource `json:"resources"`
+	EnforcementMode  string            `json:"enforcement_mode,omitempty"`
+	Href             string            `json:"href,omitempty"`
+	CreatedAt        string            `json:"created_at,omitempty"`
+	CreatedByID      string            `json:"created_by_id,omitempty"`
+	LastModifiedAt   string            `json:"last_modified_at,omitempty"`
+	LastModifiedByID string            `json:"last_modified_by_id,omitempty"`
+}
+
+type cbrRuleContext struct {
+	Attributes []cbrAttribute `json:"attributes"`
+}
+
+type cbrRuleResource struct {
+	Attributes []cbrAttribute `json:"attributes"`
+	Tags       []cbrAttribute `json:"tags,omitempty"`
+}
+
+type cbrAttribute struct {
+	Name     string `json:"name"`
+	Value    string `json:"value"`
+	Operator string `json:"operator,omitempty"`
+}
+
+// cbrRequest sends a request to the context based restrictions API, the
+// result is unmarshalled into result if it's not nil
+func cbrRequest(ctx context.Context, cbrClient *core.BaseService, method, path string, pathParams map[string]string, ifMatch string, body, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(ctx)
+	_, err := builder.ResolveRequestURL(cbrClient.GetServiceURL(), path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if ifMatch != "" {
+		builder.AddHeader("If-Match", ifMatch)
+	}
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return cbrClient.Request(request, result)
+}
+
+// validateCbrAddress validates the value of an address of a network zone,
+// the IP addresses and subnets are validated with validateIPorCIDR and the
+// ranges as two IP addresses separated by a dash
+func validateCbrAddress(addressType, value, k string) error {
+	var values []string
+	switch addressType {
+	case "ipAddress", "subnet":
+		values = []string{value}
+	case "ipRange":
+		values = strings.Split(value, "-")
+		if len(values) != 2 {
+			return fmt.Errorf("%q must be a range of two ip addresses separated by a dash, got %s", k, value)
+		}
+	default:
+		return nil
+	}
+	for _, v := range values {
+		if _, errs := validateIPorCIDR()(v, k); len(errs) > 0 {
+			return errs[0]
+		}
+	}
+	if addressType == "ipAddress" && strings.Contains(value, "/") {
+		return fmt.Errorf("%q must be an ip address, use the subnet type for %s", k, value)
+	}
+	if addressType == "subnet" && !strings.Contains(value, "/") {
+		return fmt.Errorf("%q must be a cidr, use the ipAddress type for %s", k, value)
+	}
+	if addressType == "ipRange" && (strings.Contains(values[0], "/") || strings.Contains(values[1], "/")) {
+		return fmt.Errorf("%q must be a range of ip addresses, got %s", k, value)
+	}
+	return nil
+}
@@ -19,6 +19,7 @@ import (
 	apigateway "github.com/IBM/apigateway-go-sdk"
 	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
 	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+	"github.com/IBM/container-registry-go-sdk/vulnerabilityadvisorv3"
 	"github.com/IBM/go-sdk-core/v4/core"
 	cosconfig "github.com/IBM/ibm-cos-sdk-go-config/resourceconfigurationv1"
 	kp "github.com/IBM/keyprotect-go-client"
@@ -187,6 +188,7 @@ type ClientSession interface {
 	ContainerAPI() (containerv1.ContainerServiceAPI, error)
 	VpcContainerAPI() (containerv2.ContainerServiceAPI, error)
 	ContainerRegistryV1() (*containerregistryv1.ContainerRegistryV1, error)
+	VulnerabilityAdvisorV3() (*vulnerabilityadvisorv3.VulnerabilityAdvisorV3, error)
 	CisAPI() (cisv1.CisServiceAPI, error)
 	FunctionClient() (*whisk.Client, error)
 	GlobalSearchAPI() (globalsearchv2.GlobalSearchServiceAPI, error)
@@ -242,6 +244,7 @@ type ClientSession interface {
 	CisRangeAppClientSession() (*cisrangeappv1.RangeApplicationsV1, error)
 	CisWAFRuleClientSession() (*ciswafrulev1.WafRulesApiV1, error)
 	IAMIdentityV1API() (*iamidentity.IamIdentityV1, error)
+	CbrV1API() (*core.BaseService, error)
 	ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error)
 	CatalogManagementV1() (*catalogmanagementv1.CatalogManagementV1, error)
 	EnterpriseManagementV1() (*enterprisemanagementv1.EnterpriseManagementV1, error)
@@ -275,6 +278,9 @@ type clientSession struct {
 	containerRegistryClientErr error
 	containerRegistryClient    *containerregistryv1.ContainerRegistryV1
 
+	vulnerabilityAdvisorClientErr error
+	vulnerabilityAdvisorClient    *vulnerabilityadvisorv3.VulnerabilityAdvisorV3
+
 	certManagementErr error
 	certManagementAPI certificatemanager.CertificateManagerServiceAPI
 
@@ -455,6 +461,10 @@ type clientSession struct {
 	iamIdentityErr error
 	iamIdentityAPI *iamidentity.IamIdentityV1
 
+	//Context Based Restrictions Option
+	cbrErr error
+	cbrAPI *core.BaseService
+
 	//Resource Manager Option
 	resourceManagerErr error
 	resourceManagerAPI *resourcemanager.ResourceManagerV2
@@ -524,6 +534,11 @@ func (session clientSession) ContainerRegistryV1() (*containerregistryv1.Contain
 	return session.containerRegistryClient, session.containerRegistryClientErr
 }
 
+// VulnerabilityAdvisorV3 provides Vulnerability Advisor Service APIs ...
+func (session clientSession) VulnerabilityAdvisorV3() (*vulnerabilityadvisorv3.VulnerabilityAdvisorV3, error) {
+	return session.vulnerabilityAdvisorClient, session.vulnerabilityAdvisorClientErr
+}
+
 // SchematicsAPI provides schematics Service APIs ...
 func (sess clientSession) SchematicsV1() (*schematicsv1.SchematicsV1, error) {
 	return sess.schematicsClient, sess.schematicsClientErr
@@ -861,6 +876,11 @@ func (sess clientSession) IAMIdentityV1API() (*iamidentity.IamIdentityV1, error)
 	return sess.iamIdentityAPI, sess.iamIdentityErr
 }
 
+// Context Based Restrictions Session
+func (sess clientSession) CbrV1API() (*core.BaseService, error) {
+	return sess.cbrAPI, sess.cbrErr
+}
+
 // ResourceMAanger Session
 func (sess clientSession) ResourceManagerV2API() (*resourcemanager.ResourceManagerV2, error) {
 	return sess.resourceManagerAPI, sess.resourceManagerErr
@@ -907,6 +927,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.csConfigErr = errEmptyBluemixCredentials
 		session.csv2ConfigErr = errEmptyBluemixCredentials
 		session.containerRegistryClientErr = errEmptyBluemixCredentials
+		session.vulnerabilityAdvisorClientErr = errEmptyBluemixCredentials
 		session.kpErr = errEmptyBluemixCredentials
 		session.pushServiceClientErr = errEmptyBluemixCredentials
 		session.appConfigurationClientErr = errEmptyBluemixCredentials
@@ -966,6 +987,7 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.cisRangeAppErr = errEmptyBluemixCredentials
 		session.cisWAFRuleErr = errEmptyBluemixCredentials
 		session.iamIdentityErr = errEmptyBluemixCredentials
+		session.cbrErr = errEmptyBluemixCredentials
 		session.secretsManagerClientErr = errEmptyBluemixCredentials
 		session.schematicsClientErr = errEmptyBluemixCredentials
 		session.satelliteClientErr = errEmptyBluemixCredentials
@@ -1311,6 +1333,22 @@ func (c *Config) ClientSession() (interface{}, error) {
 		session.containerRegistryClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Container Registry API service: %q", err)
 	}
 
+	// Vulnerability Advisor is served from the registry endpoints
+	vulnerabilityAdvisorClientOptions := &vulnerabilityadvisorv3.VulnerabilityAdvisorV3Options{
+		Authenticator: authenticator,
+		URL:           envFallBack([]string{"IBMCLOUD_CR_API_ENDPOINT"}, containerRegistryClientURL),
+		Account:       core.StringPtr(userConfig.userAccount),
+	}
+	session.vulnerabilityAdvisorClient, err = vulnerabilityadvisorv3.NewVulnerabilityAdvisorV3(vulnerabilityAdvisorClientOptions)
+	if err == nil {
+		session.vulnerabilityAdvisorClient.Service.EnableRetries(c.RetryCount, c.RetryDelay)
+		session.vulnerabilityAdvisorClient.SetDefaultHeaders(gohttp.Header{
+			"X-Original-User-Agent": {fmt.Sprintf("terraform-provider-ibm/%s", version.Version)},
+		})
+	} else {
+		session.vulnerabilityAdvisorClientErr = fmt.Errorf("Error occurred while configuring IBM Cloud Vulnerability Advisor API service: %q", err)
+	}
+
 	//cosconfigurl := fmt.Sprintf("https://%s.iaas.cloud.ibm.com/v1", c.Region)
 	cosconfigoptions := &cosconfig.ResourceConfigurationV1Options{
 		Authenticator: authenticator,
@@ -1973,6 +2011,24 @@ func (c *Config) ClientSession() (interface{}, error) {
 	}
 	session.iamPolicyManagementAPI = iamPolicyManagementClient
 
+	// The context based restrictions API isn't supported by the platform
+	// services SDK, the resources send the requests with the base service
+	cbrURL := contructEndpoint("cbr", cloudEndpoint)
+	if c.Visibility == "private" || c.Visibility == "public-and-private" {
+		cbrURL = contructEndpoint("private.cbr", cloudEndpoint)
+	}
+	cbrClient, err := core.NewBaseService(&core.ServiceOptions{
+		Authenticator: authenticator,
+		URL:           envFallBack([]string{"IBMCLOUD_CONTEXT_BASED_RESTRICTIONS_ENDPOINT"}, cbrURL),
+	})
+	if err != nil {
+		session.cbrErr = fmt.Errorf("Error occured while configuring Context Based Restrictions service: %q", err)
+	}
+	if cbrClient != nil {
+		cbrClient.EnableRetries(c.RetryCount, c.RetryDelay)
+	}
+	session.cbrAPI = cbrClient
+
 	rmURL := resourcemanager.DefaultServiceURL
 	if c.Visibility == "private" {
 		if c.Region == "us-south" || c.Region == "us-east" {
@@ -0,0 +1,131 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataIBMCertificateManagerExpiringCertificates() *schema.Resource {
+	return &schema.Resource{
+		Read: dataIBMCertificateManagerExpiringCertificatesRead,
+		Schema: map[string]*schema.Schema{
+			"certificate_manager_instance_id": {
+				Type:        schema.TypeString,
+				Description: "Certificate Manager Instance ID",
+				Required:    true,
+			},
+			"expiry_window": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				ValidateFunc: InvokeDataSourceValidator("ibm_certificate_manager_expiring_certificates", "expiry_window"),
+				Description:  "The number of days from now in which the certificates expire",
+			},
+			"cert_ids": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of the IDs of the expiring certificates",
+			},
+			"certificates": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of the expiring certificates",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"cert_id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"domains": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"status": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"imported": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"expires_on": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"days_to_expiry": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func datasourceIBMCertificateManagerExpiringCertificatesValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "expiry_window",
+			ValidateFunctionIdentifier: IntBetween,
+			Type:                       TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "365",
+		},
+	)
+
+	ibmCertificateManagerExpiringCertificatesDatasourceValidator := ResourceValidator{ResourceName: "ibm_certificate_manager_expiring_certificates", Schema: validateSchema}
+	return &ibmCertificateManagerExpiringCertificatesDatasourceValidator
+}
+
+func dataIBMCertificateManagerExpiringCertificatesRead(d *schema.ResourceData, meta interface{}) error {
+	cmService, err := meta.(ClientSession).CertificateManagerAPI()
+	if err != nil {
+		return err
+	}
+	instanceID := d.Get("certificate_manager_instance_id").(string)
+	result, err := cmService.Certificate().ListCertificates(instanceID)
+	if err != nil {
+		return err
+	}
+
+	// expires_on of the certificates is in milliseconds since epoch
+	now := time.Now()
+	windowEnd := now.AddDate(0, 0, d.Get("expiry_window").(int)).UnixNano() / int64(time.Millisecond)
+	record := make([]map[string]interface{}, 0)
+	certIDs := make([]string, 0)
+	for _, c := range result {
+		if c.ExpiresOn == 0 || c.ExpiresOn > windowEnd {
+			continue
+		}
+		expiresOn := time.Unix(0, c.ExpiresOn*int64(time.Millisecond))
+		certificate := make(map[string]interface{})
+		certificate["cert_id"] = c.ID
+		certificate["name"] = c.Name
+		certificate["domains"] = c.Domains
+		certificate["status"] = c.Status
+		certificate["imported"] = c.Imported
+		certificate["expires_on"] = c.ExpiresOn
+		certificate["days_to_expiry"] = int(expiresOn.Sub(now).Hours() / 24)
+		record = append(record, certificate)
+		certIDs = append(certIDs, c.ID)
+	}
+	d.SetId(instanceID)
+	d.Set("certificate_manager_instance_id", instanceID)
+	d.Set("certificates", record)
+	d.Set("cert_ids", certIDs)
+
+	return nil
+}
@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCertificateManagerExpiringCertificatesDataSource_Basic(t *testing.T) {
+	cmsName := fmt.Sprintf("tf-acc-test1-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resource.Test(t, resource.TestCase{
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCertificateManagerExpiringCertificatesDataSourceConfig_basic(cmsName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_certificate_manager_expiring_certificates.certs", "id"),
+					resource.TestCheckResourceAttr("data.ibm_certificate_manager_expiring_certificates.certs", "expiry_window", "60"),
+					resource.TestCheckResourceAttr("data.ibm_certificate_manager_expiring_certificates.certs", "certificates.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCertificateManagerExpiringCertificatesDataSourceConfig_basic(cmsName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "cm" {
+		name     = "%s"
+		location = "us-south"
+		service  = "cloudcerts"
+		plan     = "free"
+	}
+	data "ibm_certificate_manager_expiring_certificates" "certs"{
+		certificate_manager_instance_id = ibm_resource_instance.cm.id
+		expiry_window                   = 60
+	}
+	`, cmsName)
+}
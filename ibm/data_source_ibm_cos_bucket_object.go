@@ -59,6 +59,20 @@ func dataSourceIBMCosBucketObject() *schema.Resource {
 				Computed:    true,
 				Description: "COS object MD5 hexdigest",
 			},
+			"hmac_access_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"hmac_secret_access_key"},
+				Description:  "HMAC access key ID used to sign the requests instead of IAM",
+			},
+			"hmac_secret_access_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"hmac_access_key_id"},
+				Description:  "HMAC secret access key used to sign the requests instead of IAM",
+			},
 			"key": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -69,6 +83,19 @@ func dataSourceIBMCosBucketObject() *schema.Resource {
 				Computed:    true,
 				Description: "COS object last modified date",
 			},
+			"presigned_url_expiry": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateAllowedRangeInt(1, 604800),
+				RequiredWith: []string{"hmac_access_key_id", "hmac_secret_access_key"},
+				Description:  "Expiry in seconds of the presigned URL of the object, the URL is only generated when it is set",
+			},
+			"presigned_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "Presigned URL to download the object",
+			},
 			"version_id": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -94,6 +121,7 @@ func dataSourceIBMCosBucketObjectRead(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	setCOSHMACCredentials(s3Client, d)
 
 	objectKey := d.Get("key").(string)
 	headInput := &s3.HeadObjectInput{
@@ -145,6 +173,21 @@ func dataSourceIBMCosBucketObjectRead(ctx context.Context, d *schema.ResourceDat
 		log.Printf("[INFO] Ignoring body of COS bucket (%s) object (%s) with Content-Type %q", bucketName, objectKey, contentType)
 	}
 
+	// Presigned URLs can only be signed with HMAC keys, not with an IAM token
+	if expiry, ok := d.GetOk("presigned_url_expiry"); ok {
+		req, _ := s3Client.GetObjectRequest(&s3.GetObjectInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(objectKey),
+		})
+		url, err := req.Presign(time.Duration(expiry.(int)) * time.Second)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("failed presigning COS bucket (%s) object (%s): %w", bucketName, objectKey, err))
+		}
+		d.Set("presigned_url", url)
+	} else {
+		d.Set("presigned_url", "")
+	}
+
 	objectID := getObjectId(bucketCRN, objectKey, bucketLocation)
 	d.SetId(objectID)
 	d.Set("version_id", out.VersionId)
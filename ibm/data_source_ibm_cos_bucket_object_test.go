@@ -5,6 +5,7 @@ package ibm
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
@@ -51,3 +52,50 @@ func testAccIBMCOSBucketObjectDataSourceConfig_basic(name string, crn string) st
 			key             = ibm_cos_bucket_object.testacc.key
 		}`, name, crn)
 }
+
+func TestAccIBMCOSBucketObjectDataSource_presignedURL(t *testing.T) {
+	name := "tf-testacc-cos-presign"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckCOS(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectDataSourceConfig_presignedURL(name, cosCRN),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_cos_bucket_object.testacc", "presigned_url"),
+					resource.TestMatchResourceAttr("data.ibm_cos_bucket_object.testacc", "presigned_url", regexp.MustCompile("X-Amz-Expires=3600")),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketObjectDataSourceConfig_presignedURL(name string, crn string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_key" "testacc" {
+			name                 = "%[1]s"
+			resource_instance_id = "%[2]s"
+			role                 = "Reader"
+			hmac                 = true
+		}
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			key             = "%[1]s.txt"
+			content         = "Acceptance testing"
+		}
+		data "ibm_cos_bucket_object" "testacc" {
+			bucket_crn             = ibm_cos_bucket.testacc.crn
+			bucket_location        = ibm_cos_bucket.testacc.region_location
+			key                    = ibm_cos_bucket_object.testacc.key
+			hmac_access_key_id     = ibm_resource_key.testacc.hmac_access_key_id
+			hmac_secret_access_key = ibm_resource_key.testacc.hmac_secret_access_key
+			presigned_url_expiry   = 3600
+		}`, name, crn)
+}
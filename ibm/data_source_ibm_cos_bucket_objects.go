@@ -0,0 +1,282 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceIBMCosBucketObjects() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMCosBucketObjectsRead,
+
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"hmac_access_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"hmac_secret_access_key"},
+				Description:  "HMAC access key ID used to sign the requests instead of IAM",
+			},
+			"hmac_secret_access_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"hmac_access_key_id"},
+				Description:  "HMAC secret access key used to sign the requests instead of IAM",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only the objects whose key starts with the prefix are listed",
+			},
+			"delimiter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Character used to group the keys, the keys containing it after the prefix are rolled up in common_prefixes",
+			},
+			"start_after": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The listing starts after this key",
+			},
+			"max_keys": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validateAllowedRangeInt(1, 100000),
+				Description:  "Maximum number of objects listed, all the objects are listed when not set",
+			},
+			"list_versions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "List all the versions of the objects instead of the current ones",
+			},
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Keys of the listed objects",
+			},
+			"common_prefixes": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Prefixes rolled up with the delimiter",
+			},
+			"objects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Listed objects",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object key",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "COS object size in bytes",
+						},
+						"etag": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object ETag",
+						},
+						"last_modified": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object last modified date",
+						},
+						"storage_class": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object storage class",
+						},
+						"version_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "COS object version ID, set when listing versions",
+						},
+						"is_latest": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the version is the current one, set when listing versions",
+						},
+					},
+				},
+			},
+			"object_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of listed objects",
+			},
+			"total_size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total size in bytes of the listed objects",
+			},
+		},
+	}
+}
+
+func dataSourceIBMCosBucketObjectsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	if !strings.Contains(bucketCRN, ":bucket:") {
+		return diag.FromErr(fmt.Errorf("Incorrect bucket CRN %s", bucketCRN))
+	}
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
+
+	bxSession, err := m.(ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	setCOSHMACCredentials(s3Client, d)
+
+	prefix := d.Get("prefix").(string)
+	delimiter := d.Get("delimiter").(string)
+	startAfter := d.Get("start_after").(string)
+	maxKeys := d.Get("max_keys").(int)
+
+	objects := make([]map[string]interface{}, 0)
+	commonPrefixes := make([]string, 0)
+	var totalSize int64
+
+	// The pages are requested until the listing is over or max_keys objects
+	// were collected
+	full := func() bool {
+		return maxKeys > 0 && len(objects) >= maxKeys
+	}
+	addObject := func(object map[string]interface{}) {
+		if full() {
+			return
+		}
+		totalSize += int64(object["size"].(int))
+		objects = append(objects, object)
+	}
+	addPrefixes := func(prefixes []*s3.CommonPrefix) {
+		for _, p := range prefixes {
+			commonPrefixes = append(commonPrefixes, aws.StringValue(p.Prefix))
+		}
+	}
+
+	if d.Get("list_versions").(bool) {
+		input := &s3.ListObjectVersionsInput{
+			Bucket: aws.String(bucketName),
+		}
+		if prefix != "" {
+			input.Prefix = aws.String(prefix)
+		}
+		if delimiter != "" {
+			input.Delimiter = aws.String(delimiter)
+		}
+		if startAfter != "" {
+			input.KeyMarker = aws.String(startAfter)
+		}
+		err = s3Client.ListObjectVersionsPages(input, func(page *s3.ListObjectVersionsOutput, lastPage bool) bool {
+			for _, version := range page.Versions {
+				addObject(map[string]interface{}{
+					"key":           aws.StringValue(version.Key),
+					"size":          int(aws.Int64Value(version.Size)),
+					"etag":          strings.Trim(aws.StringValue(version.ETag), `"`),
+					"last_modified": cosObjectLastModified(version.LastModified),
+					"storage_class": aws.StringValue(version.StorageClass),
+					"version_id":    aws.StringValue(version.VersionId),
+					"is_latest":     aws.BoolValue(version.IsLatest),
+				})
+			}
+			addPrefixes(page.CommonPrefixes)
+			return !lastPage && !full()
+		})
+	} else {
+		input := &s3.ListObjectsV2Input{
+			Bucket: aws.String(bucketName),
+		}
+		if prefix != "" {
+			input.Prefix = aws.String(prefix)
+		}
+		if delimiter != "" {
+			input.Delimiter = aws.String(delimiter)
+		}
+		if startAfter != "" {
+			input.StartAfter = aws.String(startAfter)
+		}
+		err = s3Client.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+			for _, object := range page.Contents {
+				addObject(map[string]interface{}{
+					"key":           aws.StringValue(object.Key),
+					"size":          int(aws.Int64Value(object.Size)),
+					"etag":          strings.Trim(aws.StringValue(object.ETag), `"`),
+					"last_modified": cosObjectLastModified(object.LastModified),
+					"storage_class": aws.StringValue(object.StorageClass),
+				})
+			}
+			addPrefixes(page.CommonPrefixes)
+			return !lastPage && !full()
+		})
+	}
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed listing COS bucket (%s) objects with prefix (%s): %w", bucketName, prefix, err))
+	}
+
+	log.Printf("[DEBUG] Listed %d objects of COS bucket (%s) with prefix (%s)", len(objects), bucketName, prefix)
+
+	keys := make([]string, 0, len(objects))
+	for _, object := range objects {
+		keys = append(keys, object["key"].(string))
+	}
+
+	d.SetId(fmt.Sprintf("%s:prefix:%s:location:%s", bucketCRN, prefix, bucketLocation))
+	d.Set("keys", keys)
+	d.Set("common_prefixes", commonPrefixes)
+	d.Set("objects", objects)
+	d.Set("object_count", len(objects))
+	d.Set("total_size", int(totalSize))
+
+	return nil
+}
+
+func cosObjectLastModified(lastModified *time.Time) string {
+	if lastModified == nil {
+		return ""
+	}
+	return lastModified.Format(time.RFC1123)
+}
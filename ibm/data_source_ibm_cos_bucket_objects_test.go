@@ -0,0 +1,71 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSBucketObjectsDataSource_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-list-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckCOS(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectsDataSourceConfig_basic(name, cosCRN),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.all", "object_count", "3"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.all", "total_size", "30"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.all", "keys.#", "3"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.all", "objects.0.key", "a.txt"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.root", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.root", "common_prefixes.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.root", "common_prefixes.0", "dir/"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.page", "keys.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_cos_bucket_objects.page", "keys.0", "dir/b.txt"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketObjectsDataSourceConfig_basic(name string, crn string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			for_each        = toset(["a.txt", "dir/b.txt", "dir/c.txt"])
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			key             = each.key
+			content         = "Acceptance"
+		}
+		data "ibm_cos_bucket_objects" "all" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			depends_on      = [ibm_cos_bucket_object.testacc]
+		}
+		data "ibm_cos_bucket_objects" "root" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			delimiter       = "/"
+			depends_on      = [ibm_cos_bucket_object.testacc]
+		}
+		data "ibm_cos_bucket_objects" "page" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			prefix          = "dir/"
+			max_keys        = 1
+			depends_on      = [ibm_cos_bucket_object.testacc]
+		}`, name, crn)
+}
@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+)
+
+func dataIBMContainerRegistryImageDigests() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataIBMContainerRegistryImageDigestsRead,
+
+		Schema: map[string]*schema.Schema{
+			"exclude_tagged": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Lists only the untagged images. Use it to find images that can be cleaned up.",
+			},
+			"exclude_va": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Excludes the Vulnerability Advisor status of the images.",
+			},
+			"include_ibm": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Includes IBM-provided public images in the list of images.",
+			},
+			"repositories": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Lists only the images in the given repositories, in the format <REGISTRY>/<NAMESPACE>/<REPOSITORY>.",
+			},
+			"image_digests": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Container Registry image digests",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The digest of the image manifest.",
+						},
+						"created": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The date the image was created, as a Unix timestamp.",
+						},
+						"manifest_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the image manifest.",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The size of the image in bytes.",
+						},
+						"repo_tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The repositories and tags that reference the digest.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"repository": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the repository.",
+									},
+									"tags": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Description: "The tags of the digest in the repository.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataIBMContainerRegistryImageDigestsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listImageDigestsOptions := &containerregistryv1.ListImageDigestsOptions{}
+
+	listImageDigestsOptions.SetExcludeTagged(d.Get("exclude_tagged").(bool))
+	listImageDigestsOptions.SetExcludeVa(d.Get("exclude_va").(bool))
+	listImageDigestsOptions.SetIncludeIBM(d.Get("include_ibm").(bool))
+	if v, ok := d.GetOk("repositories"); ok {
+		listImageDigestsOptions.SetRepositories(expandStringList(v.([]interface{})))
+	}
+
+	digestList, response, err := containerRegistryClient.ListImageDigestsWithContext(context, listImageDigestsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListImageDigestsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	imageDigests := []map[string]interface{}{}
+	for _, imageDigest := range digestList {
+		digest := map[string]interface{}{}
+		digest["id"] = stringValue(imageDigest.ID)
+		digest["created"] = intValue(imageDigest.Created)
+		digest["manifest_type"] = stringValue(imageDigest.ManifestType)
+		digest["size"] = intValue(imageDigest.Size)
+		digest["repo_tags"] = flattenImageDigestRepoTags(imageDigest.RepoTags)
+		imageDigests = append(imageDigests, digest)
+	}
+	if err = d.Set("image_digests", imageDigests); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting image_digests: %s", err))
+	}
+	d.SetId(time.Now().UTC().String())
+	return nil
+}
+
+// flattenImageDigestRepoTags converts the repository to tags map of a digest,
+// where the tags are keyed by name, to a list sorted by repository.
+func flattenImageDigestRepoTags(repoTags map[string]interface{}) []map[string]interface{} {
+	repositories := make([]string, 0, len(repoTags))
+	for repository := range repoTags {
+		repositories = append(repositories, repository)
+	}
+	sort.Strings(repositories)
+
+	result := make([]map[string]interface{}, 0, len(repositories))
+	for _, repository := range repositories {
+		tags := []string{}
+		switch v := repoTags[repository].(type) {
+		case map[string]interface{}:
+			for tag := range v {
+				tags = append(tags, tag)
+			}
+		case []interface{}:
+			for _, tag := range v {
+				if s, ok := tag.(string); ok {
+					tags = append(tags, s)
+				}
+			}
+		}
+		sort.Strings(tags)
+		result = append(result, map[string]interface{}{
+			"repository": repository,
+			"tags":       tags,
+		})
+	}
+	return result
+}
@@ -0,0 +1,36 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCrImageDigestsDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCrImageDigestsDataSourceConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_cr_image_digests.untagged", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_cr_image_digests.untagged", "image_digests.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCrImageDigestsDataSourceConfig() string {
+	return fmt.Sprintf(`
+	data "ibm_cr_image_digests" "untagged" {
+		exclude_tagged = true
+		exclude_va     = true
+	}
+`)
+}
@@ -0,0 +1,180 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+)
+
+func dataIBMContainerRegistryImages() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataIBMContainerRegistryImagesRead,
+
+		Schema: map[string]*schema.Schema{
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Lists only the images in the given namespace.",
+			},
+			"repository": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Lists only the images in the given repository, in the format <REGISTRY>/<NAMESPACE>/<REPOSITORY>.",
+			},
+			"include_ibm": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Includes IBM-provided public images in the list of images.",
+			},
+			"include_private": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Includes private images in the list of images.",
+			},
+			"include_manifest_lists": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Includes tags that reference multi-architecture manifest lists in the list of images.",
+			},
+			"vulnerabilities": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Includes the Vulnerability Advisor status of the images.",
+			},
+			"images": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Container Registry images",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the image configuration.",
+						},
+						"repo_tags": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The tags that reference the image.",
+						},
+						"repo_digests": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The digests that reference the image.",
+						},
+						"created": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The date the image was created, as a Unix timestamp.",
+						},
+						"size": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The size of the image in bytes.",
+						},
+						"manifest_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the image manifest.",
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The labels of the image.",
+						},
+						"vulnerable": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The Vulnerability Advisor status of the image.",
+						},
+						"vulnerability_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of vulnerabilities found in the image.",
+						},
+						"configuration_issue_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of configuration issues found in the image.",
+						},
+						"issue_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of issues found in the image.",
+						},
+						"exempt_issue_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of exempted issues of the image.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataIBMContainerRegistryImagesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	listImagesOptions := &containerregistryv1.ListImagesOptions{}
+
+	if v, ok := d.GetOk("namespace"); ok {
+		listImagesOptions.SetNamespace(v.(string))
+	}
+	if v, ok := d.GetOk("repository"); ok {
+		listImagesOptions.SetRepository(v.(string))
+	}
+	listImagesOptions.SetIncludeIBM(d.Get("include_ibm").(bool))
+	listImagesOptions.SetIncludePrivate(d.Get("include_private").(bool))
+	listImagesOptions.SetIncludeManifestLists(d.Get("include_manifest_lists").(bool))
+	listImagesOptions.SetVulnerabilities(d.Get("vulnerabilities").(bool))
+
+	imageList, response, err := containerRegistryClient.ListImagesWithContext(context, listImagesOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListImagesWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	images := []map[string]interface{}{}
+	for _, remoteImage := range imageList {
+		image := map[string]interface{}{}
+		image["id"] = stringValue(remoteImage.ID)
+		image["repo_tags"] = remoteImage.RepoTags
+		image["repo_digests"] = remoteImage.RepoDigests
+		image["created"] = intValue(remoteImage.Created)
+		image["size"] = intValue(remoteImage.Size)
+		image["manifest_type"] = stringValue(remoteImage.ManifestType)
+		image["labels"] = remoteImage.Labels
+		image["vulnerable"] = stringValue(remoteImage.Vulnerable)
+		image["vulnerability_count"] = intValue(remoteImage.VulnerabilityCount)
+		image["configuration_issue_count"] = intValue(remoteImage.ConfigurationIssueCount)
+		image["issue_count"] = intValue(remoteImage.IssueCount)
+		image["exempt_issue_count"] = intValue(remoteImage.ExemptIssueCount)
+		images = append(images, image)
+	}
+	if err = d.Set("images", images); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting images: %s", err))
+	}
+	d.SetId(time.Now().UTC().String())
+	return nil
+}
@@ -58,6 +58,20 @@ func dataSourceIBMResourceKey() *schema.Resource {
 				Computed:    true,
 			},
 
+			"hmac_access_key_id": {
+				Type:        schema.TypeString,
+				Sensitive:   true,
+				Computed:    true,
+				Description: "The HMAC access key ID of the Cloud Object Storage credentials",
+			},
+
+			"hmac_secret_access_key": {
+				Type:        schema.TypeString,
+				Sensitive:   true,
+				Computed:    true,
+				Description: "The HMAC secret access key of the Cloud Object Storage credentials",
+			},
+
 			"most_recent": &schema.Schema{
 				Description: "If true and multiple entries are found, the most recently created resource key is used. " +
 					"If false, an error is returned",
@@ -133,6 +147,9 @@ func dataSourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	d.Set("credentials", Flatten(key.Credentials))
+	accessKeyID, secretAccessKey, _ := resourceKeyHMACCredentials(key.Credentials)
+	d.Set("hmac_access_key_id", accessKeyID)
+	d.Set("hmac_secret_access_key", secretAccessKey)
 	d.Set("status", key.State)
 	d.Set("crn", key.Crn.String())
 	return nil
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"encoding/base64"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -38,8 +39,16 @@ func dataSourceIBMSatelliteAttachHostScript() *schema.Resource {
 				Description: "List of labels for the attach host",
 			},
 			"host_provider": {
-				Type:     schema.TypeString,
-				Required: true,
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The provider of the hosts, such as ibm, aws, azure or vmware. The package setup of the script depends on the provider",
+			},
+			"script_format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "shell",
+				ValidateFunc: validateAllowedStringValue([]string{"shell", "cloud-init"}),
+				Description:  "The format of the attach host script. Use cloud-init to pass the script as user data of the hosts",
 			},
 			"script_dir": {
 				Description: "The directory where the satellite attach host script to be downloaded. Default is home directory",
@@ -99,7 +108,11 @@ func dataSourceIBMSatelliteAttachHostScriptRead(d *schema.ResourceData, meta int
 		}
 	}
 	scriptDir, _ = filepath.Abs(scriptDir)
+	scriptFormat := d.Get("script_format").(string)
 	scriptPath := filepath.Join(scriptDir, "addHost.sh")
+	if scriptFormat == "cloud-init" {
+		scriptPath = filepath.Join(scriptDir, "addHost.yaml")
+	}
 
 	//Generate script
 	createRegOptions := &kubernetesserviceapiv1.AttachSatelliteHostOptions{}
@@ -132,6 +145,9 @@ yum install container-selinux -y
 	}
 
 	scriptContent := strings.Join(lines, "\n")
+	if scriptFormat == "cloud-init" {
+		scriptContent = satelliteHostScriptCloudInit(scriptContent)
+	}
 	err = ioutil.WriteFile(scriptPath, []byte(scriptContent), 0644)
 	if err != nil {
 		return fmt.Errorf("Error Creating Satellite Attach Host Script: %s", err)
@@ -140,6 +156,7 @@ yum install container-selinux -y
 	d.Set("location", location)
 	d.Set("host_script", scriptContent)
 	d.Set("host_provider", hostProvider)
+	d.Set("script_format", scriptFormat)
 	d.Set("script_dir", scriptDir)
 	d.Set("script_path", scriptPath)
 	d.SetId(*locData.ID)
@@ -148,3 +165,17 @@ yum install container-selinux -y
 
 	return nil
 }
+
+// satelliteHostScriptCloudInit renders the attach host script as cloud-init
+// user data that writes the script to the host and runs it at first boot
+func satelliteHostScriptCloudInit(script string) string {
+	return fmt.Sprintf(`#cloud-config
+write_files:
+- path: /usr/local/bin/ibm-host-attach.sh
+  permissions: '0755'
+  encoding: b64
+  content: %s
+runcmd:
+- [ bash, /usr/local/bin/ibm-host-attach.sh ]
+`, base64.StdEncoding.EncodeToString([]byte(script)))
+}
@@ -5,6 +5,7 @@ package ibm
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -27,6 +28,23 @@ func TestAccIBMSatelliteAttachHostScriptDataSourceBasic(t *testing.T) {
 	})
 }
 
+func TestAccIBMSatelliteAttachHostScriptDataSourceCloudInit(t *testing.T) {
+	locationName := fmt.Sprintf("tf-satellitelocation-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSatelliteAttachHostScriptDataSourceCloudInitConfig(locationName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_satellite_attach_host_script.script", "script_format", "cloud-init"),
+					resource.TestMatchResourceAttr("data.ibm_satellite_attach_host_script.script", "host_script", regexp.MustCompile("^#cloud-config")),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMSatelliteAttachHostScriptDataSourceConfig(locationName string) string {
 	return fmt.Sprintf(`
 resource "ibm_satellite_location" "testacc_satellite" {
@@ -41,3 +59,19 @@ data "ibm_satellite_attach_host_script" "script" {
 	host_provider  = "ibm"
 }`, locationName)
 }
+
+func testAccCheckIBMSatelliteAttachHostScriptDataSourceCloudInitConfig(locationName string) string {
+	return fmt.Sprintf(`
+resource "ibm_satellite_location" "testacc_satellite" {
+	location     = "%s"
+	managed_from = "wdc04"
+	zones		 = ["us-east-1", "us-east-2", "us-east-3"]
+}
+
+data "ibm_satellite_attach_host_script" "script" {
+	location       = ibm_satellite_location.testacc_satellite.id
+	labels         = ["env:prod"]
+	host_provider  = "aws"
+	script_format  = "cloud-init"
+}`, locationName)
+}
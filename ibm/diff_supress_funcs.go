@@ -7,6 +7,7 @@ import (
 	"encoding/json"
 	"log"
 	"reflect"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
@@ -39,3 +40,23 @@ func suppressEquivalentJSON(k, old, new string, d *schema.ResourceData) bool {
 	}
 	return reflect.DeepEqual(oldm, newm)
 }
+
+// suppressAutoscaledWorkerCount keeps worker pools from fighting the cluster
+// autoscaler over the number of workers once the pool exists
+func suppressAutoscaledWorkerCount(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != "" && d.Get("autoscaling_enabled").(bool)
+}
+
+// suppressEquivalentRFC3339Time ignores the differences of format between
+// two RFC3339 timestamps of the same time
+func suppressEquivalentRFC3339Time(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
@@ -0,0 +1,468 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"time"
+
+	kp "github.com/IBM/keyprotect-go-client"
+)
+
+const (
+	hpcsMasterKeyStatusValid = "valid"
+	hpcsMasterKeyPartLength  = 32
+	hpcsMaxAdmins            = 8
+)
+
+// tkeAdmin is an administrator of a crypto unit, identified by the subject
+// key identifier of its signature key
+type tkeAdmin struct {
+	Name string `json:"name"`
+	SKI  string `json:"ski"`
+}
+
+// tkeCryptoUnit is a crypto unit (HSM domain) of a Hyper Protect Crypto
+// Services instance as reported by the TKE API
+type tkeCryptoUnit struct {
+	ID                  string     `json:"id"`
+	Type                string     `json:"type"`
+	Location            string     `json:"location"`
+	SignatureThreshold  int        `json:"signature_threshold"`
+	RevocationThreshold int        `json:"revocation_threshold"`
+	Admins              []tkeAdmin `json:"admins"`
+	TransportKey        string     `json:"transport_key"`
+	Sequence            int64      `json:"sequence"`
+	CurrentMKStatus     string     `json:"current_mk_status"`
+	CurrentMKVP         string     `json:"current_mkvp"`
+	NewMKStatus         string     `json:"new_mk_status"`
+	NewMKVP             string     `json:"new_mkvp"`
+}
+
+type tkeCryptoUnits struct {
+	CryptoUnits []tkeCryptoUnit `json:"crypto_units"`
+}
+
+type tkeSignature struct {
+	SKI       string `json:"ski"`
+	Signature string `json:"signature"`
+}
+
+// tkeCommand is an administrative command of a crypto unit. The command, its
+// data and the sequence of the crypto unit are signed by the administrators,
+// except in imprint mode when the crypto unit has no signature threshold yet.
+type tkeCommand struct {
+	Command    string                 `json:"command"`
+	Sequence   int64                  `json:"sequence"`
+	Data       map[string]interface{} `json:"data,omitempty"`
+	Signatures []tkeSignature         `json:"signatures,omitempty"`
+}
+
+// tkeError is returned for the error responses of the TKE API
+type tkeError struct {
+	URL        string
+	StatusCode int
+	Message    string
+}
+
+func (e *tkeError) Error() string {
+	return fmt.Sprintf("%s %d: %s", e.URL, e.StatusCode, e.Message)
+}
+
+// tkeClient sends requests to the Trusted Key Entry API of the region of a
+// Hyper Protect Crypto Services instance. The endpoint can be set with the
+// IBMCLOUD_HPCS_TKE_ENDPOINT environment variable.
+type tkeClient struct {
+	endpoint   string
+	token      string
+	httpClient *http.Client
+}
+
+func hpcsTkeClient(meta interface{}, location string) (*tkeClient, error) {
+	bmxSess, err := meta.(ClientSession).BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	tkeURL := contructEndpoint(fmt.Sprintf("tke.%s.hs-crypto", location), cloudEndpoint) + "/tke/v1"
+	return &tkeClient{
+		endpoint:   strings.TrimSuffix(envFallBack([]string{"IBMCLOUD_HPCS_TKE_ENDPOINT"}, tkeURL), "/"),
+		token:      bmxSess.Config.IAMAccessToken,
+		httpClient: &http.Client{Timeout: 60 * time.Second},
+	}, nil
+}
+
+func (c *tkeClient) request(method, path string, body, result interface{}) error {
+	url := c.endpoint + path
+	reqBody := &bytes.Buffer{}
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reqBody = bytes.NewBuffer(b)
+	}
+	req, err := http.NewRequest(method, url, reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("accept", "application/json")
+	req.Header.Set("content-type", "application/json")
+	req.Header.Set("authorization", c.token)
+
+	response, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	resBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
+	}
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return &tkeError{URL: url, StatusCode: response.StatusCode, Message: string(resBody)}
+	}
+	if result != nil && len(resBody) != 0 {
+		return json.Unmarshal(resBody, result)
+	}
+	return nil
+}
+
+func (c *tkeClient) cryptoUnits(instanceID string) ([]tkeCryptoUnit, error) {
+	units := tkeCryptoUnits{}
+	if err := c.request("GET", fmt.Sprintf("/instances/%s/crypto_units", instanceID), nil, &units); err != nil {
+		return nil, err
+	}
+	return units.CryptoUnits, nil
+}
+
+// hpcsSignatureKey is the signature key of an administrator
+type hpcsSignatureKey struct {
+	Name       string
+	SKI        string
+	PublicKey  string
+	privateKey *ecdsa.PrivateKey
+}
+
+// readHpcsSignatureKey reads the PEM encoded EC private key of a signature key
+// file, encrypted keys are decrypted with the token
+func readHpcsSignatureKey(name, path, token string) (*hpcsSignatureKey, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Error while reading the signature key file %s: %s", path, err)
+	}
+	block, _ := pem.Decode(content)
+	if block == nil {
+		return nil, fmt.Errorf("The signature key file %s must be PEM encoded", path)
+	}
+	der := block.Bytes
+	// Signature key files are protected with the legacy PEM encryption
+	if x509.IsEncryptedPEMBlock(block) {
+		if token == "" {
+			return nil, fmt.Errorf("The signature key file %s is encrypted, its token is required", path)
+		}
+		der, err = x509.DecryptPEMBlock(block, []byte(token))
+		if err != nil {
+			return nil, fmt.Errorf("Error while decrypting the signature key file %s: %s", path, err)
+		}
+	}
+
+	var privateKey *ecdsa.PrivateKey
+	if privateKey, err = x509.ParseECPrivateKey(der); err != nil {
+		key, pkcs8Err := x509.ParsePKCS8PrivateKey(der)
+		if pkcs8Err != nil {
+			return nil, fmt.Errorf("Error while parsing the signature key file %s: %s", path, err)
+		}
+		var ok bool
+		if privateKey, ok = key.(*ecdsa.PrivateKey); !ok {
+			return nil, fmt.Errorf("The signature key file %s must contain an EC private key", path)
+		}
+	}
+
+	publicKey, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+	ski := sha256.Sum256(publicKey)
+	return &hpcsSignatureKey{
+		Name:       name,
+		SKI:        hex.EncodeToString(ski[:]),
+		PublicKey:  base64.StdEncoding.EncodeToString(publicKey),
+		privateKey: privateKey,
+	}, nil
+}
+
+// readHpcsMasterKeyPart reads the base64 encoded 256 bit master key part of
+// a local file
+func readHpcsMasterKeyPart(path string) ([]byte, error) {
+	keyMaterial, err := readKmsKeyMaterialFile(path)
+	if err != nil {
+		return nil, err
+	}
+	part, _ := base64.StdEncoding.DecodeString(keyMaterial)
+	if len(part) != hpcsMasterKeyPartLength {
+		return nil, fmt.Errorf("The master key part of the file %s must be a 256 bit key", path)
+	}
+	return part, nil
+}
+
+// hpcsMasterKeyVerificationPattern returns the verification pattern of the
+// master key that is combined from its parts
+func hpcsMasterKeyVerificationPattern(parts [][]byte) string {
+	masterKey := make([]byte, hpcsMasterKeyPartLength)
+	for _, part := range parts {
+		for i := range masterKey {
+			masterKey[i] ^= part[i]
+		}
+	}
+	mkvp := sha256.Sum256(masterKey)
+	return hex.EncodeToString(mkvp[:16])
+}
+
+// tkeCommandDigest returns the digest that is signed for a command of a
+// crypto unit
+func tkeCommandDigest(unitID string, command string, sequence int64, data map[string]interface{}) ([]byte, error) {
+	message, err := json.Marshal(map[string]interface{}{
+		"crypto_unit": unitID,
+		"command":     command,
+		"sequence":    sequence,
+		"data":        data,
+	})
+	if err != nil {
+		return nil, err
+	}
+	digest := sha512.Sum512(message)
+	return digest[:], nil
+}
+
+func signTkeCommand(unitID, command string, sequence int64, data map[string]interface{}, signers []*hpcsSignatureKey) (*tkeCommand, error) {
+	cmd := &tkeCommand{
+		Command:  command,
+		Sequence: sequence,
+		Data:     data,
+	}
+	if len(signers) == 0 {
+		return cmd, nil
+	}
+	digest, err := tkeCommandDigest(unitID, command, sequence, data)
+	if err != nil {
+		return nil, err
+	}
+	for _, signer := range signers {
+		signature, err := ecdsa.SignASN1(rand.Reader, signer.privateKey, digest)
+		if err != nil {
+			return nil, fmt.Errorf("Error while signing the %s command with the signature key of %s: %s", command, signer.Name, err)
+		}
+		cmd.Signatures = append(cmd.Signatures, tkeSignature{
+			SKI:       signer.SKI,
+			Signature: base64.StdEncoding.EncodeToString(signature),
+		})
+	}
+	return cmd, nil
+}
+
+// hpcsConfig is the configuration of the crypto units of an instance
+type hpcsConfig struct {
+	SignatureThreshold  int
+	RevocationThreshold int
+	Admins              []*hpcsSignatureKey
+	MasterKeyParts      [][]byte
+}
+
+// tkeCryptoUnitSession sends the signed commands of a crypto unit in the
+// order of its sequence
+type tkeCryptoUnitSession struct {
+	client     *tkeClient
+	instanceID string
+	unit       tkeCryptoUnit
+	sequence   int64
+}
+
+func (s *tkeCryptoUnitSession) send(command string, data map[string]interface{}, signers []*hpcsSignatureKey) error {
+	cmd, err := signTkeCommand(s.unit.ID, command, s.sequence, data, signers)
+	if err != nil {
+		return err
+	}
+	path := fmt.Sprintf("/instances/%s/crypto_units/%s/commands", s.instanceID, s.unit.ID)
+	if err := s.client.request("POST", path, cmd, nil); err != nil {
+		return fmt.Errorf("Error while sending the %s command: %s", command, err)
+	}
+	s.sequence++
+	return nil
+}
+
+// unitSigners returns the configured administrators that are administrators
+// of the crypto unit, as many as required by the signature threshold
+func unitSigners(admins []*hpcsSignatureKey, unitAdmins []tkeAdmin, threshold int) []*hpcsSignatureKey {
+	if threshold == 0 {
+		return nil
+	}
+	current := make(map[string]bool, len(unitAdmins))
+	for _, admin := range unitAdmins {
+		current[admin.SKI] = true
+	}
+	signers := []*hpcsSignatureKey{}
+	for _, admin := range admins {
+		if current[admin.SKI] && len(signers) < threshold {
+			signers = append(signers, admin)
+		}
+	}
+	return signers
+}
+
+// hpcsInitCryptoUnits initializes all the crypto units of the instance with
+// the administrators, the thresholds and the master key of the
+// configuration. The initialization is idempotent, only the differences to the
+// configuration are applied.
+func hpcsInitCryptoUnits(c *tkeClient, instanceID string, config hpcsConfig) error {
+	units, err := c.cryptoUnits(instanceID)
+	if err != nil {
+		return fmt.Errorf("Error while reading the crypto units of instance %s: %s", instanceID, err)
+	}
+	for _, unit := range units {
+		if err := hpcsInitCryptoUnit(c, instanceID, unit, config); err != nil {
+			return fmt.Errorf("Error while initializing crypto unit %s: %s", unit.ID, err)
+		}
+	}
+	return nil
+}
+
+func hpcsInitCryptoUnit(c *tkeClient, instanceID string, unit tkeCryptoUnit, config hpcsConfig) error {
+	s := &tkeCryptoUnitSession{client: c, instanceID: instanceID, unit: unit, sequence: unit.Sequence}
+
+	// The commands are signed by the current administrators until the
+	// thresholds of the configuration are set
+	signers := unitSigners(config.Admins, unit.Admins, unit.SignatureThreshold)
+	if unit.SignatureThreshold > 0 && len(signers) < unit.SignatureThreshold {
+		return fmt.Errorf("%d signature keys of the current administrators are required, %d are configured", unit.SignatureThreshold, len(signers))
+	}
+
+	current := make(map[string]bool, len(unit.Admins))
+	for _, admin := range unit.Admins {
+		current[admin.SKI] = true
+	}
+	configured := make(map[string]bool, len(config.Admins))
+	for _, admin := range config.Admins {
+		configured[admin.SKI] = true
+		if current[admin.SKI] {
+			continue
+		}
+		err := s.send("add_admin", map[string]interface{}{
+			"name":       admin.Name,
+			"ski":        admin.SKI,
+			"public_key": admin.PublicKey,
+		}, signers)
+		if err != nil {
+			return err
+		}
+	}
+
+	if unit.SignatureThreshold != config.SignatureThreshold || unit.RevocationThreshold != config.RevocationThreshold {
+		err := s.send("set_thresholds", map[string]interface{}{
+			"signature_threshold":  config.SignatureThreshold,
+			"revocation_threshold": config.RevocationThreshold,
+		}, signers)
+		if err != nil {
+			return err
+		}
+	}
+
+	signers = config.Admins
+	if len(signers) > config.SignatureThreshold {
+		signers = signers[:config.SignatureThreshold]
+	}
+	for _, admin := range unit.Admins {
+		if configured[admin.SKI] {
+			continue
+		}
+		if err := s.send("remove_admin", map[string]interface{}{"ski": admin.SKI}, signers); err != nil {
+			return err
+		}
+	}
+
+	mkvp := hpcsMasterKeyVerificationPattern(config.MasterKeyParts)
+	if unit.CurrentMKStatus == hpcsMasterKeyStatusValid && unit.CurrentMKVP == mkvp {
+		return nil
+	}
+	if err := s.send("clear_new_master_key", nil, signers); err != nil {
+		return err
+	}
+	for i, part := range config.MasterKeyParts {
+		// The master key parts are wrapped with the transport key of the
+		// crypto unit
+		encryptedPart, err := kp.EncryptKey(base64.StdEncoding.EncodeToString(part), unit.TransportKey)
+		if err != nil {
+			return fmt.Errorf("Error while wrapping master key part %d: %s", i+1, err)
+		}
+		err = s.send("load_master_key_part", map[string]interface{}{
+			"index":          i + 1,
+			"encrypted_part": encryptedPart,
+		}, signers)
+		if err != nil {
+			return err
+		}
+	}
+	if err := s.send("commit_master_key", map[string]interface{}{"mkvp": mkvp}, signers); err != nil {
+		return err
+	}
+	return s.send("activate_master_key", map[string]interface{}{"mkvp": mkvp}, signers)
+}
+
+// hpcsZeroizeCryptoUnits clears the master keys and the administrators of
+// the initialized crypto units of the instance
+func hpcsZeroizeCryptoUnits(c *tkeClient, instanceID string, admins []*hpcsSignatureKey) error {
+	units, err := c.cryptoUnits(instanceID)
+	if err != nil {
+		return fmt.Errorf("Error while reading the crypto units of instance %s: %s", instanceID, err)
+	}
+	for _, unit := range units {
+		if unit.SignatureThreshold == 0 && len(unit.Admins) == 0 {
+			continue
+		}
+		s := &tkeCryptoUnitSession{client: c, instanceID: instanceID, unit: unit, sequence: unit.Sequence}
+		if err := s.send("zeroize", nil, unitSigners(admins, unit.Admins, unit.SignatureThreshold)); err != nil {
+			return fmt.Errorf("Error while zeroizing crypto unit %s: %s", unit.ID, err)
+		}
+	}
+	return nil
+}
+
+// flattenHpcsCryptoUnits returns the status of the crypto units
+func flattenHpcsCryptoUnits(units []tkeCryptoUnit) []map[string]interface{} {
+	hsmInfo := make([]map[string]interface{}, 0, len(units))
+	for _, unit := range units {
+		admins := make([]map[string]interface{}, 0, len(unit.Admins))
+		for _, admin := range unit.Admins {
+			admins = append(admins, map[string]interface{}{
+				"name": admin.Name,
+				"ski":  admin.SKI,
+			})
+		}
+		hsmInfo = append(hsmInfo, map[string]interface{}{
+			"hsm_id":               unit.ID,
+			"hsm_type":             unit.Type,
+			"hsm_location":         unit.Location,
+			"signature_threshold":  unit.SignatureThreshold,
+			"revocation_threshold": unit.RevocationThreshold,
+			"admins":               admins,
+			"current_mk_status":    unit.CurrentMKStatus,
+			"current_mkvp":         unit.CurrentMKVP,
+			"new_mk_status":        unit.NewMKStatus,
+			"new_mkvp":             unit.NewMKVP,
+		})
+	}
+	return hsmInfo
+}
@@ -0,0 +1,349 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+)
+
+// tkeStandIn is a local stand-in of the TKE API that verifies the signatures
+// of the commands and keeps the state of the crypto units in memory
+type tkeStandIn struct {
+	mu           sync.Mutex
+	units        []*tkeStandInUnit
+	commands     []string
+	transportKey *rsa.PrivateKey
+}
+
+type tkeStandInUnit struct {
+	tkeCryptoUnit
+	publicKeys map[string]*ecdsa.PublicKey
+	newMK      []byte
+}
+
+func newTkeStandIn(t *testing.T, unitIDs ...string) *tkeStandIn {
+	transportKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(&transportKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := &tkeStandIn{transportKey: transportKey}
+	for _, id := range unitIDs {
+		s.units = append(s.units, &tkeStandInUnit{
+			tkeCryptoUnit: tkeCryptoUnit{
+				ID:           id,
+				Type:         "operational",
+				Location:     "us-south",
+				TransportKey: base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})),
+			},
+			publicKeys: map[string]*ecdsa.PublicKey{},
+		})
+	}
+	return s
+}
+
+func (s *tkeStandIn) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	path := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case r.Method == "GET" && len(path) == 3 && path[0] == "instances" && path[2] == "crypto_units":
+		units := tkeCryptoUnits{}
+		for _, unit := range s.units {
+			units.CryptoUnits = append(units.CryptoUnits, unit.tkeCryptoUnit)
+		}
+		json.NewEncoder(w).Encode(units)
+	case r.Method == "POST" && len(path) == 5 && path[4] == "commands":
+		var unit *tkeStandInUnit
+		for _, u := range s.units {
+			if u.ID == path[3] {
+				unit = u
+			}
+		}
+		if unit == nil {
+			http.Error(w, "crypto unit not found", http.StatusNotFound)
+			return
+		}
+		cmd := tkeCommand{}
+		if err := json.NewDecoder(r.Body).Decode(&cmd); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if err := s.execute(unit, cmd); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		unit.Sequence++
+		s.commands = append(s.commands, fmt.Sprintf("%s:%s", unit.ID, cmd.Command))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *tkeStandIn) execute(unit *tkeStandInUnit, cmd tkeCommand) error {
+	if cmd.Sequence != unit.Sequence {
+		return fmt.Errorf("sequence %d expected, got %d", unit.Sequence, cmd.Sequence)
+	}
+	if unit.SignatureThreshold > 0 {
+		digest, err := tkeCommandDigest(unit.ID, cmd.Command, cmd.Sequence, cmd.Data)
+		if err != nil {
+			return err
+		}
+		valid := 0
+		for _, signature := range cmd.Signatures {
+			publicKey, ok := unit.publicKeys[signature.SKI]
+			if !ok {
+				return fmt.Errorf("%s is not an administrator", signature.SKI)
+			}
+			sig, _ := base64.StdEncoding.DecodeString(signature.Signature)
+			if !ecdsa.VerifyASN1(publicKey, digest, sig) {
+				return fmt.Errorf("invalid signature of %s", signature.SKI)
+			}
+			valid++
+		}
+		if valid < unit.SignatureThreshold {
+			return fmt.Errorf("%d signatures required, got %d", unit.SignatureThreshold, valid)
+		}
+	}
+
+	switch cmd.Command {
+	case "add_admin":
+		der, _ := base64.StdEncoding.DecodeString(cmd.Data["public_key"].(string))
+		publicKey, err := x509.ParsePKIXPublicKey(der)
+		if err != nil {
+			return err
+		}
+		ski := cmd.Data["ski"].(string)
+		unit.publicKeys[ski] = publicKey.(*ecdsa.PublicKey)
+		unit.Admins = append(unit.Admins, tkeAdmin{Name: cmd.Data["name"].(string), SKI: ski})
+	case "remove_admin":
+		ski := cmd.Data["ski"].(string)
+		delete(unit.publicKeys, ski)
+		admins := []tkeAdmin{}
+		for _, admin := range unit.Admins {
+			if admin.SKI != ski {
+				admins = append(admins, admin)
+			}
+		}
+		unit.Admins = admins
+	case "set_thresholds":
+		unit.SignatureThreshold = int(cmd.Data["signature_threshold"].(float64))
+		unit.RevocationThreshold = int(cmd.Data["revocation_threshold"].(float64))
+	case "clear_new_master_key":
+		unit.newMK = make([]byte, hpcsMasterKeyPartLength)
+		unit.NewMKStatus = "empty"
+	case "load_master_key_part":
+		encryptedPart, _ := base64.StdEncoding.DecodeString(cmd.Data["encrypted_part"].(string))
+		part, err := s.transportKey.Decrypt(nil, encryptedPart, &rsa.OAEPOptions{Hash: crypto.SHA256})
+		if err != nil {
+			return err
+		}
+		for i := range unit.newMK {
+			unit.newMK[i] ^= part[i]
+		}
+		unit.NewMKStatus = "partially_loaded"
+	case "commit_master_key":
+		mkvp := hpcsMasterKeyVerificationPattern([][]byte{unit.newMK})
+		if mkvp != cmd.Data["mkvp"].(string) {
+			return fmt.Errorf("master key verification pattern %s expected, got %s", mkvp, cmd.Data["mkvp"])
+		}
+		unit.NewMKStatus = "committed"
+		unit.NewMKVP = mkvp
+	case "activate_master_key":
+		if unit.NewMKStatus != "committed" {
+			return fmt.Errorf("the new master key is not committed")
+		}
+		unit.CurrentMKStatus = hpcsMasterKeyStatusValid
+		unit.CurrentMKVP = unit.NewMKVP
+		unit.NewMKStatus = "empty"
+		unit.NewMKVP = ""
+	case "zeroize":
+		unit.Admins = nil
+		unit.publicKeys = map[string]*ecdsa.PublicKey{}
+		unit.SignatureThreshold = 0
+		unit.RevocationThreshold = 0
+		unit.CurrentMKStatus = ""
+		unit.CurrentMKVP = ""
+	default:
+		return fmt.Errorf("unknown command %s", cmd.Command)
+	}
+	return nil
+}
+
+func (s *tkeStandIn) takeCommands() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	commands := s.commands
+	s.commands = nil
+	return commands
+}
+
+func testHpcsSignatureKeyFile(t *testing.T, dir, name, token string) string {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P521(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalECPrivateKey(privateKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block := &pem.Block{Type: "EC PRIVATE KEY", Bytes: der}
+	if token != "" {
+		block, err = x509.EncryptPEMBlock(rand.Reader, block.Type, der, []byte(token), x509.PEMCipherAES256)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	path := fmt.Sprintf("%s/%s.pem", dir, name)
+	if err := ioutil.WriteFile(path, pem.EncodeToMemory(block), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func testHpcsMasterKeyPartFile(t *testing.T, dir, name string) string {
+	part := make([]byte, hpcsMasterKeyPartLength)
+	if _, err := rand.Read(part); err != nil {
+		t.Fatal(err)
+	}
+	path := fmt.Sprintf("%s/%s", dir, name)
+	if err := ioutil.WriteFile(path, []byte(base64.StdEncoding.EncodeToString(part)), 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestHpcsInitCryptoUnits(t *testing.T) {
+	dir, err := ioutil.TempDir("", "hpcs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	admin1, err := readHpcsSignatureKey("admin1", testHpcsSignatureKeyFile(t, dir, "admin1", "secret"), "secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	admin2, err := readHpcsSignatureKey("admin2", testHpcsSignatureKeyFile(t, dir, "admin2", ""), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	admin3, err := readHpcsSignatureKey("admin3", testHpcsSignatureKeyFile(t, dir, "admin3", ""), "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readHpcsSignatureKey("admin1", fmt.Sprintf("%s/admin1.pem", dir), ""); err == nil {
+		t.Error("expected an error for an encrypted signature key file without token")
+	}
+
+	parts := [][]byte{}
+	for _, name := range []string{"part1", "part2"} {
+		part, err := readHpcsMasterKeyPart(testHpcsMasterKeyPartFile(t, dir, name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		parts = append(parts, part)
+	}
+	mkvp := hpcsMasterKeyVerificationPattern(parts)
+
+	standIn := newTkeStandIn(t, "unit-1", "unit-2")
+	server := httptest.NewServer(standIn)
+	defer server.Close()
+	client := &tkeClient{endpoint: server.URL, httpClient: server.Client()}
+
+	config := hpcsConfig{
+		SignatureThreshold:  2,
+		RevocationThreshold: 1,
+		Admins:              []*hpcsSignatureKey{admin1, admin2},
+		MasterKeyParts:      parts,
+	}
+	if err := hpcsInitCryptoUnits(client, "instance", config); err != nil {
+		t.Fatal(err)
+	}
+	if commands := standIn.takeCommands(); len(commands) != 16 {
+		t.Errorf("expected 16 commands for the initialization, got %v", commands)
+	}
+	units, err := client.cryptoUnits("instance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, unit := range flattenHpcsCryptoUnits(units) {
+		if unit["signature_threshold"] != 2 || unit["revocation_threshold"] != 1 {
+			t.Errorf("%s: unexpected thresholds %v, %v", unit["hsm_id"], unit["signature_threshold"], unit["revocation_threshold"])
+		}
+		if len(unit["admins"].([]map[string]interface{})) != 2 {
+			t.Errorf("%s: expected 2 administrators, got %v", unit["hsm_id"], unit["admins"])
+		}
+		if unit["current_mk_status"] != hpcsMasterKeyStatusValid || unit["current_mkvp"] != mkvp {
+			t.Errorf("%s: expected the master key %s to be valid, got %v %v", unit["hsm_id"], mkvp, unit["current_mk_status"], unit["current_mkvp"])
+		}
+	}
+
+	// The initialization is idempotent
+	if err := hpcsInitCryptoUnits(client, "instance", config); err != nil {
+		t.Fatal(err)
+	}
+	if commands := standIn.takeCommands(); len(commands) != 0 {
+		t.Errorf("expected no commands for an initialized instance, got %v", commands)
+	}
+
+	// An administrator is replaced, the commands are signed by the current
+	// administrators until the thresholds are lowered
+	config.Admins = []*hpcsSignatureKey{admin1, admin2, admin3}
+	config.SignatureThreshold = 1
+	if err := hpcsInitCryptoUnits(client, "instance", config); err != nil {
+		t.Fatal(err)
+	}
+	expected := []string{"unit-1:add_admin", "unit-1:set_thresholds", "unit-2:add_admin", "unit-2:set_thresholds"}
+	if commands := standIn.takeCommands(); strings.Join(commands, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected the commands %v, got %v", expected, commands)
+	}
+	config.Admins = []*hpcsSignatureKey{admin1, admin3}
+	if err := hpcsInitCryptoUnits(client, "instance", config); err != nil {
+		t.Fatal(err)
+	}
+	expected = []string{"unit-1:remove_admin", "unit-2:remove_admin"}
+	if commands := standIn.takeCommands(); strings.Join(commands, ",") != strings.Join(expected, ",") {
+		t.Errorf("expected the commands %v, got %v", expected, commands)
+	}
+
+	// The signatures of the current administrators are required
+	config.Admins = []*hpcsSignatureKey{admin2}
+	if err := hpcsInitCryptoUnits(client, "instance", config); err == nil {
+		t.Error("expected an error without the signature keys of the current administrators")
+	}
+
+	if err := hpcsZeroizeCryptoUnits(client, "instance", []*hpcsSignatureKey{admin3}); err != nil {
+		t.Fatal(err)
+	}
+	units, err = client.cryptoUnits("instance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, unit := range units {
+		if unit.SignatureThreshold != 0 || len(unit.Admins) != 0 || unit.CurrentMKVP != "" {
+			t.Errorf("%s: expected the crypto unit to be zeroized", unit.ID)
+		}
+	}
+}
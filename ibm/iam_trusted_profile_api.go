@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+)
+
+// The trusted profiles of the IAM Identity Services API aren't supported by
+// the iamidentityv1 client, the requests are sent with its base service
+
+// iamTrustedProfile is a trusted profile of the IAM Identity Services API
+type iamTrustedProfile struct {
+	ID          string `json:"id,omitempty"`
+	EntityTag   string `json:"entity_tag,omitempty"`
+	CRN         string `json:"crn,omitempty"`
+	Name        string `json:"name"`
+	Description string `json:"description,omitempty"`
+	IamID       string `json:"iam_id,omitempty"`
+	AccountID   string `json:"account_id,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+	ModifiedAt  string `json:"modified_at,omitempty"`
+}
+
+// iamTrustedProfileClaimRule is a rule of a trusted profile that federated
+// users or compute resources with matching claims can apply the profile with
+type iamTrustedProfileClaimRule struct {
+	ID         string                                `json:"id,omitempty"`
+	EntityTag  string                                `json:"entity_tag,omitempty"`
+	Name       string                                `json:"name,omitempty"`
+	Type       string                                `json:"type"`
+	RealmName  string                                `json:"realm_name,omitempty"`
+	CrType     string                                `json:"cr_type,omitempty"`
+	Expiration int                                   `json:"expiration,omitempty"`
+	Conditions []iamTrustedProfileClaimRuleCondition `json:"conditions"`
+	CreatedAt  string                                `json:"created_at,omitempty"`
+	ModifiedAt string                                `json:"modified_at,omitempty"`
+}
+
+type iamTrustedProfileClaimRuleCondition struct {
+	Claim    string `json:"claim"`
+	Operator string `json:"operator"`
+	Value    string `json:"value"`
+}
+
+// iamTrustedProfileLink links a compute resource to a trusted profile, the
+// compute resource can apply the profile without a claim rule
+type iamTrustedProfileLink struct {
+	ID         string                      `json:"id,omitempty"`
+	EntityTag  string                      `json:"entity_tag,omitempty"`
+	Name       string                      `json:"name,omitempty"`
+	CrType     string                      `json:"cr_type"`
+	Link       iamTrustedProfileLinkTarget `json:"link"`
+	CreatedAt  string                      `json:"created_at,omitempty"`
+	ModifiedAt string                      `json:"modified_at,omitempty"`
+}
+
+type iamTrustedProfileLinkTarget struct {
+	CRN       string `json:"crn"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+}
+
+// iamTrustedProfileRequest sends a request to the trusted profile API, the
+// result is unmarshalled into result if it's not nil
+func iamTrustedProfileRequest(ctx context.Context, iamIdentityClient *iamidentityv1.IamIdentityV1, method, path string, pathParams map[string]string, ifMatch string, body, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder = builder.WithContext(ctx)
+	builder.EnableGzipCompression = iamIdentityClient.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(iamIdentityClient.Service.Options.URL, path, pathParams)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if ifMatch != "" {
+		builder.AddHeader("If-Match", ifMatch)
+	}
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return iamIdentityClient.Service.Request(request, result)
+}
+
+func getIAMTrustedProfile(ctx context.Context, iamIdentityClient *iamidentityv1.IamIdentityV1, profileID string) (*iamTrustedProfile, *core.DetailedResponse, error) {
+	profile := &iamTrustedProfile{}
+	response, err := iamTrustedProfileRequest(ctx, iamIdentityClient, core.GET, `/v1/profiles/{profile-id}`, map[string]string{"profile-id": profileID}, "", nil, profile)
+	if err != nil {
+		return nil, response, err
+	}
+	return profile, response, nil
+}
@@ -0,0 +1,341 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"strings"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/bluemix-go/rest"
+)
+
+// kubeAPI talks to the Kubernetes API server of an IKS/ROKS cluster with the
+// admin credentials that are also exposed by ibm_container_cluster_config.
+type kubeAPI struct {
+	host   string
+	token  string
+	client *rest.Client
+
+	// resources caches the discovered API resources by group version
+	resources map[string][]kubeAPIResource
+}
+
+// kubeObjectMeta is the subset of the Kubernetes ObjectMeta the provider needs
+type kubeObjectMeta struct {
+	Name            string            `json:"name"`
+	Namespace       string            `json:"namespace,omitempty"`
+	ResourceVersion string            `json:"resourceVersion,omitempty"`
+	Labels          map[string]string `json:"labels,omitempty"`
+	Annotations     map[string]string `json:"annotations,omitempty"`
+}
+
+// kubeConfigMap is a Kubernetes core/v1 ConfigMap
+type kubeConfigMap struct {
+	APIVersion string            `json:"apiVersion"`
+	Kind       string            `json:"kind"`
+	Metadata   kubeObjectMeta    `json:"metadata"`
+	Data       map[string]string `json:"data,omitempty"`
+}
+
+// kubeObject is a Kubernetes object of any kind
+type kubeObject map[string]interface{}
+
+// kubeAPIResource is a resource of an API group version as listed by discovery
+type kubeAPIResource struct {
+	Name       string `json:"name"`
+	Kind       string `json:"kind"`
+	Namespaced bool   `json:"namespaced"`
+}
+
+type kubeAPIResourceList struct {
+	GroupVersion string            `json:"groupVersion"`
+	Resources    []kubeAPIResource `json:"resources"`
+}
+
+// kubeNode is the subset of a Kubernetes core/v1 Node the provider needs
+type kubeNode struct {
+	Metadata kubeObjectMeta `json:"metadata"`
+	Status   struct {
+		Conditions []kubeNodeCondition `json:"conditions,omitempty"`
+	} `json:"status"`
+}
+
+type kubeNodeCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+func newKubeAPI(meta interface{}, clusterNameOrID string, targetEnv v2.ClusterTargetHeader) (*kubeAPI, error) {
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return nil, err
+	}
+	configDir, err := ioutil.TempDir("", "ibm-cluster-config")
+	if err != nil {
+		return nil, fmt.Errorf("Error creating the directory for the cluster config: %s", err)
+	}
+	defer os.RemoveAll(configDir)
+
+	clusterKeyDetails, err := csClient.Clusters().GetClusterConfigDetail(clusterNameOrID, configDir, true, targetEnv)
+	if err != nil {
+		return nil, fmt.Errorf("Error downloading the cluster config [%s]: %w", clusterNameOrID, err)
+	}
+	return newKubeAPIFromConfig(clusterKeyDetails.Host, clusterKeyDetails.Token, clusterKeyDetails.ClusterCACertificate, clusterKeyDetails.Admin, clusterKeyDetails.AdminKey)
+}
+
+func newKubeAPIFromConfig(host, token, caCertificate, clientCertificate, clientKey string) (*kubeAPI, error) {
+	if host == "" {
+		return nil, fmt.Errorf("The cluster config does not contain the API server host")
+	}
+	tlsConfig := &tls.Config{}
+	if caCertificate != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(caCertificate)) {
+			return nil, fmt.Errorf("Error parsing the cluster CA certificate")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if clientCertificate != "" && clientKey != "" {
+		cert, err := tls.X509KeyPair([]byte(clientCertificate), []byte(clientKey))
+		if err != nil {
+			return nil, fmt.Errorf("Error parsing the cluster admin certificate: %s", err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	transport := DefaultTransport().(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return &kubeAPI{
+		host:  strings.TrimSuffix(host, "/"),
+		token: token,
+		client: &rest.Client{
+			HTTPClient: &http.Client{Transport: transport},
+		},
+		resources: map[string][]kubeAPIResource{},
+	}, nil
+}
+
+func (k *kubeAPI) url(path string) string {
+	return k.host + path
+}
+
+// do sends the request to the API server, decoding a 2XX response into respV.
+// Non-2XX responses are returned as bmxerror.RequestFailure.
+func (k *kubeAPI) do(req *rest.Request, respV interface{}) error {
+	if k.token != "" {
+		req.Set("Authorization", "Bearer "+k.token)
+	}
+	_, err := k.client.Do(req, respV, nil)
+	if err == rest.ErrEmptyResponseBody {
+		return nil
+	}
+	return err
+}
+
+func (k *kubeAPI) getConfigMap(namespace, name string) (*kubeConfigMap, error) {
+	configMap := &kubeConfigMap{}
+	err := k.do(rest.GetRequest(k.url(fmt.Sprintf("/api/v1/namespaces/%s/configmaps/%s", namespace, name))), configMap)
+	if err != nil {
+		return nil, err
+	}
+	return configMap, nil
+}
+
+// updateConfigMap replaces the config map. The resourceVersion read with it is
+// sent back, so a concurrent writer makes the update fail with a conflict.
+func (k *kubeAPI) updateConfigMap(configMap *kubeConfigMap) (*kubeConfigMap, error) {
+	updated := &kubeConfigMap{}
+	path := fmt.Sprintf("/api/v1/namespaces/%s/configmaps/%s", configMap.Metadata.Namespace, configMap.Metadata.Name)
+	err := k.do(rest.PutRequest(k.url(path)).Body(configMap), updated)
+	if err != nil {
+		return nil, err
+	}
+	return updated, nil
+}
+
+func (k *kubeAPI) getNode(name string) (*kubeNode, error) {
+	node := &kubeNode{}
+	err := k.do(rest.GetRequest(k.url(fmt.Sprintf("/api/v1/nodes/%s", name))), node)
+	if err != nil {
+		return nil, err
+	}
+	return node, nil
+}
+
+// isReady reports whether the kubelet of the node posts the Ready condition
+func (n *kubeNode) isReady() bool {
+	for _, c := range n.Status.Conditions {
+		if c.Type == "Ready" {
+			return c.Status == "True"
+		}
+	}
+	return false
+}
+
+func (o kubeObject) apiVersion() string {
+	v, _ := o["apiVersion"].(string)
+	return v
+}
+
+func (o kubeObject) kind() string {
+	v, _ := o["kind"].(string)
+	return v
+}
+
+func (o kubeObject) metadata() map[string]interface{} {
+	m, _ := o["metadata"].(map[string]interface{})
+	return m
+}
+
+func (o kubeObject) name() string {
+	v, _ := o.metadata()["name"].(string)
+	return v
+}
+
+func (o kubeObject) namespace() string {
+	v, _ := o.metadata()["namespace"].(string)
+	return v
+}
+
+// resourceFor looks up the API resource of a kind with discovery. The cached
+// resources are refreshed once when the kind is not found, so kinds of custom
+// resource definitions created in the meantime are found as well.
+func (k *kubeAPI) resourceFor(apiVersion, kind string) (*kubeAPIResource, error) {
+	for attempt := 0; attempt < 2; attempt++ {
+		resources, ok := k.resources[apiVersion]
+		if !ok || attempt > 0 {
+			list := &kubeAPIResourceList{}
+			err := k.do(rest.GetRequest(k.url(k.groupVersionPath(apiVersion))), list)
+			if err != nil && !isKubeNotFound(err) {
+				return nil, fmt.Errorf("Error discovering the resources of %s: %s", apiVersion, err)
+			}
+			resources = list.Resources
+			k.resources[apiVersion] = resources
+		}
+		for _, r := range resources {
+			// Subresources such as deployments/status share the kind
+			if r.Kind == kind && !strings.Contains(r.Name, "/") {
+				return &r, nil
+			}
+		}
+	}
+	return nil, &kubeKindNotServedError{apiVersion: apiVersion, kind: kind}
+}
+
+// kubeKindNotServedError is returned by discovery for a kind that the cluster
+// does not serve, such as the kind of a removed custom resource definition
+type kubeKindNotServedError struct {
+	apiVersion string
+	kind       string
+}
+
+func (e *kubeKindNotServedError) Error() string {
+	return fmt.Sprintf("The kind %s of %s is not served by the cluster", e.kind, e.apiVersion)
+}
+
+func (k *kubeAPI) groupVersionPath(apiVersion string) string {
+	if !strings.Contains(apiVersion, "/") {
+		return "/api/" + apiVersion
+	}
+	return "/apis/" + apiVersion
+}
+
+// objectPath returns the path of the object, or of the collection of the
+// resource when name is empty
+func (k *kubeAPI) objectPath(res *kubeAPIResource, apiVersion, namespace, name string) string {
+	path := k.groupVersionPath(apiVersion)
+	if res.Namespaced {
+		path += "/namespaces/" + namespace
+	}
+	path += "/" + res.Name
+	if name != "" {
+		path += "/" + name
+	}
+	return path
+}
+
+func (k *kubeAPI) getObject(path string) (kubeObject, error) {
+	obj := kubeObject{}
+	err := k.do(rest.GetRequest(k.url(path)), &obj)
+	if err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// applyObject applies the object with server-side apply. A JSON document is a
+// valid apply patch.
+func (k *kubeAPI) applyObject(path string, obj kubeObject, fieldManager string, force bool) (kubeObject, error) {
+	body, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	req := rest.PatchRequest(k.url(path)).
+		Query("fieldManager", fieldManager).
+		Set("Content-Type", "application/apply-patch+yaml").
+		Body(body)
+	if force {
+		req.Query("force", "true")
+	}
+	applied := kubeObject{}
+	err = k.do(req, &applied)
+	if err != nil {
+		return nil, err
+	}
+	return applied, nil
+}
+
+func (k *kubeAPI) createObject(collectionPath string, obj kubeObject, fieldManager string) (kubeObject, error) {
+	created := kubeObject{}
+	err := k.do(rest.PostRequest(k.url(collectionPath)).Query("fieldManager", fieldManager).Body(obj), &created)
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (k *kubeAPI) replaceObject(path string, obj kubeObject, fieldManager string) (kubeObject, error) {
+	replaced := kubeObject{}
+	err := k.do(rest.PutRequest(k.url(path)).Query("fieldManager", fieldManager).Body(obj), &replaced)
+	if err != nil {
+		return nil, err
+	}
+	return replaced, nil
+}
+
+// deleteObject deletes the object, leaving the garbage collection of its
+// dependents to the cluster
+func (k *kubeAPI) deleteObject(path string) error {
+	return k.do(rest.DeleteRequest(k.url(path)).Query("propagationPolicy", "Background"), nil)
+}
+
+func kubeErrorStatus(err error) int {
+	if apiErr, ok := err.(bmxerror.RequestFailure); ok {
+		return apiErr.StatusCode()
+	}
+	return 0
+}
+
+func isKubeNotFound(err error) bool {
+	return kubeErrorStatus(err) == http.StatusNotFound
+}
+
+func isKubeKindNotServed(err error) bool {
+	_, ok := err.(*kubeKindNotServedError)
+	return ok
+}
+
+func isKubeConflict(err error) bool {
+	return kubeErrorStatus(err) == http.StatusConflict
+}
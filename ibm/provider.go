@@ -165,159 +165,163 @@ func Provider() *schema.Provider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"ibm_api_gateway":                        dataSourceIBMApiGateway(),
-			"ibm_account":                            dataSourceIBMAccount(),
-			"ibm_app":                                dataSourceIBMApp(),
-			"ibm_app_domain_private":                 dataSourceIBMAppDomainPrivate(),
-			"ibm_app_domain_shared":                  dataSourceIBMAppDomainShared(),
-			"ibm_app_route":                          dataSourceIBMAppRoute(),
-			"ibm_function_action":                    dataSourceIBMFunctionAction(),
-			"ibm_function_package":                   dataSourceIBMFunctionPackage(),
-			"ibm_function_rule":                      dataSourceIBMFunctionRule(),
-			"ibm_function_trigger":                   dataSourceIBMFunctionTrigger(),
-			"ibm_function_namespace":                 dataSourceIBMFunctionNamespace(),
-			"ibm_certificate_manager_certificates":   dataIBMCertificateManagerCertificates(),
-			"ibm_certificate_manager_certificate":    dataIBMCertificateManagerCertificate(),
-			"ibm_cis":                                dataSourceIBMCISInstance(),
-			"ibm_cis_dns_records":                    dataSourceIBMCISDNSRecords(),
-			"ibm_cis_certificates":                   dataIBMCISCertificates(),
-			"ibm_cis_global_load_balancers":          dataSourceIBMCISGlbs(),
-			"ibm_cis_origin_pools":                   dataSourceIBMCISOriginPools(),
-			"ibm_cis_healthchecks":                   dataSourceIBMCISHealthChecks(),
-			"ibm_cis_domain":                         dataSourceIBMCISDomain(),
-			"ibm_cis_firewall":                       dataIBMCISFirewallsRecord(),
-			"ibm_cis_cache_settings":                 dataSourceIBMCISCacheSetting(),
-			"ibm_cis_waf_packages":                   dataSourceIBMCISWAFPackages(),
-			"ibm_cis_range_apps":                     dataSourceIBMCISRangeApps(),
-			"ibm_cis_custom_certificates":            dataSourceIBMCISCustomCertificates(),
-			"ibm_cis_rate_limit":                     dataSourceIBMCISRateLimit(),
-			"ibm_cis_ip_addresses":                   dataSourceIBMCISIP(),
-			"ibm_cis_waf_groups":                     dataSourceIBMCISWAFGroups(),
-			"ibm_cis_edge_functions_actions":         dataSourceIBMCISEdgeFunctionsActions(),
-			"ibm_cis_edge_functions_triggers":        dataSourceIBMCISEdgeFunctionsTriggers(),
-			"ibm_cis_custom_pages":                   dataSourceIBMCISCustomPages(),
-			"ibm_cis_page_rules":                     dataSourceIBMCISPageRules(),
-			"ibm_cis_waf_rules":                      dataSourceIBMCISWAFRules(),
-			"ibm_database":                           dataSourceIBMDatabaseInstance(),
-			"ibm_compute_bare_metal":                 dataSourceIBMComputeBareMetal(),
-			"ibm_compute_image_template":             dataSourceIBMComputeImageTemplate(),
-			"ibm_compute_placement_group":            dataSourceIBMComputePlacementGroup(),
-			"ibm_compute_ssh_key":                    dataSourceIBMComputeSSHKey(),
-			"ibm_compute_vm_instance":                dataSourceIBMComputeVmInstance(),
-			"ibm_container_addons":                   datasourceIBMContainerAddOns(),
-			"ibm_container_alb":                      dataSourceIBMContainerALB(),
-			"ibm_container_alb_cert":                 dataSourceIBMContainerALBCert(),
-			"ibm_container_bind_service":             dataSourceIBMContainerBindService(),
-			"ibm_container_cluster":                  dataSourceIBMContainerCluster(),
-			"ibm_container_cluster_config":           dataSourceIBMContainerClusterConfig(),
-			"ibm_container_cluster_versions":         dataSourceIBMContainerClusterVersions(),
-			"ibm_container_cluster_worker":           dataSourceIBMContainerClusterWorker(),
-			"ibm_container_vpc_cluster_alb":          dataSourceIBMContainerVPCClusterALB(),
-			"ibm_container_vpc_alb":                  dataSourceIBMContainerVPCClusterALB(),
-			"ibm_container_vpc_cluster":              dataSourceIBMContainerVPCCluster(),
-			"ibm_container_vpc_cluster_worker":       dataSourceIBMContainerVPCClusterWorker(),
-			"ibm_container_vpc_cluster_worker_pool":  dataSourceIBMContainerVpcClusterWorkerPool(),
-			"ibm_container_vpc_worker_pool":          dataSourceIBMContainerVpcClusterWorkerPool(),
-			"ibm_container_worker_pool":              dataSourceIBMContainerWorkerPool(),
-			"ibm_cr_namespaces":                      dataIBMContainerRegistryNamespaces(),
-			"ibm_cos_bucket":                         dataSourceIBMCosBucket(),
-			"ibm_cos_bucket_object":                  dataSourceIBMCosBucketObject(),
-			"ibm_dns_domain_registration":            dataSourceIBMDNSDomainRegistration(),
-			"ibm_dns_domain":                         dataSourceIBMDNSDomain(),
-			"ibm_dns_secondary":                      dataSourceIBMDNSSecondary(),
-			"ibm_event_streams_topic":                dataSourceIBMEventStreamsTopic(),
-			"ibm_iam_access_group":                   dataSourceIBMIAMAccessGroup(),
-			"ibm_iam_account_settings":               dataSourceIBMIAMAccountSettings(),
-			"ibm_iam_auth_token":                     dataSourceIBMIAMAuthToken(),
-			"ibm_iam_role_actions":                   datasourceIBMIAMRoleAction(),
-			"ibm_iam_users":                          dataSourceIBMIAMUsers(),
-			"ibm_iam_roles":                          datasourceIBMIAMRole(),
-			"ibm_iam_user_policy":                    dataSourceIBMIAMUserPolicy(),
-			"ibm_iam_user_profile":                   dataSourceIBMIAMUserProfile(),
-			"ibm_iam_service_id":                     dataSourceIBMIAMServiceID(),
-			"ibm_iam_service_policy":                 dataSourceIBMIAMServicePolicy(),
-			"ibm_iam_api_key":                        dataSourceIbmIamApiKey(),
-			"ibm_is_dedicated_host":                  dataSourceIbmIsDedicatedHost(),
-			"ibm_is_dedicated_hosts":                 dataSourceIbmIsDedicatedHosts(),
-			"ibm_is_dedicated_host_profile":          dataSourceIbmIsDedicatedHostProfile(),
-			"ibm_is_dedicated_host_profiles":         dataSourceIbmIsDedicatedHostProfiles(),
-			"ibm_is_dedicated_host_group":            dataSourceIbmIsDedicatedHostGroup(),
-			"ibm_is_dedicated_host_groups":           dataSourceIbmIsDedicatedHostGroups(),
-			"ibm_is_dedicated_host_disk":             dataSourceIbmIsDedicatedHostDisk(),
-			"ibm_is_dedicated_host_disks":            dataSourceIbmIsDedicatedHostDisks(),
-			"ibm_is_floating_ip":                     dataSourceIBMISFloatingIP(),
-			"ibm_is_flow_logs":                       dataSourceIBMISFlowLogs(),
-			"ibm_is_image":                           dataSourceIBMISImage(),
-			"ibm_is_images":                          dataSourceIBMISImages(),
-			"ibm_is_endpoint_gateway_targets":        dataSourceIBMISEndpointGatewayTargets(),
-			"ibm_is_instance_group":                  dataSourceIBMISInstanceGroup(),
-			"ibm_is_instance_group_memberships":      dataSourceIBMISInstanceGroupMemberships(),
-			"ibm_is_instance_group_membership":       dataSourceIBMISInstanceGroupMembership(),
-			"ibm_is_instance_group_manager":          dataSourceIBMISInstanceGroupManager(),
-			"ibm_is_instance_group_managers":         dataSourceIBMISInstanceGroupManagers(),
-			"ibm_is_instance_group_manager_policies": dataSourceIBMISInstanceGroupManagerPolicies(),
-			"ibm_is_instance_group_manager_policy":   dataSourceIBMISInstanceGroupManagerPolicy(),
-			"ibm_is_instance_group_manager_action":   dataSourceIBMISInstanceGroupManagerAction(),
-			"ibm_is_instance_group_manager_actions":  dataSourceIBMISInstanceGroupManagerActions(),
-			"ibm_is_virtual_endpoint_gateways":       dataSourceIBMISEndpointGateways(),
-			"ibm_is_virtual_endpoint_gateway_ips":    dataSourceIBMISEndpointGatewayIPs(),
-			"ibm_is_virtual_endpoint_gateway":        dataSourceIBMISEndpointGateway(),
-			"ibm_is_instance_templates":              dataSourceIBMISInstanceTemplates(),
-			"ibm_is_instance_profile":                dataSourceIBMISInstanceProfile(),
-			"ibm_is_instance_profiles":               dataSourceIBMISInstanceProfiles(),
-			"ibm_is_instance":                        dataSourceIBMISInstance(),
-			"ibm_is_instances":                       dataSourceIBMISInstances(),
-			"ibm_is_instance_disk":                   dataSourceIbmIsInstanceDisk(),
-			"ibm_is_instance_disks":                  dataSourceIbmIsInstanceDisks(),
-			"ibm_is_lb":                              dataSourceIBMISLB(),
-			"ibm_is_lb_profiles":                     dataSourceIBMISLbProfiles(),
-			"ibm_is_lbs":                             dataSourceIBMISLBS(),
-			"ibm_is_public_gateway":                  dataSourceIBMISPublicGateway(),
-			"ibm_is_public_gateways":                 dataSourceIBMISPublicGateways(),
-			"ibm_is_region":                          dataSourceIBMISRegion(),
-			"ibm_is_ssh_key":                         dataSourceIBMISSSHKey(),
-			"ibm_is_subnet":                          dataSourceIBMISSubnet(),
-			"ibm_is_subnets":                         dataSourceIBMISSubnets(),
-			"ibm_is_subnet_reserved_ip":              dataSourceIBMISReservedIP(),
-			"ibm_is_subnet_reserved_ips":             dataSourceIBMISReservedIPs(),
-			"ibm_is_security_group":                  dataSourceIBMISSecurityGroup(),
-			"ibm_is_security_group_target":           dataSourceIBMISSecurityGroupTarget(),
-			"ibm_is_security_group_targets":          dataSourceIBMISSecurityGroupTargets(),
-			"ibm_is_volume":                          dataSourceIBMISVolume(),
-			"ibm_is_volume_profile":                  dataSourceIBMISVolumeProfile(),
-			"ibm_is_volume_profiles":                 dataSourceIBMISVolumeProfiles(),
-			"ibm_is_vpc":                             dataSourceIBMISVPC(),
-			"ibm_is_vpn_gateways":                    dataSourceIBMISVPNGateways(),
-			"ibm_is_vpn_gateway_connections":         dataSourceIBMISVPNGatewayConnections(),
-			"ibm_is_vpc_default_routing_table":       dataSourceIBMISVPCDefaultRoutingTable(),
-			"ibm_is_vpc_routing_tables":              dataSourceIBMISVPCRoutingTables(),
-			"ibm_is_vpc_routing_table_routes":        dataSourceIBMISVPCRoutingTableRoutes(),
-			"ibm_is_zone":                            dataSourceIBMISZone(),
-			"ibm_is_zones":                           dataSourceIBMISZones(),
-			"ibm_is_operating_system":                dataSourceIBMISOperatingSystem(),
-			"ibm_is_operating_systems":               dataSourceIBMISOperatingSystems(),
-			"ibm_lbaas":                              dataSourceIBMLbaas(),
-			"ibm_network_vlan":                       dataSourceIBMNetworkVlan(),
-			"ibm_org":                                dataSourceIBMOrg(),
-			"ibm_org_quota":                          dataSourceIBMOrgQuota(),
-			"ibm_kp_key":                             dataSourceIBMkey(),
-			"ibm_kms_key_rings":                      dataSourceIBMKMSkeyRings(),
-			"ibm_kms_keys":                           dataSourceIBMKMSkeys(),
-			"ibm_pn_application_chrome":              dataSourceIBMPNApplicationChrome(),
-			"ibm_app_config_environment":             dataSourceIbmAppConfigEnvironment(),
-			"ibm_app_config_environments":            dataSourceIbmAppConfigEnvironments(),
-			"ibm_app_config_feature":                 dataSourceIbmAppConfigFeature(),
-			"ibm_app_config_features":                dataSourceIbmAppConfigFeatures(),
-			"ibm_kms_key":                            dataSourceIBMKMSkey(),
-			"ibm_resource_quota":                     dataSourceIBMResourceQuota(),
-			"ibm_resource_group":                     dataSourceIBMResourceGroup(),
-			"ibm_resource_instance":                  dataSourceIBMResourceInstance(),
-			"ibm_resource_key":                       dataSourceIBMResourceKey(),
-			"ibm_security_group":                     dataSourceIBMSecurityGroup(),
-			"ibm_service_instance":                   dataSourceIBMServiceInstance(),
-			"ibm_service_key":                        dataSourceIBMServiceKey(),
-			"ibm_service_plan":                       dataSourceIBMServicePlan(),
-			"ibm_space":                              dataSourceIBMSpace(),
+			"ibm_api_gateway":                               dataSourceIBMApiGateway(),
+			"ibm_account":                                   dataSourceIBMAccount(),
+			"ibm_app":                                       dataSourceIBMApp(),
+			"ibm_app_domain_private":                        dataSourceIBMAppDomainPrivate(),
+			"ibm_app_domain_shared":                         dataSourceIBMAppDomainShared(),
+			"ibm_app_route":                                 dataSourceIBMAppRoute(),
+			"ibm_function_action":                           dataSourceIBMFunctionAction(),
+			"ibm_function_package":                          dataSourceIBMFunctionPackage(),
+			"ibm_function_rule":                             dataSourceIBMFunctionRule(),
+			"ibm_function_trigger":                          dataSourceIBMFunctionTrigger(),
+			"ibm_function_namespace":                        dataSourceIBMFunctionNamespace(),
+			"ibm_certificate_manager_certificates":          dataIBMCertificateManagerCertificates(),
+			"ibm_certificate_manager_certificate":           dataIBMCertificateManagerCertificate(),
+			"ibm_certificate_manager_expiring_certificates": dataIBMCertificateManagerExpiringCertificates(),
+			"ibm_cis":                                       dataSourceIBMCISInstance(),
+			"ibm_cis_dns_records":                           dataSourceIBMCISDNSRecords(),
+			"ibm_cis_certificates":                          dataIBMCISCertificates(),
+			"ibm_cis_global_load_balancers":                 dataSourceIBMCISGlbs(),
+			"ibm_cis_origin_pools":                          dataSourceIBMCISOriginPools(),
+			"ibm_cis_healthchecks":                          dataSourceIBMCISHealthChecks(),
+			"ibm_cis_domain":                                dataSourceIBMCISDomain(),
+			"ibm_cis_firewall":                              dataIBMCISFirewallsRecord(),
+			"ibm_cis_cache_settings":                        dataSourceIBMCISCacheSetting(),
+			"ibm_cis_waf_packages":                          dataSourceIBMCISWAFPackages(),
+			"ibm_cis_range_apps":                            dataSourceIBMCISRangeApps(),
+			"ibm_cis_custom_certificates":                   dataSourceIBMCISCustomCertificates(),
+			"ibm_cis_rate_limit":                            dataSourceIBMCISRateLimit(),
+			"ibm_cis_ip_addresses":                          dataSourceIBMCISIP(),
+			"ibm_cis_waf_groups":                            dataSourceIBMCISWAFGroups(),
+			"ibm_cis_edge_functions_actions":                dataSourceIBMCISEdgeFunctionsActions(),
+			"ibm_cis_edge_functions_triggers":               dataSourceIBMCISEdgeFunctionsTriggers(),
+			"ibm_cis_custom_pages":                          dataSourceIBMCISCustomPages(),
+			"ibm_cis_page_rules":                            dataSourceIBMCISPageRules(),
+			"ibm_cis_waf_rules":                             dataSourceIBMCISWAFRules(),
+			"ibm_database":                                  dataSourceIBMDatabaseInstance(),
+			"ibm_compute_bare_metal":                        dataSourceIBMComputeBareMetal(),
+			"ibm_compute_image_template":                    dataSourceIBMComputeImageTemplate(),
+			"ibm_compute_placement_group":                   dataSourceIBMComputePlacementGroup(),
+			"ibm_compute_ssh_key":                           dataSourceIBMComputeSSHKey(),
+			"ibm_compute_vm_instance":                       dataSourceIBMComputeVmInstance(),
+			"ibm_container_addons":                          datasourceIBMContainerAddOns(),
+			"ibm_container_alb":                             dataSourceIBMContainerALB(),
+			"ibm_container_alb_cert":                        dataSourceIBMContainerALBCert(),
+			"ibm_container_bind_service":                    dataSourceIBMContainerBindService(),
+			"ibm_container_cluster":                         dataSourceIBMContainerCluster(),
+			"ibm_container_cluster_config":                  dataSourceIBMContainerClusterConfig(),
+			"ibm_container_cluster_versions":                dataSourceIBMContainerClusterVersions(),
+			"ibm_container_cluster_worker":                  dataSourceIBMContainerClusterWorker(),
+			"ibm_container_vpc_cluster_alb":                 dataSourceIBMContainerVPCClusterALB(),
+			"ibm_container_vpc_alb":                         dataSourceIBMContainerVPCClusterALB(),
+			"ibm_container_vpc_cluster":                     dataSourceIBMContainerVPCCluster(),
+			"ibm_container_vpc_cluster_worker":              dataSourceIBMContainerVPCClusterWorker(),
+			"ibm_container_vpc_cluster_worker_pool":         dataSourceIBMContainerVpcClusterWorkerPool(),
+			"ibm_container_vpc_worker_pool":                 dataSourceIBMContainerVpcClusterWorkerPool(),
+			"ibm_container_worker_pool":                     dataSourceIBMContainerWorkerPool(),
+			"ibm_cr_namespaces":                             dataIBMContainerRegistryNamespaces(),
+			"ibm_cr_images":                                 dataIBMContainerRegistryImages(),
+			"ibm_cr_image_digests":                          dataIBMContainerRegistryImageDigests(),
+			"ibm_cos_bucket":                                dataSourceIBMCosBucket(),
+			"ibm_cos_bucket_object":                         dataSourceIBMCosBucketObject(),
+			"ibm_cos_bucket_objects":                        dataSourceIBMCosBucketObjects(),
+			"ibm_dns_domain_registration":                   dataSourceIBMDNSDomainRegistration(),
+			"ibm_dns_domain":                                dataSourceIBMDNSDomain(),
+			"ibm_dns_secondary":                             dataSourceIBMDNSSecondary(),
+			"ibm_event_streams_topic":                       dataSourceIBMEventStreamsTopic(),
+			"ibm_iam_access_group":                          dataSourceIBMIAMAccessGroup(),
+			"ibm_iam_account_settings":                      dataSourceIBMIAMAccountSettings(),
+			"ibm_iam_auth_token":                            dataSourceIBMIAMAuthToken(),
+			"ibm_iam_role_actions":                          datasourceIBMIAMRoleAction(),
+			"ibm_iam_users":                                 dataSourceIBMIAMUsers(),
+			"ibm_iam_roles":                                 datasourceIBMIAMRole(),
+			"ibm_iam_user_policy":                           dataSourceIBMIAMUserPolicy(),
+			"ibm_iam_user_profile":                          dataSourceIBMIAMUserProfile(),
+			"ibm_iam_service_id":                            dataSourceIBMIAMServiceID(),
+			"ibm_iam_service_policy":                        dataSourceIBMIAMServicePolicy(),
+			"ibm_iam_api_key":                               dataSourceIbmIamApiKey(),
+			"ibm_is_dedicated_host":                         dataSourceIbmIsDedicatedHost(),
+			"ibm_is_dedicated_hosts":                        dataSourceIbmIsDedicatedHosts(),
+			"ibm_is_dedicated_host_profile":                 dataSourceIbmIsDedicatedHostProfile(),
+			"ibm_is_dedicated_host_profiles":                dataSourceIbmIsDedicatedHostProfiles(),
+			"ibm_is_dedicated_host_group":                   dataSourceIbmIsDedicatedHostGroup(),
+			"ibm_is_dedicated_host_groups":                  dataSourceIbmIsDedicatedHostGroups(),
+			"ibm_is_dedicated_host_disk":                    dataSourceIbmIsDedicatedHostDisk(),
+			"ibm_is_dedicated_host_disks":                   dataSourceIbmIsDedicatedHostDisks(),
+			"ibm_is_floating_ip":                            dataSourceIBMISFloatingIP(),
+			"ibm_is_flow_logs":                              dataSourceIBMISFlowLogs(),
+			"ibm_is_image":                                  dataSourceIBMISImage(),
+			"ibm_is_images":                                 dataSourceIBMISImages(),
+			"ibm_is_endpoint_gateway_targets":               dataSourceIBMISEndpointGatewayTargets(),
+			"ibm_is_instance_group":                         dataSourceIBMISInstanceGroup(),
+			"ibm_is_instance_group_memberships":             dataSourceIBMISInstanceGroupMemberships(),
+			"ibm_is_instance_group_membership":              dataSourceIBMISInstanceGroupMembership(),
+			"ibm_is_instance_group_manager":                 dataSourceIBMISInstanceGroupManager(),
+			"ibm_is_instance_group_managers":                dataSourceIBMISInstanceGroupManagers(),
+			"ibm_is_instance_group_manager_policies":        dataSourceIBMISInstanceGroupManagerPolicies(),
+			"ibm_is_instance_group_manager_policy":          dataSourceIBMISInstanceGroupManagerPolicy(),
+			"ibm_is_instance_group_manager_action":          dataSourceIBMISInstanceGroupManagerAction(),
+			"ibm_is_instance_group_manager_actions":         dataSourceIBMISInstanceGroupManagerActions(),
+			"ibm_is_virtual_endpoint_gateways":              dataSourceIBMISEndpointGateways(),
+			"ibm_is_virtual_endpoint_gateway_ips":           dataSourceIBMISEndpointGatewayIPs(),
+			"ibm_is_virtual_endpoint_gateway":               dataSourceIBMISEndpointGateway(),
+			"ibm_is_instance_templates":                     dataSourceIBMISInstanceTemplates(),
+			"ibm_is_instance_profile":                       dataSourceIBMISInstanceProfile(),
+			"ibm_is_instance_profiles":                      dataSourceIBMISInstanceProfiles(),
+			"ibm_is_instance":                               dataSourceIBMISInstance(),
+			"ibm_is_instances":                              dataSourceIBMISInstances(),
+			"ibm_is_instance_disk":                          dataSourceIbmIsInstanceDisk(),
+			"ibm_is_instance_disks":                         dataSourceIbmIsInstanceDisks(),
+			"ibm_is_lb":                                     dataSourceIBMISLB(),
+			"ibm_is_lb_profiles":                            dataSourceIBMISLbProfiles(),
+			"ibm_is_lbs":                                    dataSourceIBMISLBS(),
+			"ibm_is_public_gateway":                         dataSourceIBMISPublicGateway(),
+			"ibm_is_public_gateways":                        dataSourceIBMISPublicGateways(),
+			"ibm_is_region":                                 dataSourceIBMISRegion(),
+			"ibm_is_ssh_key":                                dataSourceIBMISSSHKey(),
+			"ibm_is_subnet":                                 dataSourceIBMISSubnet(),
+			"ibm_is_subnets":                                dataSourceIBMISSubnets(),
+			"ibm_is_subnet_reserved_ip":                     dataSourceIBMISReservedIP(),
+			"ibm_is_subnet_reserved_ips":                    dataSourceIBMISReservedIPs(),
+			"ibm_is_security_group":                         dataSourceIBMISSecurityGroup(),
+			"ibm_is_security_group_target":                  dataSourceIBMISSecurityGroupTarget(),
+			"ibm_is_security_group_targets":                 dataSourceIBMISSecurityGroupTargets(),
+			"ibm_is_volume":                                 dataSourceIBMISVolume(),
+			"ibm_is_volume_profile":                         dataSourceIBMISVolumeProfile(),
+			"ibm_is_volume_profiles":                        dataSourceIBMISVolumeProfiles(),
+			"ibm_is_vpc":                                    dataSourceIBMISVPC(),
+			"ibm_is_vpn_gateways":                           dataSourceIBMISVPNGateways(),
+			"ibm_is_vpn_gateway_connections":                dataSourceIBMISVPNGatewayConnections(),
+			"ibm_is_vpc_default_routing_table":              dataSourceIBMISVPCDefaultRoutingTable(),
+			"ibm_is_vpc_routing_tables":                     dataSourceIBMISVPCRoutingTables(),
+			"ibm_is_vpc_routing_table_routes":               dataSourceIBMISVPCRoutingTableRoutes(),
+			"ibm_is_zone":                                   dataSourceIBMISZone(),
+			"ibm_is_zones":                                  dataSourceIBMISZones(),
+			"ibm_is_operating_system":                       dataSourceIBMISOperatingSystem(),
+			"ibm_is_operating_systems":                      dataSourceIBMISOperatingSystems(),
+			"ibm_lbaas":                                     dataSourceIBMLbaas(),
+			"ibm_network_vlan":                              dataSourceIBMNetworkVlan(),
+			"ibm_org":                                       dataSourceIBMOrg(),
+			"ibm_org_quota":                                 dataSourceIBMOrgQuota(),
+			"ibm_kp_key":                                    dataSourceIBMkey(),
+			"ibm_kms_key_rings":                             dataSourceIBMKMSkeyRings(),
+			"ibm_kms_keys":                                  dataSourceIBMKMSkeys(),
+			"ibm_pn_application_chrome":                     dataSourceIBMPNApplicationChrome(),
+			"ibm_app_config_environment":                    dataSourceIbmAppConfigEnvironment(),
+			"ibm_app_config_environments":                   dataSourceIbmAppConfigEnvironments(),
+			"ibm_app_config_feature":                        dataSourceIbmAppConfigFeature(),
+			"ibm_app_config_features":                       dataSourceIbmAppConfigFeatures(),
+			"ibm_kms_key":                                   dataSourceIBMKMSkey(),
+			"ibm_resource_quota":                            dataSourceIBMResourceQuota(),
+			"ibm_resource_group":                            dataSourceIBMResourceGroup(),
+			"ibm_resource_instance":                         dataSourceIBMResourceInstance(),
+			"ibm_resource_key":                              dataSourceIBMResourceKey(),
+			"ibm_security_group":                            dataSourceIBMSecurityGroup(),
+			"ibm_service_instance":                          dataSourceIBMServiceInstance(),
+			"ibm_service_key":                               dataSourceIBMServiceKey(),
+			"ibm_service_plan":                              dataSourceIBMServicePlan(),
+			"ibm_space":                                     dataSourceIBMSpace(),
 
 			// Added for Schematics
 			"ibm_schematics_workspace": dataSourceIBMSchematicsWorkspace(),
@@ -412,6 +416,9 @@ func Provider() *schema.Provider {
 			"ibm_database":                                       resourceIBMDatabaseInstance(),
 			"ibm_certificate_manager_import":                     resourceIBMCertificateManagerImport(),
 			"ibm_certificate_manager_order":                      resourceIBMCertificateManagerOrder(),
+			"ibm_certificate_manager_notification_channel":       resourceIBMCertificateManagerNotificationChannel(),
+			"ibm_cbr_zone":                                       resourceIBMCbrZone(),
+			"ibm_cbr_rule":                                       resourceIBMCbrRule(),
 			"ibm_cis_domain":                                     resourceIBMCISDomain(),
 			"ibm_cis_domain_settings":                            resourceIBMCISSettings(),
 			"ibm_cis_firewall":                                   resourceIBMCISFirewallRecord(),
@@ -452,17 +459,28 @@ func Provider() *schema.Provider {
 			"ibm_container_vpc_worker_pool":                      resourceIBMContainerVpcWorkerPool(),
 			"ibm_container_vpc_cluster":                          resourceIBMContainerVpcCluster(),
 			"ibm_container_alb_cert":                             resourceIBMContainerALBCert(),
+			"ibm_container_ingress_secret_opaque":                resourceIBMContainerIngressSecretOpaque(),
+			"ibm_container_ingress_secret_tls":                   resourceIBMContainerIngressSecretTLS(),
+			"ibm_container_manifest":                             resourceIBMContainerManifest(),
+			"ibm_container_nlb_dns":                              resourceIBMContainerNlbDns(),
 			"ibm_container_cluster":                              resourceIBMContainerCluster(),
 			"ibm_container_cluster_feature":                      resourceIBMContainerClusterFeature(),
 			"ibm_container_bind_service":                         resourceIBMContainerBindService(),
 			"ibm_container_worker_pool":                          resourceIBMContainerWorkerPool(),
+			"ibm_container_worker_pool_autoscaling":              resourceIBMContainerWorkerPoolAutoscaling(),
+			"ibm_container_worker_update":                        resourceIBMContainerWorkerUpdate(),
 			"ibm_container_worker_pool_zone_attachment":          resourceIBMContainerWorkerPoolZoneAttachment(),
 			"ibm_cr_namespace":                                   resourceIBMCrNamespace(),
 			"ibm_cr_retention_policy":                            resourceIBMCrRetentionPolicy(),
+			"ibm_cr_image_tag":                                   resourceIBMCrImageTag(),
+			"ibm_cr_exemption":                                   resourceIBMCrExemption(),
+			"ibm_cr_quota":                                       resourceIBMCrQuota(),
 			"ibm_ob_logging":                                     resourceIBMObLogging(),
 			"ibm_ob_monitoring":                                  resourceIBMObMonitoring(),
 			"ibm_cos_bucket":                                     resourceIBMCOSBucket(),
 			"ibm_cos_bucket_object":                              resourceIBMCOSBucketObject(),
+			"ibm_cos_bucket_objects":                             resourceIBMCOSBucketObjects(),
+			"ibm_cos_bucket_public_access":                       resourceIBMCOSBucketPublicAccess(),
 			"ibm_dns_domain":                                     resourceIBMDNSDomain(),
 			"ibm_dns_domain_registration_nameservers":            resourceIBMDNSDomainRegistrationNameservers(),
 			"ibm_dns_secondary":                                  resourceIBMDNSSecondary(),
@@ -485,6 +503,10 @@ func Provider() *schema.Provider {
 			"ibm_iam_service_policy":                             resourceIBMIAMServicePolicy(),
 			"ibm_iam_user_invite":                                resourceIBMUserInvite(),
 			"ibm_iam_api_key":                                    resourceIbmIamApiKey(),
+			"ibm_iam_trusted_profile":                            resourceIBMIAMTrustedProfile(),
+			"ibm_iam_trusted_profile_claim_rule":                 resourceIBMIAMTrustedProfileClaimRule(),
+			"ibm_iam_trusted_profile_link":                       resourceIBMIAMTrustedProfileLink(),
+			"ibm_iam_trusted_profile_policy":                     resourceIBMIAMTrustedProfilePolicy(),
 			"ibm_ipsec_vpn":                                      resourceIBMIPSecVPN(),
 			"ibm_is_dedicated_host":                              resourceIbmIsDedicatedHost(),
 			"ibm_is_dedicated_host_group":                        resourceIbmIsDedicatedHostGroup(),
@@ -553,6 +575,12 @@ func Provider() *schema.Provider {
 			"ibm_kms_key":                                        resourceIBMKmskey(),
 			"ibm_kms_key_alias":                                  resourceIBMKmskeyAlias(),
 			"ibm_kms_key_rings":                                  resourceIBMKmskeyRings(),
+			"ibm_kms_key_policies":                               resourceIBMKmsKeyPolicies(),
+			"ibm_kms_instance_policies":                          resourceIBMKmsInstancePolicies(),
+			"ibm_kms_key_restore":                                resourceIBMKmsKeyRestore(),
+			"ibm_kms_key_purge":                                  resourceIBMKmsKeyPurge(),
+			"ibm_kms_import_token":                               resourceIBMKmsImportToken(),
+			"ibm_hpcs":                                           resourceIBMHPCS(),
 			"ibm_kp_key":                                         resourceIBMkey(),
 			"ibm_resource_group":                                 resourceIBMResourceGroup(),
 			"ibm_resource_instance":                              resourceIBMResourceInstance(),
@@ -581,6 +609,7 @@ func Provider() *schema.Provider {
 			"ibm_pi_volume_attach":       resourceIBMPIVolumeAttach(),
 			"ibm_pi_capture":             resourceIBMPICapture(),
 			"ibm_pi_image":               resourceIBMPIImage(),
+			"ibm_pi_image_export":        resourceIBMPIImageExport(),
 			"ibm_pi_network_port":        resourceIBMPINetworkPort(),
 			"ibm_pi_snapshot":            resourceIBMPISnapshot(),
 			"ibm_pi_network_port_attach": resourceIBMPINetworkPortAttach(),
@@ -618,10 +647,28 @@ func Provider() *schema.Provider {
 			"ibm_schematics_job":       resourceIBMSchematicsJob(),
 
 			//satellite  resources
-			"ibm_satellite_location":            resourceIBMSatelliteLocation(),
-			"ibm_satellite_host":                resourceIBMSatelliteHost(),
-			"ibm_satellite_cluster":             resourceIBMSatelliteCluster(),
-			"ibm_satellite_cluster_worker_pool": resourceIBMSatelliteClusterWorkerPool(),
+			"ibm_satellite_location":              resourceIBMSatelliteLocation(),
+			"ibm_satellite_host":                  resourceIBMSatelliteHost(),
+			"ibm_satellite_host_pool":             resourceIBMSatelliteHostPool(),
+			"ibm_satellite_cluster":               resourceIBMSatelliteCluster(),
+			"ibm_satellite_cluster_worker_pool":   resourceIBMSatelliteClusterWorkerPool(),
+			"ibm_satellite_endpoint":              resourceIBMSatelliteEndpoint(),
+			"ibm_satellite_link_source":           resourceIBMSatelliteLinkSource(),
+			"ibm_satellite_storage_configuration": resourceIBMSatelliteStorageConfiguration(),
+			"ibm_satellite_storage_assignment":    resourceIBMSatelliteStorageAssignment(),
+
+			//Added for Secrets Manager
+			"ibm_secrets_manager_arbitrary_secret":         resourceIBMSecretsManagerArbitrarySecret(),
+			"ibm_secrets_manager_username_password_secret": resourceIBMSecretsManagerUsernamePasswordSecret(),
+			"ibm_secrets_manager_iam_credentials_secret":   resourceIBMSecretsManagerIAMCredentialsSecret(),
+			"ibm_secrets_manager_imported_cert":            resourceIBMSecretsManagerImportedCert(),
+			"ibm_secrets_manager_public_cert":              resourceIBMSecretsManagerPublicCert(),
+			"ibm_secrets_manager_secret_group":             resourceIBMSecretsManagerSecretGroup(),
+			"ibm_secrets_manager_iam_credentials_config":   resourceIBMSecretsManagerIAMCredentialsConfig(),
+			"ibm_secrets_manager_public_cert_ca_config":    resourceIBMSecretsManagerPublicCertCAConfig(),
+			"ibm_secrets_manager_public_cert_dns_config":   resourceIBMSecretsManagerPublicCertDNSConfig(),
+			"ibm_secrets_manager_root_ca_config":           resourceIBMSecretsManagerRootCAConfig(),
+			"ibm_secrets_manager_intermediate_ca_config":   resourceIBMSecretsManagerIntermediateCAConfig(),
 
 			//Added for Resource Tag
 			"ibm_resource_tag": resourceIBMResourceTag(),
@@ -639,95 +686,113 @@ func Validator() ValidatorDict {
 	initOnce.Do(func() {
 		globalValidatorDict = ValidatorDict{
 			ResourceValidatorDictionary: map[string]*ResourceValidator{
-				"ibm_iam_account_settings":              resourceIBMIAMAccountSettingsValidator(),
-				"ibm_iam_custom_role":                   resourceIBMIAMCustomRoleValidator(),
-				"ibm_cis_healthcheck":                   resourceIBMCISHealthCheckValidator(),
-				"ibm_cis_rate_limit":                    resourceIBMCISRateLimitValidator(),
-				"ibm_cis":                               resourceIBMCISValidator(),
-				"ibm_cis_domain_settings":               resourceIBMCISDomainSettingValidator(),
-				"ibm_cis_tls_settings":                  resourceIBMCISTLSSettingsValidator(),
-				"ibm_cis_routing":                       resourceIBMCISRoutingValidator(),
-				"ibm_cis_page_rule":                     resourceCISPageRuleValidator(),
-				"ibm_cis_waf_package":                   resourceIBMCISWAFPackageValidator(),
-				"ibm_cis_waf_group":                     resourceIBMCISWAFGroupValidator(),
-				"ibm_cis_certificate_upload":            resourceCISCertificateUploadValidator(),
-				"ibm_cis_cache_settings":                resourceIBMCISCacheSettingsValidator(),
-				"ibm_cis_custom_page":                   resourceIBMCISCustomPageValidator(),
-				"ibm_cis_firewall":                      resourceIBMCISFirewallValidator(),
-				"ibm_cis_range_app":                     resourceIBMCISRangeAppValidator(),
-				"ibm_cis_waf_rule":                      resourceIBMCISWAFRuleValidator(),
-				"ibm_cis_certificate_order":             resourceIBMCISCertificateOrderValidator(),
-				"ibm_cr_namespace":                      resourceIBMCrNamespaceValidator(),
-				"ibm_tg_gateway":                        resourceIBMTGValidator(),
-				"ibm_app_config_feature":                resourceIbmAppConfigFeatureValidator(),
-				"ibm_tg_connection":                     resourceIBMTransitGatewayConnectionValidator(),
-				"ibm_dl_virtual_connection":             resourceIBMdlGatewayVCValidator(),
-				"ibm_dl_gateway":                        resourceIBMDLGatewayValidator(),
-				"ibm_dl_provider_gateway":               resourceIBMDLProviderGatewayValidator(),
-				"ibm_database":                          resourceIBMICDValidator(),
-				"ibm_function_package":                  resourceIBMFuncPackageValidator(),
-				"ibm_function_action":                   resourceIBMFuncActionValidator(),
-				"ibm_function_rule":                     resourceIBMFuncRuleValidator(),
-				"ibm_function_trigger":                  resourceIBMFuncTriggerValidator(),
-				"ibm_function_namespace":                resourceIBMFuncNamespaceValidator(),
-				"ibm_is_dedicated_host_group":           resourceIbmIsDedicatedHostGroupValidator(),
-				"ibm_is_dedicated_host":                 resourceIbmIsDedicatedHostValidator(),
-				"ibm_is_dedicated_host_disk_management": resourceIBMISDedicatedHostDiskManagementValidator(),
-				"ibm_is_flow_log":                       resourceIBMISFlowLogValidator(),
-				"ibm_is_instance_group":                 resourceIBMISInstanceGroupValidator(),
-				"ibm_is_instance_group_membership":      resourceIBMISInstanceGroupMembershipValidator(),
-				"ibm_is_instance_group_manager":         resourceIBMISInstanceGroupManagerValidator(),
-				"ibm_is_instance_group_manager_policy":  resourceIBMISInstanceGroupManagerPolicyValidator(),
-				"ibm_is_instance_group_manager_action":  resourceIBMISInstanceGroupManagerActionValidator(),
-				"ibm_is_floating_ip":                    resourceIBMISFloatingIPValidator(),
-				"ibm_is_ike_policy":                     resourceIBMISIKEValidator(),
-				"ibm_is_image":                          resourceIBMISImageValidator(),
-				"ibm_is_instance":                       resourceIBMISInstanceValidator(),
-				"ibm_is_instance_disk_management":       resourceIBMISInstanceDiskManagementValidator(),
-				"ibm_is_ipsec_policy":                   resourceIBMISIPSECValidator(),
-				"ibm_is_lb_listener_policy_rule":        resourceIBMISLBListenerPolicyRuleValidator(),
-				"ibm_is_lb_listener_policy":             resourceIBMISLBListenerPolicyValidator(),
-				"ibm_is_lb_listener":                    resourceIBMISLBListenerValidator(),
-				"ibm_is_lb_pool":                        resourceIBMISLBPoolValidator(),
-				"ibm_is_lb":                             resourceIBMISLBValidator(),
-				"ibm_is_network_acl":                    resourceIBMISNetworkACLValidator(),
-				"ibm_is_public_gateway":                 resourceIBMISPublicGatewayValidator(),
-				"ibm_is_security_group_target":          resourceIBMISSecurityGroupTargetValidator(),
-				"ibm_is_security_group_rule":            resourceIBMISSecurityGroupRuleValidator(),
-				"ibm_is_security_group":                 resourceIBMISSecurityGroupValidator(),
-				"ibm_is_ssh_key":                        resourceIBMISSHKeyValidator(),
-				"ibm_is_subnet":                         resourceIBMISSubnetValidator(),
-				"ibm_is_subnet_reserved_ip":             resourceIBMISSubnetReservedIPValidator(),
-				"ibm_is_volume":                         resourceIBMISVolumeValidator(),
-				"ibm_is_address_prefix":                 resourceIBMISAddressPrefixValidator(),
-				"ibm_is_route":                          resourceIBMISRouteValidator(),
-				"ibm_is_vpc":                            resourceIBMISVPCValidator(),
-				"ibm_is_vpc_routing_table":              resourceIBMISVPCRoutingTableValidator(),
-				"ibm_is_vpc_routing_table_route":        resourceIBMISVPCRoutingTableRouteValidator(),
-				"ibm_is_vpn_gateway_connection":         resourceIBMISVPNGatewayConnectionValidator(),
-				"ibm_is_vpn_gateway":                    resourceIBMISVPNGatewayValidator(),
-				"ibm_kms_key_rings":                     resourceIBMKeyRingValidator(),
-				"ibm_dns_glb_monitor":                   resourceIBMPrivateDNSGLBMonitorValidator(),
-				"ibm_dns_glb_pool":                      resourceIBMPrivateDNSGLBPoolValidator(),
-				"ibm_schematics_action":                 resourceIBMSchematicsActionValidator(),
-				"ibm_schematics_job":                    resourceIBMSchematicsJobValidator(),
-				"ibm_schematics_workspace":              resourceIBMSchematicsWorkspaceValidator(),
-				"ibm_resource_instance":                 resourceIBMResourceInstanceValidator(),
-				"ibm_is_virtual_endpoint_gateway":       resourceIBMISEndpointGatewayValidator(),
-				"ibm_container_vpc_cluster":             resourceIBMContainerVpcClusterValidator(),
-				"ibm_container_cluster":                 resourceIBMContainerClusterValidator(),
-				"ibm_resource_tag":                      resourceIBMResourceTagValidator(),
-				"ibm_satellite_location":                resourceIBMSatelliteLocationValidator(),
-				"ibm_satellite_cluster":                 resourceIBMSatelliteClusterValidator(),
+				"ibm_iam_account_settings":                     resourceIBMIAMAccountSettingsValidator(),
+				"ibm_iam_custom_role":                          resourceIBMIAMCustomRoleValidator(),
+				"ibm_iam_trusted_profile_claim_rule":           resourceIBMIAMTrustedProfileClaimRuleValidator(),
+				"ibm_iam_trusted_profile_link":                 resourceIBMIAMTrustedProfileLinkValidator(),
+				"ibm_cbr_zone":                                 resourceIBMCbrZoneValidator(),
+				"ibm_cbr_rule":                                 resourceIBMCbrRuleValidator(),
+				"ibm_cis_healthcheck":                          resourceIBMCISHealthCheckValidator(),
+				"ibm_cis_rate_limit":                           resourceIBMCISRateLimitValidator(),
+				"ibm_cis":                                      resourceIBMCISValidator(),
+				"ibm_cis_domain_settings":                      resourceIBMCISDomainSettingValidator(),
+				"ibm_cis_tls_settings":                         resourceIBMCISTLSSettingsValidator(),
+				"ibm_cis_routing":                              resourceIBMCISRoutingValidator(),
+				"ibm_cis_page_rule":                            resourceCISPageRuleValidator(),
+				"ibm_cis_waf_package":                          resourceIBMCISWAFPackageValidator(),
+				"ibm_cis_waf_group":                            resourceIBMCISWAFGroupValidator(),
+				"ibm_cis_certificate_upload":                   resourceCISCertificateUploadValidator(),
+				"ibm_cis_cache_settings":                       resourceIBMCISCacheSettingsValidator(),
+				"ibm_cis_custom_page":                          resourceIBMCISCustomPageValidator(),
+				"ibm_cis_firewall":                             resourceIBMCISFirewallValidator(),
+				"ibm_cis_range_app":                            resourceIBMCISRangeAppValidator(),
+				"ibm_cis_waf_rule":                             resourceIBMCISWAFRuleValidator(),
+				"ibm_cis_certificate_order":                    resourceIBMCISCertificateOrderValidator(),
+				"ibm_cr_namespace":                             resourceIBMCrNamespaceValidator(),
+				"ibm_tg_gateway":                               resourceIBMTGValidator(),
+				"ibm_app_config_feature":                       resourceIbmAppConfigFeatureValidator(),
+				"ibm_tg_connection":                            resourceIBMTransitGatewayConnectionValidator(),
+				"ibm_dl_virtual_connection":                    resourceIBMdlGatewayVCValidator(),
+				"ibm_dl_gateway":                               resourceIBMDLGatewayValidator(),
+				"ibm_dl_provider_gateway":                      resourceIBMDLProviderGatewayValidator(),
+				"ibm_database":                                 resourceIBMICDValidator(),
+				"ibm_function_package":                         resourceIBMFuncPackageValidator(),
+				"ibm_function_action":                          resourceIBMFuncActionValidator(),
+				"ibm_function_rule":                            resourceIBMFuncRuleValidator(),
+				"ibm_function_trigger":                         resourceIBMFuncTriggerValidator(),
+				"ibm_function_namespace":                       resourceIBMFuncNamespaceValidator(),
+				"ibm_is_dedicated_host_group":                  resourceIbmIsDedicatedHostGroupValidator(),
+				"ibm_is_dedicated_host":                        resourceIbmIsDedicatedHostValidator(),
+				"ibm_is_dedicated_host_disk_management":        resourceIBMISDedicatedHostDiskManagementValidator(),
+				"ibm_is_flow_log":                              resourceIBMISFlowLogValidator(),
+				"ibm_is_instance_group":                        resourceIBMISInstanceGroupValidator(),
+				"ibm_is_instance_group_membership":             resourceIBMISInstanceGroupMembershipValidator(),
+				"ibm_is_instance_group_manager":                resourceIBMISInstanceGroupManagerValidator(),
+				"ibm_is_instance_group_manager_policy":         resourceIBMISInstanceGroupManagerPolicyValidator(),
+				"ibm_is_instance_group_manager_action":         resourceIBMISInstanceGroupManagerActionValidator(),
+				"ibm_is_floating_ip":                           resourceIBMISFloatingIPValidator(),
+				"ibm_is_ike_policy":                            resourceIBMISIKEValidator(),
+				"ibm_is_image":                                 resourceIBMISImageValidator(),
+				"ibm_is_instance":                              resourceIBMISInstanceValidator(),
+				"ibm_is_instance_disk_management":              resourceIBMISInstanceDiskManagementValidator(),
+				"ibm_is_ipsec_policy":                          resourceIBMISIPSECValidator(),
+				"ibm_is_lb_listener_policy_rule":               resourceIBMISLBListenerPolicyRuleValidator(),
+				"ibm_is_lb_listener_policy":                    resourceIBMISLBListenerPolicyValidator(),
+				"ibm_is_lb_listener":                           resourceIBMISLBListenerValidator(),
+				"ibm_is_lb_pool":                               resourceIBMISLBPoolValidator(),
+				"ibm_is_lb":                                    resourceIBMISLBValidator(),
+				"ibm_is_network_acl":                           resourceIBMISNetworkACLValidator(),
+				"ibm_is_public_gateway":                        resourceIBMISPublicGatewayValidator(),
+				"ibm_is_security_group_target":                 resourceIBMISSecurityGroupTargetValidator(),
+				"ibm_is_security_group_rule":                   resourceIBMISSecurityGroupRuleValidator(),
+				"ibm_is_security_group":                        resourceIBMISSecurityGroupValidator(),
+				"ibm_is_ssh_key":                               resourceIBMISSHKeyValidator(),
+				"ibm_is_subnet":                                resourceIBMISSubnetValidator(),
+				"ibm_is_subnet_reserved_ip":                    resourceIBMISSubnetReservedIPValidator(),
+				"ibm_is_volume":                                resourceIBMISVolumeValidator(),
+				"ibm_is_address_prefix":                        resourceIBMISAddressPrefixValidator(),
+				"ibm_is_route":                                 resourceIBMISRouteValidator(),
+				"ibm_is_vpc":                                   resourceIBMISVPCValidator(),
+				"ibm_is_vpc_routing_table":                     resourceIBMISVPCRoutingTableValidator(),
+				"ibm_is_vpc_routing_table_route":               resourceIBMISVPCRoutingTableRouteValidator(),
+				"ibm_is_vpn_gateway_connection":                resourceIBMISVPNGatewayConnectionValidator(),
+				"ibm_is_vpn_gateway":                           resourceIBMISVPNGatewayValidator(),
+				"ibm_kms_key_rings":                            resourceIBMKeyRingValidator(),
+				"ibm_kms_key_policies":                         resourceIBMKmsKeyPoliciesValidator(),
+				"ibm_kms_instance_policies":                    resourceIBMKmsInstancePoliciesValidator(),
+				"ibm_kms_key_restore":                          resourceIBMKmsKeyRestoreValidator(),
+				"ibm_kms_key_purge":                            resourceIBMKmsKeyPurgeValidator(),
+				"ibm_kms_import_token":                         resourceIBMKmsImportTokenValidator(),
+				"ibm_hpcs":                                     resourceIBMHPCSValidator(),
+				"ibm_dns_glb_monitor":                          resourceIBMPrivateDNSGLBMonitorValidator(),
+				"ibm_dns_glb_pool":                             resourceIBMPrivateDNSGLBPoolValidator(),
+				"ibm_schematics_action":                        resourceIBMSchematicsActionValidator(),
+				"ibm_schematics_job":                           resourceIBMSchematicsJobValidator(),
+				"ibm_schematics_workspace":                     resourceIBMSchematicsWorkspaceValidator(),
+				"ibm_resource_instance":                        resourceIBMResourceInstanceValidator(),
+				"ibm_is_virtual_endpoint_gateway":              resourceIBMISEndpointGatewayValidator(),
+				"ibm_container_vpc_cluster":                    resourceIBMContainerVpcClusterValidator(),
+				"ibm_container_cluster":                        resourceIBMContainerClusterValidator(),
+				"ibm_resource_tag":                             resourceIBMResourceTagValidator(),
+				"ibm_satellite_location":                       resourceIBMSatelliteLocationValidator(),
+				"ibm_satellite_cluster":                        resourceIBMSatelliteClusterValidator(),
+				"ibm_secrets_manager_secret_group":             resourceIBMSecretsManagerSecretGroupValidator(),
+				"ibm_secrets_manager_iam_credentials_config":   resourceIBMSecretsManagerIAMCredentialsConfigValidator(),
+				"ibm_secrets_manager_public_cert_ca_config":    resourceIBMSecretsManagerPublicCertCAConfigValidator(),
+				"ibm_secrets_manager_public_cert_dns_config":   resourceIBMSecretsManagerPublicCertDNSConfigValidator(),
+				"ibm_secrets_manager_root_ca_config":           resourceIBMSecretsManagerRootCAConfigValidator(),
+				"ibm_secrets_manager_intermediate_ca_config":   resourceIBMSecretsManagerIntermediateCAConfigValidator(),
+				"ibm_certificate_manager_notification_channel": resourceIBMCertificateManagerNotificationChannelValidator(),
 			},
 			DataSourceValidatorDictionary: map[string]*ResourceValidator{
-				"ibm_is_subnet":               dataSourceIBMISSubnetValidator(),
-				"ibm_dl_offering_speeds":      datasourceIBMDLOfferingSpeedsValidator(),
-				"ibm_dl_routers":              datasourceIBMDLRoutersValidator(),
-				"ibm_is_vpc":                  dataSourceIBMISVpcValidator(),
-				"ibm_is_volume":               dataSourceIBMISVolumeValidator(),
-				"ibm_secrets_manager_secret":  datasourceIBMSecretsManagerSecretValidator(),
-				"ibm_secrets_manager_secrets": datasourceIBMSecretsManagerSecretsValidator(),
+				"ibm_is_subnet":                                 dataSourceIBMISSubnetValidator(),
+				"ibm_dl_offering_speeds":                        datasourceIBMDLOfferingSpeedsValidator(),
+				"ibm_dl_routers":                                datasourceIBMDLRoutersValidator(),
+				"ibm_is_vpc":                                    dataSourceIBMISVpcValidator(),
+				"ibm_is_volume":                                 dataSourceIBMISVolumeValidator(),
+				"ibm_secrets_manager_secret":                    datasourceIBMSecretsManagerSecretValidator(),
+				"ibm_secrets_manager_secrets":                   datasourceIBMSecretsManagerSecretsValidator(),
+				"ibm_certificate_manager_expiring_certificates": datasourceIBMCertificateManagerExpiringCertificatesValidator(),
 			},
 		}
 	})
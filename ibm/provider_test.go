@@ -74,6 +74,20 @@ var hpcsInstanceID string
 var secretsManagerInstanceID string
 var secretsManagerSecretType string
 var secretsManagerSecretID string
+var secretsManagerCertCRN string
+var secretsManagerUpdatedCertCRN string
+var secretsManagerArbitraryCRN string
+var secretsManagerPublicCertCA string
+var secretsManagerPublicCertDNS string
+var secretsManagerPublicCertCommonName string
+var secretsManagerACMEAccountPrivateKey string
+var ingressClusterName string
+var nlbIPs string
+var satelliteLocationID string
+var satelliteClusterGroup string
+
+// For Container Registry
+var crImage string
 
 // For Power Colo
 
@@ -83,6 +97,11 @@ var pi_volume_name string
 var pi_network_name string
 var pi_cloud_instance_id string
 var pi_instance_name string
+var pi_image_bucket_name string
+var pi_image_bucket_file_name string
+var pi_image_bucket_region string
+var pi_image_bucket_access_key string
+var pi_image_bucket_secret_key string
 
 // For Image
 
@@ -459,6 +478,34 @@ func init() {
 		pi_instance_name = "terraform-test-power"
 		fmt.Println("[INFO] Set the environment variable PI_PVM_INSTANCE_ID for testing pi_instance_name resource else it is set to default value 'terraform-test-power'")
 	}
+
+	pi_image_bucket_name = os.Getenv("PI_IMAGE_BUCKET_NAME")
+	if pi_image_bucket_name == "" {
+		pi_image_bucket_name = "images-public-bucket"
+		fmt.Println("[INFO] Set the environment variable PI_IMAGE_BUCKET_NAME for testing ibm_pi_image and ibm_pi_image_export resources else it is set to default value 'images-public-bucket'")
+	}
+
+	pi_image_bucket_file_name = os.Getenv("PI_IMAGE_BUCKET_FILE_NAME")
+	if pi_image_bucket_file_name == "" {
+		pi_image_bucket_file_name = "rhel.ova.gz"
+		fmt.Println("[INFO] Set the environment variable PI_IMAGE_BUCKET_FILE_NAME for testing ibm_pi_image resource else it is set to default value 'rhel.ova.gz'")
+	}
+
+	pi_image_bucket_region = os.Getenv("PI_IMAGE_BUCKET_REGION")
+	if pi_image_bucket_region == "" {
+		pi_image_bucket_region = "us-east"
+		fmt.Println("[INFO] Set the environment variable PI_IMAGE_BUCKET_REGION for testing ibm_pi_image and ibm_pi_image_export resources else it is set to default value 'us-east'")
+	}
+
+	pi_image_bucket_access_key = os.Getenv("PI_IMAGE_BUCKET_ACCESS_KEY")
+	if pi_image_bucket_access_key == "" {
+		fmt.Println("[INFO] Set the environment variable PI_IMAGE_BUCKET_ACCESS_KEY for testing ibm_pi_image and ibm_pi_image_export resources else tests will fail if this is not set correctly")
+	}
+
+	pi_image_bucket_secret_key = os.Getenv("PI_IMAGE_BUCKET_SECRET_KEY")
+	if pi_image_bucket_secret_key == "" {
+		fmt.Println("[INFO] Set the environment variable PI_IMAGE_BUCKET_SECRET_KEY for testing ibm_pi_image and ibm_pi_image_export resources else tests will fail if this is not set correctly")
+	}
 	workspaceID = os.Getenv("SCHEMATICS_WORKSPACE_ID")
 	if workspaceID == "" {
 		workspaceID = "us-south.workspace.tf-acc-test-schematics-state-test.392cd99f"
@@ -544,6 +591,66 @@ func init() {
 		fmt.Println("[WARN] Set the environment variable SECRETS_MANAGER_SECRET_ID for testing data_source_ibm_secrets_manager_secret_test else tests will fail if this is not set correctly")
 	}
 
+	secretsManagerCertCRN = os.Getenv("SECRETS_MANAGER_CERT_CRN")
+	if secretsManagerCertCRN == "" {
+		fmt.Println("[WARN] Set the environment variable SECRETS_MANAGER_CERT_CRN for testing ibm_container_ingress_secret_tls resource else tests will fail if this is not set correctly")
+	}
+
+	secretsManagerUpdatedCertCRN = os.Getenv("SECRETS_MANAGER_UPDATED_CERT_CRN")
+	if secretsManagerUpdatedCertCRN == "" {
+		fmt.Println("[WARN] Set the environment variable SECRETS_MANAGER_UPDATED_CERT_CRN for testing ibm_container_ingress_secret_tls resource else tests will fail if this is not set correctly")
+	}
+
+	secretsManagerArbitraryCRN = os.Getenv("SECRETS_MANAGER_ARBITRARY_SECRET_CRN")
+	if secretsManagerArbitraryCRN == "" {
+		fmt.Println("[WARN] Set the environment variable SECRETS_MANAGER_ARBITRARY_SECRET_CRN for testing ibm_container_ingress_secret_opaque resource else tests will fail if this is not set correctly")
+	}
+
+	secretsManagerPublicCertCA = os.Getenv("SECRETS_MANAGER_PUBLIC_CERT_CA")
+	if secretsManagerPublicCertCA == "" {
+		fmt.Println("[WARN] Set the environment variable SECRETS_MANAGER_PUBLIC_CERT_CA with the name of a certificate authority configuration for testing ibm_secrets_manager_public_cert resource else tests will fail if this is not set correctly")
+	}
+
+	secretsManagerPublicCertDNS = os.Getenv("SECRETS_MANAGER_PUBLIC_CERT_DNS")
+	if secretsManagerPublicCertDNS == "" {
+		fmt.Println("[WARN] Set the environment variable SECRETS_MANAGER_PUBLIC_CERT_DNS with the name of a DNS provider configuration for testing ibm_secrets_manager_public_cert resource else tests will fail if this is not set correctly")
+	}
+
+	secretsManagerPublicCertCommonName = os.Getenv("SECRETS_MANAGER_PUBLIC_CERT_COMMON_NAME")
+	if secretsManagerPublicCertCommonName == "" {
+		fmt.Println("[WARN] Set the environment variable SECRETS_MANAGER_PUBLIC_CERT_COMMON_NAME with a domain of the DNS provider for testing ibm_secrets_manager_public_cert resource else tests will fail if this is not set correctly")
+	}
+
+	secretsManagerACMEAccountPrivateKey = os.Getenv("SECRETS_MANAGER_ACME_ACCOUNT_PRIVATE_KEY")
+	if secretsManagerACMEAccountPrivateKey == "" {
+		fmt.Println("[WARN] Set the environment variable SECRETS_MANAGER_ACME_ACCOUNT_PRIVATE_KEY with the PEM private key of a Let's Encrypt account for testing ibm_secrets_manager_public_cert_ca_config resource else tests will fail if this is not set correctly")
+	}
+
+	ingressClusterName = os.Getenv("IBM_INGRESS_CLUSTER_NAME")
+	if ingressClusterName == "" {
+		fmt.Println("[WARN] Set the environment variable IBM_INGRESS_CLUSTER_NAME with the name of a classic cluster for testing ibm_container_ingress_secret_tls, ibm_container_ingress_secret_opaque and ibm_container_nlb_dns resources else tests will fail if this is not set correctly")
+	}
+
+	nlbIPs = os.Getenv("IBM_NLB_IPS")
+	if nlbIPs == "" {
+		fmt.Println("[WARN] Set the environment variable IBM_NLB_IPS with comma separated NLB IPs of the cluster IBM_INGRESS_CLUSTER_NAME for testing ibm_container_nlb_dns resource else tests will fail if this is not set correctly")
+	}
+
+	satelliteLocationID = os.Getenv("IBM_SATELLITE_LOCATION_ID")
+	if satelliteLocationID == "" {
+		fmt.Println("[WARN] Set the environment variable IBM_SATELLITE_LOCATION_ID with the ID of a Satellite location for testing ibm_satellite_endpoint and ibm_satellite_link_source resources else tests will fail if this is not set correctly")
+	}
+
+	satelliteClusterGroup = os.Getenv("IBM_SATELLITE_CLUSTER_GROUP")
+	if satelliteClusterGroup == "" {
+		fmt.Println("[WARN] Set the environment variable IBM_SATELLITE_CLUSTER_GROUP with the name of a Satellite cluster group for testing ibm_satellite_storage_assignment resource else tests will fail if this is not set correctly")
+	}
+
+	crImage = os.Getenv("IBM_CR_IMAGE")
+	if crImage == "" {
+		fmt.Println("[WARN] Set the environment variable IBM_CR_IMAGE with an image in the format <REGISTRY>/<NAMESPACE>/<REPOSITORY>:<TAG> for testing ibm_cr_image_tag resource else tests will fail if this is not set correctly")
+	}
+
 	tg_cross_network_account_id = os.Getenv("IBM_TG_CROSS_ACCOUNT_ID")
 	if tg_cross_network_account_id == "" {
 		fmt.Println("[INFO] Set the environment variable IBM_TG_CROSS_ACCOUNT_ID for testing ibm_tg_connection resource else  tests will fail if this is not set correctly")
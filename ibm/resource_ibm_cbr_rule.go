@@ -0,0 +1,316 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMCbrRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCbrRuleCreate,
+		ReadContext:   resourceIBMCbrRuleRead,
+		UpdateContext: resourceIBMCbrRuleUpdate,
+		DeleteContext: resourceIBMCbrRuleDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the rule",
+			},
+			"contexts": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The contexts that are allowed to access the resources of the rule",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: "The attributes of the context, networkZoneId and endpointType",
+							Elem:        cbrRuleAttributeSchema(false),
+						},
+					},
+				},
+			},
+			"resources": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The resources that the rule applies to",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attributes": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: "The attributes of the resource, for example accountId, serviceName and serviceInstance",
+							Elem:        cbrRuleAttributeSchema(true),
+						},
+						"tags": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The access tags of the resource",
+							Elem:        cbrRuleAttributeSchema(true),
+						},
+					},
+				},
+			},
+			"enforcement_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "enabled",
+				ValidateFunc: InvokeValidator("ibm_cbr_rule", "enforcement_mode"),
+				Description:  "The enforcement mode of the rule: enabled, disabled or report",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the rule",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The href link to the rule",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation date of the rule",
+			},
+			"created_by_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IAM ID of the user or service which created the rule",
+			},
+			"last_modified_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last modification date of the rule",
+			},
+			"last_modified_by_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IAM ID of the user or service which last modified the rule",
+			},
+		},
+	}
+}
+
+// cbrRuleAttributeSchema returns the schema of the attributes of the contexts
+// and resources of a rule, only the resource attributes have an operator
+func cbrRuleAttributeSchema(withOperator bool) *schema.Resource {
+	attributeSchema := map[string]*schema.Schema{
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The name of the attribute",
+		},
+		"value": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The value of the attribute",
+		},
+	}
+	if withOperator {
+		attributeSchema["operator"] = &schema.Schema{
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			Description: "The operator of the attribute",
+		}
+	}
+	return &schema.Resource{Schema: attributeSchema}
+}
+
+func resourceIBMCbrRuleValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "enforcement_mode",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "enabled, disabled, report",
+		},
+	)
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_cbr_rule", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func expandCbrRuleAttributes(list []interface{}) []cbrAttribute {
+	attributes := make([]cbrAttribute, 0, len(list))
+	for _, a := range list {
+		attribute, _ := a.(map[string]interface{})
+		if attribute == nil {
+			continue
+		}
+		cbrAttr := cbrAttribute{
+			Name:  attribute["name"].(string),
+			Value: attribute["value"].(string),
+		}
+		if operator, ok := attribute["operator"]; ok {
+			cbrAttr.Operator = operator.(string)
+		}
+		attributes = append(attributes, cbrAttr)
+	}
+	return attributes
+}
+
+func flattenCbrRuleAttributes(list []cbrAttribute, withOperator bool) []map[string]interface{} {
+	attributes := make([]map[string]interface{}, len(list))
+	for i, a := range list {
+		attribute := map[string]interface{}{
+			"name":  a.Name,
+			"value": a.Value,
+		}
+		if withOperator {
+			attribute["operator"] = a.Operator
+		}
+		attributes[i] = attribute
+	}
+	return attributes
+}
+
+func expandCbrRule(d *schema.ResourceData) cbrRule {
+	rule := cbrRule{
+		Description:     d.Get("description").(string),
+		EnforcementMode: d.Get("enforcement_mode").(string),
+		Contexts:        []cbrRuleContext{},
+		Resources:       []cbrRuleResource{},
+	}
+	for _, c := range d.Get("contexts").([]interface{}) {
+		ruleContext, _ := c.(map[string]interface{})
+		if ruleContext == nil {
+			continue
+		}
+		rule.Contexts = append(rule.Contexts, cbrRuleContext{
+			Attributes: expandCbrRuleAttributes(ruleContext["attributes"].([]interface{})),
+		})
+	}
+	for _, r := range d.Get("resources").([]interface{}) {
+		ruleResource, _ := r.(map[string]interface{})
+		if ruleResource == nil {
+			continue
+		}
+		rule.Resources = append(rule.Resources, cbrRuleResource{
+			Attributes: expandCbrRuleAttributes(ruleResource["attributes"].([]interface{})),
+			Tags:       expandCbrRuleAttributes(ruleResource["tags"].([]interface{})),
+		})
+	}
+	return rule
+}
+
+func resourceIBMCbrRuleCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cbrClient, err := meta.(ClientSession).CbrV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rule := &cbrRule{}
+	response, err := cbrRequest(context, cbrClient, core.POST, `/v1/rules`, nil, "", expandCbrRule(d), rule)
+	if err != nil {
+		log.Printf("[DEBUG] CreateRule failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error creating rule: %s", err))
+	}
+
+	d.SetId(rule.ID)
+
+	return resourceIBMCbrRuleRead(context, d, meta)
+}
+
+func resourceIBMCbrRuleRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cbrClient, err := meta.(ClientSession).CbrV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rule := &cbrRule{}
+	response, err := cbrRequest(context, cbrClient, core.GET, `/v1/rules/{rule_id}`, map[string]string{"rule_id": d.Id()}, "", nil, rule)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetRule failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error retrieving rule: %s", err))
+	}
+
+	contexts := make([]map[string]interface{}, len(rule.Contexts))
+	for i, c := range rule.Contexts {
+		contexts[i] = map[string]interface{}{
+			"attributes": flattenCbrRuleAttributes(c.Attributes, false),
+		}
+	}
+	resources := make([]map[string]interface{}, len(rule.Resources))
+	for i, r := range rule.Resources {
+		resources[i] = map[string]interface{}{
+			"attributes": flattenCbrRuleAttributes(r.Attributes, true),
+			"tags":       flattenCbrRuleAttributes(r.Tags, true),
+		}
+	}
+
+	d.Set("description", rule.Description)
+	d.Set("contexts", contexts)
+	d.Set("resources", resources)
+	if rule.EnforcementMode != "" {
+		d.Set("enforcement_mode", rule.EnforcementMode)
+	}
+	d.Set("crn", rule.CRN)
+	d.Set("href", rule.Href)
+	d.Set("created_at", rule.CreatedAt)
+	d.Set("created_by_id", rule.CreatedByID)
+	d.Set("last_modified_at", rule.LastModifiedAt)
+	d.Set("last_modified_by_id", rule.LastModifiedByID)
+
+	return nil
+}
+
+func resourceIBMCbrRuleUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cbrClient, err := meta.(ClientSession).CbrV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("description", "contexts", "resources", "enforcement_mode") {
+		// The rule is replaced with the ETag of the current version
+		response, err := cbrRequest(context, cbrClient, core.GET, `/v1/rules/{rule_id}`, map[string]string{"rule_id": d.Id()}, "", nil, &cbrRule{})
+		if err != nil {
+			log.Printf("[DEBUG] GetRule failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error retrieving rule: %s", err))
+		}
+
+		response, err = cbrRequest(context, cbrClient, core.PUT, `/v1/rules/{rule_id}`, map[string]string{"rule_id": d.Id()}, response.Headers.Get("ETag"), expandCbrRule(d), nil)
+		if err != nil {
+			log.Printf("[DEBUG] ReplaceRule failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error updating rule: %s", err))
+		}
+	}
+
+	return resourceIBMCbrRuleRead(context, d, meta)
+}
+
+func resourceIBMCbrRuleDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cbrClient, err := meta.(ClientSession).CbrV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := cbrRequest(context, cbrClient, core.DELETE, `/v1/rules/{rule_id}`, map[string]string{"rule_id": d.Id()}, "", nil, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteRule failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting rule: %s", err))
+	}
+
+	d.SetId("")
+
+	return nil
+}
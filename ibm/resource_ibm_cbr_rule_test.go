@@ -0,0 +1,106 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMCbrRule_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-cbr-zone-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCbrRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCbrRuleBasic(name, "report"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cbr_rule.rule", "enforcement_mode", "report"),
+					resource.TestCheckResourceAttr("ibm_cbr_rule.rule", "contexts.0.attributes.#", "2"),
+					resource.TestCheckResourceAttr("ibm_cbr_rule.rule", "resources.0.attributes.#", "2"),
+					resource.TestCheckResourceAttrSet("ibm_cbr_rule.rule", "crn"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCbrRuleBasic(name, "enabled"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cbr_rule.rule", "enforcement_mode", "enabled"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_cbr_rule.rule",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCbrRuleDestroy(s *terraform.State) error {
+	cbrClient, err := testAccProvider.Meta().(ClientSession).CbrV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cbr_rule" {
+			continue
+		}
+
+		response, err := cbrRequest(context.Background(), cbrClient, core.GET, `/v1/rules/{rule_id}`, map[string]string{"rule_id": rs.Primary.ID}, "", nil, nil)
+		if err == nil {
+			return fmt.Errorf("Rule still exists: %s", rs.Primary.ID)
+		} else if response == nil || response.StatusCode != 404 {
+			return fmt.Errorf("Error waiting for rule (%s) to be destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMCbrRuleBasic(name, enforcementMode string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cbr_zone" "zone" {
+			name = "%s"
+			addresses {
+				type  = "subnet"
+				value = "10.240.0.0/24"
+			}
+		}
+
+		resource "ibm_cbr_rule" "rule" {
+			description      = "Rule of the test scenario"
+			enforcement_mode = "%s"
+			contexts {
+				attributes {
+					name  = "networkZoneId"
+					value = ibm_cbr_zone.zone.id
+				}
+				attributes {
+					name  = "endpointType"
+					value = "private"
+				}
+			}
+			resources {
+				attributes {
+					name  = "accountId"
+					value = ibm_cbr_zone.zone.account_id
+				}
+				attributes {
+					name     = "serviceName"
+					value    = "cloud-object-storage"
+					operator = "stringEquals"
+				}
+			}
+		}
+	`, name, enforcementMode)
+}
@@ -0,0 +1,389 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMCbrZone() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCbrZoneCreate,
+		ReadContext:   resourceIBMCbrZoneRead,
+		UpdateContext: resourceIBMCbrZoneUpdate,
+		DeleteContext: resourceIBMCbrZoneDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMCbrZoneCustomizeDiff(diff)
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the zone",
+			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The ID of the account of the zone, defaults to the account of the provider",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the zone",
+			},
+			"addresses": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "The addresses of the zone",
+				Elem:        cbrZoneAddressSchema(true),
+			},
+			"excluded": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The addresses that are excluded from the addresses of the zone",
+				Elem:        cbrZoneAddressSchema(false),
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the zone",
+			},
+			"address_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of addresses of the zone",
+			},
+			"excluded_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of excluded addresses of the zone",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The href link to the zone",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The creation date of the zone",
+			},
+			"created_by_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IAM ID of the user or service which created the zone",
+			},
+			"last_modified_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last modification date of the zone",
+			},
+			"last_modified_by_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IAM ID of the user or service which last modified the zone",
+			},
+		},
+	}
+}
+
+// cbrZoneAddressSchema returns the schema of the addresses of a zone, the
+// excluded addresses are only ip addresses, ranges and subnets
+func cbrZoneAddressSchema(withRefs bool) *schema.Resource {
+	addressSchema := map[string]*schema.Schema{
+		"type": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ValidateFunc: InvokeValidator("ibm_cbr_zone", "type"),
+			Description:  "The type of the address: ipAddress, ipRange, subnet, vpc or serviceRef",
+		},
+		"value": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The IP address, range (first-last), subnet (CIDR) or VPC CRN of the address",
+		},
+	}
+	if withRefs {
+		addressSchema["ref"] = &schema.Schema{
+			Type:        schema.TypeList,
+			Optional:    true,
+			MaxItems:    1,
+			Description: "The service of a serviceRef address",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"account_id": {
+						Type:        schema.TypeString,
+						Required:    true,
+						Description: "The ID of the account of the service",
+					},
+					"service_type": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The type of the service",
+					},
+					"service_name": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The name of the service",
+					},
+					"service_instance": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The instance of the service",
+					},
+					"location": {
+						Type:        schema.TypeString,
+						Optional:    true,
+						Description: "The location of the service",
+					},
+				},
+			},
+		}
+	}
+	return &schema.Resource{Schema: addressSchema}
+}
+
+func resourceIBMCbrZoneValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "ipAddress, ipRange, subnet, vpc, serviceRef",
+		},
+	)
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_cbr_zone", Schema: validateSchema}
+	return &resourceValidator
+}
+
+// resourceIBMCbrZoneCustomizeDiff validates the addresses of the zone for
+// their type, values that are known after apply are validated on apply
+func resourceIBMCbrZoneCustomizeDiff(diff *schema.ResourceDiff) error {
+	for _, key := range []string{"addresses", "excluded"} {
+		for i, a := range diff.Get(key).([]interface{}) {
+			address, _ := a.(map[string]interface{})
+			if address == nil {
+				continue
+			}
+			addressType := address["type"].(string)
+			value := address["value"].(string)
+			if key == "excluded" && (addressType == "vpc" || addressType == "serviceRef") {
+				return fmt.Errorf("%s.%d.type: %s addresses cannot be excluded", key, i, addressType)
+			}
+			if value == "" {
+				continue
+			}
+			if err := validateCbrAddress(addressType, value, fmt.Sprintf("%s.%d.value", key, i)); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func expandCbrZoneAddresses(list []interface{}) ([]cbrAddress, error) {
+	addresses := make([]cbrAddress, 0, len(list))
+	for _, a := range list {
+		address, _ := a.(map[string]interface{})
+		if address == nil {
+			continue
+		}
+		cbrAddr := cbrAddress{
+			Type:  address["type"].(string),
+			Value: address["value"].(string),
+		}
+		if cbrAddr.Type == "serviceRef" {
+			refs, _ := address["ref"].([]interface{})
+			if len(refs) == 0 || refs[0] == nil {
+				return nil, fmt.Errorf("ref is required for serviceRef addresses")
+			}
+			ref := refs[0].(map[string]interface{})
+			cbrAddr.Value = ""
+			cbrAddr.Ref = &cbrServiceRef{
+				AccountID:       ref["account_id"].(string),
+				ServiceType:     ref["service_type"].(string),
+				ServiceName:     ref["service_name"].(string),
+				ServiceInstance: ref["service_instance"].(string),
+				Location:        ref["location"].(string),
+			}
+		} else {
+			if cbrAddr.Value == "" {
+				return nil, fmt.Errorf("value is required for %s addresses", cbrAddr.Type)
+			}
+			if err := validateCbrAddress(cbrAddr.Type, cbrAddr.Value, "value"); err != nil {
+				return nil, err
+			}
+		}
+		addresses = append(addresses, cbrAddr)
+	}
+	return addresses, nil
+}
+
+func flattenCbrZoneAddresses(list []cbrAddress, withRefs bool) []map[string]interface{} {
+	addresses := make([]map[string]interface{}, len(list))
+	for i, a := range list {
+		address := map[string]interface{}{
+			"type":  a.Type,
+			"value": a.Value,
+		}
+		if withRefs && a.Ref != nil {
+			address["ref"] = []map[string]interface{}{
+				{
+					"account_id":       a.Ref.AccountID,
+					"service_type":     a.Ref.ServiceType,
+					"service_name":     a.Ref.ServiceName,
+					"service_instance": a.Ref.ServiceInstance,
+					"location":         a.Ref.Location,
+				},
+			}
+		}
+		addresses[i] = address
+	}
+	return addresses
+}
+
+func expandCbrZone(d *schema.ResourceData, meta interface{}) (cbrZone, error) {
+	zone := cbrZone{
+		Name:        d.Get("name").(string),
+		AccountID:   d.Get("account_id").(string),
+		Description: d.Get("description").(string),
+	}
+	if zone.AccountID == "" {
+		userDetails, err := meta.(ClientSession).BluemixUserDetails()
+		if err != nil {
+			return zone, err
+		}
+		zone.AccountID = userDetails.userAccount
+	}
+
+	var err error
+	zone.Addresses, err = expandCbrZoneAddresses(d.Get("addresses").([]interface{}))
+	if err != nil {
+		return zone, err
+	}
+	zone.Excluded, err = expandCbrZoneAddresses(d.Get("excluded").([]interface{}))
+	if err != nil {
+		return zone, err
+	}
+	return zone, nil
+}
+
+func resourceIBMCbrZoneCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cbrClient, err := meta.(ClientSession).CbrV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request, err := expandCbrZone(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zone := &cbrZone{}
+	response, err := cbrRequest(context, cbrClient, core.POST, `/v1/zones`, nil, "", request, zone)
+	if err != nil {
+		log.Printf("[DEBUG] CreateZone failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error creating zone: %s", err))
+	}
+
+	d.SetId(zone.ID)
+
+	return resourceIBMCbrZoneRead(context, d, meta)
+}
+
+func resourceIBMCbrZoneRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cbrClient, err := meta.(ClientSession).CbrV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	zone := &cbrZone{}
+	response, err := cbrRequest(context, cbrClient, core.GET, `/v1/zones/{zone_id}`, map[string]string{"zone_id": d.Id()}, "", nil, zone)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetZone failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error retrieving zone: %s", err))
+	}
+
+	d.Set("name", zone.Name)
+	d.Set("account_id", zone.AccountID)
+	d.Set("description", zone.Description)
+	d.Set("addresses", flattenCbrZoneAddresses(zone.Addresses, true))
+	d.Set("excluded", flattenCbrZoneAddresses(zone.Excluded, false))
+	d.Set("crn", zone.CRN)
+	d.Set("address_count", zone.AddressCount)
+	d.Set("excluded_count", zone.ExcludedCount)
+	d.Set("href", zone.Href)
+	d.Set("created_at", zone.CreatedAt)
+	d.Set("created_by_id", zone.CreatedByID)
+	d.Set("last_modified_at", zone.LastModifiedAt)
+	d.Set("last_modified_by_id", zone.LastModifiedByID)
+
+	return nil
+}
+
+func resourceIBMCbrZoneUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cbrClient, err := meta.(ClientSession).CbrV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "description", "addresses", "excluded") {
+		// The zone is replaced with the ETag of the current version
+		response, err := cbrRequest(context, cbrClient, core.GET, `/v1/zones/{zone_id}`, map[string]string{"zone_id": d.Id()}, "", nil, &cbrZone{})
+		if err != nil {
+			log.Printf("[DEBUG] GetZone failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error retrieving zone: %s", err))
+		}
+
+		request, err := expandCbrZone(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		response, err = cbrRequest(context, cbrClient, core.PUT, `/v1/zones/{zone_id}`, map[string]string{"zone_id": d.Id()}, response.Headers.Get("ETag"), request, nil)
+		if err != nil {
+			log.Printf("[DEBUG] ReplaceZone failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error updating zone: %s", err))
+		}
+	}
+
+	return resourceIBMCbrZoneRead(context, d, meta)
+}
+
+func resourceIBMCbrZoneDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cbrClient, err := meta.(ClientSession).CbrV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := cbrRequest(context, cbrClient, core.DELETE, `/v1/zones/{zone_id}`, map[string]string{"zone_id": d.Id()}, "", nil, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteZone failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting zone: %s", err))
+	}
+
+	d.SetId("")
+
+	return nil
+}
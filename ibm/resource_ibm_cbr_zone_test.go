@@ -0,0 +1,122 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v4/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMCbrZone_Basic(t *testing.T) {
+	name := fmt.Sprintf("tf-cbr-zone-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCbrZoneDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCbrZoneBasic(name, "169.23.56.234"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cbr_zone.zone", "name", name),
+					resource.TestCheckResourceAttr("ibm_cbr_zone.zone", "addresses.#", "3"),
+					resource.TestCheckResourceAttr("ibm_cbr_zone.zone", "excluded.#", "1"),
+					resource.TestCheckResourceAttrSet("ibm_cbr_zone.zone", "crn"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCbrZoneBasic(name, "169.23.56.235"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cbr_zone.zone", "addresses.0.value", "169.23.56.235"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_cbr_zone.zone",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestValidateCbrAddress(t *testing.T) {
+	valid := map[string][]string{
+		"ipAddress": {"169.23.56.234", "2001:db8::1"},
+		"ipRange":   {"169.23.22.0-169.23.22.255"},
+		"subnet":    {"10.0.0.0/24", "2001:db8::/64"},
+		"vpc":       {"crn:v1:bluemix:public:is:us-south:a/12ab34cd56ef78ab90cd12ef34ab56cd::vpc:r006-4727d842-f94f-4a2d-824a-9bc9b02c523b"},
+	}
+	invalid := map[string][]string{
+		"ipAddress": {"169.23.56", "10.0.0.0/24"},
+		"ipRange":   {"169.23.22.0", "169.23.22.0-", "169.23.22.0-169.23.22.0/24"},
+		"subnet":    {"10.0.0.0", "10.0.0.0/33"},
+	}
+
+	for addressType, values := range valid {
+		for _, value := range values {
+			if err := validateCbrAddress(addressType, value, "value"); err != nil {
+				t.Errorf("%s %s: unexpected error %s", addressType, value, err)
+			}
+		}
+	}
+	for addressType, values := range invalid {
+		for _, value := range values {
+			if err := validateCbrAddress(addressType, value, "value"); err == nil {
+				t.Errorf("%s %s: expected an error", addressType, value)
+			}
+		}
+	}
+}
+
+func testAccCheckIBMCbrZoneDestroy(s *terraform.State) error {
+	cbrClient, err := testAccProvider.Meta().(ClientSession).CbrV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cbr_zone" {
+			continue
+		}
+
+		response, err := cbrRequest(context.Background(), cbrClient, core.GET, `/v1/zones/{zone_id}`, map[string]string{"zone_id": rs.Primary.ID}, "", nil, nil)
+		if err == nil {
+			return fmt.Errorf("Zone still exists: %s", rs.Primary.ID)
+		} else if response == nil || response.StatusCode != 404 {
+			return fmt.Errorf("Error waiting for zone (%s) to be destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMCbrZoneBasic(name, ipAddress string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cbr_zone" "zone" {
+			name        = "%s"
+			description = "Zone of the test scenario"
+			addresses {
+				type  = "ipAddress"
+				value = "%s"
+			}
+			addresses {
+				type  = "ipRange"
+				value = "169.23.22.0-169.23.22.255"
+			}
+			addresses {
+				type  = "subnet"
+				value = "10.240.0.0/24"
+			}
+			excluded {
+				type  = "ipAddress"
+				value = "169.23.22.10"
+			}
+		}
+	`, name, ipAddress)
+}
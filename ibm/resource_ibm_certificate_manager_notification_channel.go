@@ -0,0 +1,234 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"log"
+	gohttp "net/http"
+	"net/url"
+	"strings"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMCertificateManagerNotificationChannel() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCertificateManagerNotificationChannelCreate,
+		Read:     resourceIBMCertificateManagerNotificationChannelRead,
+		Update:   resourceIBMCertificateManagerNotificationChannelUpdate,
+		Delete:   resourceIBMCertificateManagerNotificationChannelDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"certificate_manager_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Certificate Manager Instance ID",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: InvokeValidator("ibm_certificate_manager_notification_channel", "type"),
+				Description:  "The type of the notification channel: url, slack or event_notifications",
+			},
+			"endpoint": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The webhook URL of the url and slack channels, or the CRN of the Event Notifications instance",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the notification channel",
+			},
+			"is_active": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "If set to false, no notifications are sent to the channel",
+			},
+			"channel_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the notification channel",
+			},
+			"version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The version of the notification payload sent to the channel",
+			},
+		},
+	}
+}
+
+func resourceIBMCertificateManagerNotificationChannelValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "url, slack, event_notifications",
+		},
+	)
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_certificate_manager_notification_channel", Schema: validateSchema}
+	return &resourceValidator
+}
+
+// certificateManagerNotificationChannel is a notification channel of the
+// Certificate Manager v1 API, the channels aren't supported by the client
+type certificateManagerNotificationChannel struct {
+	ID          string `json:"_id,omitempty"`
+	Type        string `json:"type"`
+	Endpoint    string `json:"endpoint"`
+	Description string `json:"description,omitempty"`
+	IsActive    *bool  `json:"is_active,omitempty"`
+	Version     int    `json:"version,omitempty"`
+}
+
+// certificateManagerRESTClient is the bluemix-go client embedded in the
+// Certificate Manager API of the session, the notification channels aren't
+// supported by the API so the requests are sent with its client
+type certificateManagerRESTClient interface {
+	Get(path string, respV interface{}, extraHeader ...interface{}) (*gohttp.Response, error)
+	Post(path string, data interface{}, respV interface{}, extraHeader ...interface{}) (*gohttp.Response, error)
+	Put(path string, data interface{}, respV interface{}, extraHeader ...interface{}) (*gohttp.Response, error)
+	Delete(path string, extraHeader ...interface{}) (*gohttp.Response, error)
+}
+
+// certificateManagerRequest sends a request to the Certificate Manager API
+// with the client of the session, which refreshes the token and retries
+func certificateManagerRequest(meta interface{}, method, path string, body, result interface{}) error {
+	cmService, err := meta.(ClientSession).CertificateManagerAPI()
+	if err != nil {
+		return err
+	}
+	cmClient, ok := cmService.(certificateManagerRESTClient)
+	if !ok {
+		return fmt.Errorf("The Certificate Manager client doesn't support %s requests", method)
+	}
+
+	switch method {
+	case "GET":
+		_, err = cmClient.Get(path, result)
+	case "POST":
+		_, err = cmClient.Post(path, body, result)
+	case "PUT":
+		_, err = cmClient.Put(path, body, result)
+	case "DELETE":
+		_, err = cmClient.Delete(path)
+	default:
+		err = fmt.Errorf("Unsupported method %s", method)
+	}
+	return err
+}
+
+func certificateManagerChannelsPath(instanceID string) string {
+	return fmt.Sprintf("/api/v1/instances/%s/notifications/channels", url.QueryEscape(instanceID))
+}
+
+// parseCertificateManagerChannelID returns the instance ID and the channel ID
+// of the ID of a channel resource, the ID has the format of a certificate ID
+func parseCertificateManagerChannelID(id string) (string, string, error) {
+	parts := strings.Split(id, ":channel:")
+	if len(parts) != 2 {
+		return "", "", fmt.Errorf("Incorrect ID %s: ID should be a combination of instanceCRN:channel:channelID", id)
+	}
+	return parts[0] + "::", parts[1], nil
+}
+
+func expandCertificateManagerNotificationChannel(d *schema.ResourceData) certificateManagerNotificationChannel {
+	isActive := d.Get("is_active").(bool)
+	return certificateManagerNotificationChannel{
+		Type:        d.Get("type").(string),
+		Endpoint:    d.Get("endpoint").(string),
+		Description: d.Get("description").(string),
+		IsActive:    &isActive,
+	}
+}
+
+func resourceIBMCertificateManagerNotificationChannelCreate(d *schema.ResourceData, meta interface{}) error {
+	instanceID := d.Get("certificate_manager_instance_id").(string)
+
+	channel := certificateManagerNotificationChannel{}
+	err := certificateManagerRequest(meta, "POST", certificateManagerChannelsPath(instanceID), expandCertificateManagerNotificationChannel(d), &channel)
+	if err != nil {
+		return fmt.Errorf("Error creating notification channel: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s:channel:%s", strings.TrimSuffix(instanceID, "::"), channel.ID))
+
+	return resourceIBMCertificateManagerNotificationChannelRead(d, meta)
+}
+
+func resourceIBMCertificateManagerNotificationChannelRead(d *schema.ResourceData, meta interface{}) error {
+	instanceID, channelID, err := parseCertificateManagerChannelID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	channel := certificateManagerNotificationChannel{}
+	err = certificateManagerRequest(meta, "GET", fmt.Sprintf("%s/%s", certificateManagerChannelsPath(instanceID), channelID), nil, &channel)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			log.Printf("[WARN] Notification channel %s is not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving notification channel: %s", err)
+	}
+
+	d.Set("certificate_manager_instance_id", instanceID)
+	d.Set("channel_id", channelID)
+	d.Set("type", channel.Type)
+	// The endpoint of the channel is masked by the API
+	if channel.Endpoint != "" && !strings.Contains(channel.Endpoint, "***") {
+		d.Set("endpoint", channel.Endpoint)
+	}
+	d.Set("description", channel.Description)
+	if channel.IsActive != nil {
+		d.Set("is_active", *channel.IsActive)
+	}
+	d.Set("version", channel.Version)
+
+	return nil
+}
+
+func resourceIBMCertificateManagerNotificationChannelUpdate(d *schema.ResourceData, meta interface{}) error {
+	instanceID, channelID, err := parseCertificateManagerChannelID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("type", "endpoint", "description", "is_active") {
+		err = certificateManagerRequest(meta, "PUT", fmt.Sprintf("%s/%s", certificateManagerChannelsPath(instanceID), channelID), expandCertificateManagerNotificationChannel(d), nil)
+		if err != nil {
+			return fmt.Errorf("Error updating notification channel: %s", err)
+		}
+	}
+
+	return resourceIBMCertificateManagerNotificationChannelRead(d, meta)
+}
+
+func resourceIBMCertificateManagerNotificationChannelDelete(d *schema.ResourceData, meta interface{}) error {
+	instanceID, channelID, err := parseCertificateManagerChannelID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	err = certificateManagerRequest(meta, "DELETE", fmt.Sprintf("%s/%s", certificateManagerChannelsPath(instanceID), channelID), nil, nil)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); !ok || apiErr.StatusCode() != 404 {
+			return fmt.Errorf("Error deleting notification channel: %s", err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
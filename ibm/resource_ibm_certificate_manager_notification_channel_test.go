@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMCertificateManagerNotificationChannel_Basic(t *testing.T) {
+	cmsName := fmt.Sprintf("tf-acc-test1-%s", acctest.RandStringFromCharSet(10, acctest.CharSetAlphaNum))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCertificateManagerNotificationChannelDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCertificateManagerNotificationChannel_basic(cmsName, "Certificate expiry notifications", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_certificate_manager_notification_channel.channel", "type", "url"),
+					resource.TestCheckResourceAttr("ibm_certificate_manager_notification_channel.channel", "is_active", "true"),
+					resource.TestCheckResourceAttrSet("ibm_certificate_manager_notification_channel.channel", "channel_id"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCertificateManagerNotificationChannel_basic(cmsName, "Updated notifications", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_certificate_manager_notification_channel.channel", "description", "Updated notifications"),
+					resource.TestCheckResourceAttr("ibm_certificate_manager_notification_channel.channel", "is_active", "false"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "ibm_certificate_manager_notification_channel.channel",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoint"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCertificateManagerNotificationChannelDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_certificate_manager_notification_channel" {
+			continue
+		}
+		instanceID, channelID, err := parseCertificateManagerChannelID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		channel := certificateManagerNotificationChannel{}
+		err = certificateManagerRequest(testAccProvider.Meta(), "GET", fmt.Sprintf("%s/%s", certificateManagerChannelsPath(instanceID), channelID), nil, &channel)
+		if err == nil {
+			return fmt.Errorf("Notification channel still exists: %s", rs.Primary.ID)
+		}
+		if apiErr, ok := err.(bmxerror.RequestFailure); !ok || apiErr.StatusCode() != 404 {
+			return fmt.Errorf("Error waiting for notification channel (%s) to be destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMCertificateManagerNotificationChannel_basic(cmsName, description string, isActive bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "cm" {
+		name     = "%s"
+		location = "us-south"
+		service  = "cloudcerts"
+		plan     = "free"
+	}
+	resource "ibm_certificate_manager_notification_channel" "channel" {
+		certificate_manager_instance_id = ibm_resource_instance.cm.id
+		type                            = "url"
+		endpoint                        = "https://example.com/certificate-manager/notifications"
+		description                     = "%s"
+		is_active                       = %t
+	}
+	`, cmsName, description, isActive)
+}
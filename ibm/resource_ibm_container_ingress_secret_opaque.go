@@ -0,0 +1,373 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/hashcode"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+)
+
+// ingressSecret is an ingress secret of the /ingress/v2/secret API including the
+// Opaque secret fields, which the Kubernetes service SDKs do not cover yet
+type ingressSecret struct {
+	Cluster     string               `json:"cluster"`
+	Name        string               `json:"name"`
+	Namespace   string               `json:"namespace"`
+	Type        string               `json:"type"`
+	Status      string               `json:"status"`
+	Persistence bool                 `json:"persistence"`
+	UserManaged bool                 `json:"userManaged"`
+	Fields      []ingressSecretField `json:"fields"`
+}
+
+type ingressSecretField struct {
+	Name                 string `json:"name"`
+	CRN                  string `json:"crn"`
+	ExpiresOn            string `json:"expiresOn"`
+	LastUpdatedTimestamp string `json:"lastUpdatedTimestamp"`
+}
+
+type ingressSecretFieldAdd struct {
+	Name         string `json:"name,omitempty"`
+	CRN          string `json:"crn"`
+	AppendPrefix bool   `json:"appendPrefix"`
+}
+
+func resourceIBMContainerIngressSecretOpaque() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMContainerIngressSecretOpaqueCreate,
+		Read:     resourceIBMContainerIngressSecretOpaqueRead,
+		Update:   resourceIBMContainerIngressSecretOpaqueUpdate,
+		Delete:   resourceIBMContainerIngressSecretOpaqueDelete,
+		Importer: &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster ID or name",
+			},
+			"secret_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Secret name",
+			},
+			"secret_namespace": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Namespace the secret is synced to",
+			},
+			"persistence": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Persistence of secret",
+			},
+			"fields": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Fields of the secret, each one synced from a Secrets Manager secret",
+				Set:         resourceIBMContainerIngressSecretOpaqueFieldHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"crn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "CRN of the secret in Secrets Manager",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Name of the field. By default the name of the Secrets Manager secret is used",
+						},
+						"prefix": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Prefix the field name with the name of the Secrets Manager secret",
+						},
+						"field_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the field in the Kubernetes secret",
+						},
+						"expires_on": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Expiration date of the Secrets Manager secret",
+						},
+						"last_updated_timestamp": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Time the field was last synced",
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Secret Status",
+			},
+			"user_managed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "If the secret was created by the user",
+			},
+		},
+	}
+}
+
+// Only the configured attributes identify a field, the computed ones change
+// whenever the secret is synced
+func resourceIBMContainerIngressSecretOpaqueFieldHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["crn"].(string)))
+	if name, ok := m["name"]; ok {
+		buf.WriteString(fmt.Sprintf("%s-", name.(string)))
+	}
+	if prefix, ok := m["prefix"]; ok {
+		buf.WriteString(fmt.Sprintf("%t-", prefix.(bool)))
+	}
+	return hashcode.String(buf.String())
+}
+
+func resourceIBMContainerIngressSecretOpaqueCreate(d *schema.ResourceData, meta interface{}) error {
+	cluster := d.Get("cluster").(string)
+	secretName := d.Get("secret_name").(string)
+	namespace := d.Get("secret_namespace").(string)
+
+	body := map[string]interface{}{
+		"cluster":     cluster,
+		"name":        secretName,
+		"namespace":   namespace,
+		"type":        "Opaque",
+		"persistence": d.Get("persistence").(bool),
+		"fields":      expandIngressSecretFields(d.Get("fields").(*schema.Set).List()),
+	}
+	err := ingressSecretRequest(meta, core.POST, "/ingress/v2/secret/createSecret", nil, body, nil)
+	if err != nil {
+		return fmt.Errorf("Error creating ingress secret %s in namespace %s: %s", secretName, namespace, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", cluster, secretName, namespace))
+
+	_, err = waitForContainerALBCert(d, meta, schema.TimeoutCreate)
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for create resource ingress secret (%s) : %s", d.Id(), err)
+	}
+
+	return resourceIBMContainerIngressSecretOpaqueRead(d, meta)
+}
+
+func resourceIBMContainerIngressSecretOpaqueRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) < 3 {
+		return fmt.Errorf("Incorrect ID %s: ID should be a combination of cluster/secretName/secretNamespace", d.Id())
+	}
+	cluster := parts[0]
+
+	secret := &ingressSecret{}
+	query := map[string]string{
+		"cluster":   cluster,
+		"name":      parts[1],
+		"namespace": parts[2],
+	}
+	err = ingressSecretRequest(meta, core.GET, "/ingress/v2/secret/getSecret", query, nil, secret)
+	if err != nil {
+		if isIngressSecretNotFound(err) {
+			log.Printf("[WARN] Ingress secret %s is not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving ingress secret %s: %s", d.Id(), err)
+	}
+
+	// The API only returns the resulting field names, the name and prefix
+	// options of a field are taken from the configuration
+	configured := map[string]map[string]interface{}{}
+	for _, f := range d.Get("fields").(*schema.Set).List() {
+		field := f.(map[string]interface{})
+		configured[field["crn"].(string)] = field
+	}
+	fields := make([]map[string]interface{}, 0, len(secret.Fields))
+	for _, f := range secret.Fields {
+		field := map[string]interface{}{
+			"crn":                    f.CRN,
+			"name":                   f.Name,
+			"prefix":                 false,
+			"field_name":             f.Name,
+			"expires_on":             f.ExpiresOn,
+			"last_updated_timestamp": f.LastUpdatedTimestamp,
+		}
+		if c, ok := configured[f.CRN]; ok {
+			field["name"] = c["name"]
+			field["prefix"] = c["prefix"]
+		}
+		fields = append(fields, field)
+	}
+
+	d.Set("cluster", cluster)
+	d.Set("secret_name", secret.Name)
+	d.Set("secret_namespace", secret.Namespace)
+	d.Set("persistence", secret.Persistence)
+	d.Set("status", secret.Status)
+	d.Set("user_managed", secret.UserManaged)
+	d.Set("fields", fields)
+
+	return nil
+}
+
+func resourceIBMContainerIngressSecretOpaqueUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("fields") {
+		o, n := d.GetChange("fields")
+		removed := o.(*schema.Set).Difference(n.(*schema.Set)).List()
+		added := n.(*schema.Set).Difference(o.(*schema.Set)).List()
+
+		for _, f := range removed {
+			field := f.(map[string]interface{})
+			body := map[string]interface{}{
+				"cluster":   d.Get("cluster").(string),
+				"name":      d.Get("secret_name").(string),
+				"namespace": d.Get("secret_namespace").(string),
+				"field": map[string]string{
+					"name": field["field_name"].(string),
+				},
+			}
+			err := ingressSecretRequest(meta, core.POST, "/ingress/v2/secret/removeField", nil, body, nil)
+			if err != nil {
+				return fmt.Errorf("Error removing field %s from ingress secret %s: %s", field["field_name"], d.Id(), err)
+			}
+		}
+		for _, field := range expandIngressSecretFields(added) {
+			body := map[string]interface{}{
+				"cluster":   d.Get("cluster").(string),
+				"name":      d.Get("secret_name").(string),
+				"namespace": d.Get("secret_namespace").(string),
+				"field":     field,
+			}
+			err := ingressSecretRequest(meta, core.POST, "/ingress/v2/secret/addField", nil, body, nil)
+			if err != nil {
+				return fmt.Errorf("Error adding field %s to ingress secret %s: %s", field.CRN, d.Id(), err)
+			}
+		}
+
+		_, err := waitForContainerALBCert(d, meta, schema.TimeoutUpdate)
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for updating resource ingress secret (%s) : %s", d.Id(), err)
+		}
+	}
+	return resourceIBMContainerIngressSecretOpaqueRead(d, meta)
+}
+
+func resourceIBMContainerIngressSecretOpaqueDelete(d *schema.ResourceData, meta interface{}) error {
+	ingressClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+
+	params := v2.SecretDeleteConfig{
+		Cluster:   d.Get("cluster").(string),
+		Name:      d.Get("secret_name").(string),
+		Namespace: d.Get("secret_namespace").(string),
+	}
+	err = ingressClient.Ingresses().DeleteIngressSecret(params)
+	if err != nil {
+		return fmt.Errorf("Error deleting ingress secret %s: %s", d.Id(), err)
+	}
+	_, err = waitForALBCertDelete(d, meta, schema.TimeoutDelete)
+	if err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func expandIngressSecretFields(fields []interface{}) []ingressSecretFieldAdd {
+	result := make([]ingressSecretFieldAdd, 0, len(fields))
+	for _, f := range fields {
+		field := f.(map[string]interface{})
+		result = append(result, ingressSecretFieldAdd{
+			Name:         field["name"].(string),
+			CRN:          field["crn"].(string),
+			AppendPrefix: field["prefix"].(bool),
+		})
+	}
+	return result
+}
+
+// ingressSecretRequest sends a request to the ingress secret API with the
+// authentication of the Kubernetes service SDK, decoding the response into result
+func ingressSecretRequest(meta interface{}, method, path string, query map[string]string, body interface{}, result interface{}) error {
+	satClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+	builder := core.NewRequestBuilder(method)
+	_, err = builder.ResolveRequestURL(satClient.Service.Options.URL, path, nil)
+	if err != nil {
+		return err
+	}
+	builder.AddHeader("Accept", "application/json")
+	for k, v := range query {
+		builder.AddQuery(k, v)
+	}
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		_, err = builder.SetBodyContentJSON(body)
+		if err != nil {
+			return err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return err
+	}
+	response, err := satClient.Service.Request(request, result)
+	if err != nil {
+		if response != nil {
+			return &ingressSecretError{statusCode: response.StatusCode, err: err}
+		}
+		return err
+	}
+	return nil
+}
+
+type ingressSecretError struct {
+	statusCode int
+	err        error
+}
+
+func (e *ingressSecretError) Error() string {
+	return e.err.Error()
+}
+
+func isIngressSecretNotFound(err error) bool {
+	if apiErr, ok := err.(*ingressSecretError); ok {
+		return apiErr.statusCode == http.StatusNotFound
+	}
+	return false
+}
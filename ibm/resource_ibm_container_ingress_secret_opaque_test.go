@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerIngressSecretOpaqueBasic(t *testing.T) {
+	secretName := fmt.Sprintf("tf-secret-opaque-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMContainerIngressSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerIngressSecretOpaqueBasic(secretName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_secret_opaque.secret", "secret_name", secretName),
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_secret_opaque.secret", "secret_namespace", "default"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_secret_opaque.secret", "fields.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerIngressSecretOpaqueUpdate(secretName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_secret_opaque.secret", "fields.#", "2"),
+				),
+			},
+			{
+				ResourceName:            "ibm_container_ingress_secret_opaque.secret",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"fields"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerIngressSecretOpaqueBasic(secretName string) string {
+	return fmt.Sprintf(`
+	data "ibm_container_cluster" "cluster" {
+	  cluster_name_id = "%s"
+	}
+	resource "ibm_container_ingress_secret_opaque" "secret" {
+	  cluster          = data.ibm_container_cluster.cluster.id
+	  secret_name      = "%s"
+	  secret_namespace = "default"
+	  fields {
+		crn = "%s"
+	  }
+	}
+	`, ingressClusterName, secretName, secretsManagerArbitraryCRN)
+}
+
+func testAccCheckIBMContainerIngressSecretOpaqueUpdate(secretName string) string {
+	return fmt.Sprintf(`
+	data "ibm_container_cluster" "cluster" {
+	  cluster_name_id = "%s"
+	}
+	resource "ibm_container_ingress_secret_opaque" "secret" {
+	  cluster          = data.ibm_container_cluster.cluster.id
+	  secret_name      = "%s"
+	  secret_namespace = "default"
+	  fields {
+		crn = "%s"
+	  }
+	  fields {
+		crn  = "%s"
+		name = "tls-cert"
+	  }
+	}
+	`, ingressClusterName, secretName, secretsManagerArbitraryCRN, secretsManagerCertCRN)
+}
@@ -0,0 +1,206 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+)
+
+func resourceIBMContainerIngressSecretTLS() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMContainerIngressSecretTLSCreate,
+		Read:     resourceIBMContainerIngressSecretTLSRead,
+		Update:   resourceIBMContainerIngressSecretTLSUpdate,
+		Delete:   resourceIBMContainerIngressSecretTLSDelete,
+		Importer: &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster ID or name",
+			},
+			"secret_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Secret name",
+			},
+			"secret_namespace": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Namespace the secret is synced to",
+			},
+			"cert_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "CRN of the certificate in Secrets Manager",
+			},
+			"persistence": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Persistence of secret",
+			},
+			"domain_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Domain name",
+			},
+			"expires_on": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Certificate expires on date",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Secret Status",
+			},
+			"user_managed": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "If the secret was created by the user",
+			},
+		},
+	}
+}
+
+func resourceIBMContainerIngressSecretTLSCreate(d *schema.ResourceData, meta interface{}) error {
+	ingressClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+
+	cluster := d.Get("cluster").(string)
+	secretName := d.Get("secret_name").(string)
+	namespace := d.Get("secret_namespace").(string)
+
+	params := v2.SecretCreateConfig{
+		CRN:       d.Get("cert_crn").(string),
+		Cluster:   cluster,
+		Name:      secretName,
+		Namespace: namespace,
+	}
+	if v, ok := d.GetOk("persistence"); ok {
+		params.Persistence = v.(bool)
+	}
+
+	_, err = ingressClient.Ingresses().CreateIngressSecret(params)
+	if err != nil {
+		return fmt.Errorf("Error creating ingress secret %s in namespace %s: %s", secretName, namespace, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", cluster, secretName, namespace))
+
+	_, err = waitForContainerALBCert(d, meta, schema.TimeoutCreate)
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for create resource ingress secret (%s) : %s", d.Id(), err)
+	}
+
+	return resourceIBMContainerIngressSecretTLSRead(d, meta)
+}
+
+func resourceIBMContainerIngressSecretTLSRead(d *schema.ResourceData, meta interface{}) error {
+	ingressClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) < 3 {
+		return fmt.Errorf("Incorrect ID %s: ID should be a combination of cluster/secretName/secretNamespace", d.Id())
+	}
+	cluster := parts[0]
+	secretName := parts[1]
+	namespace := parts[2]
+
+	secret, err := ingressClient.Ingresses().GetIngressSecret(cluster, secretName, namespace)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			log.Printf("[WARN] Ingress secret %s is not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving ingress secret %s: %s", d.Id(), err)
+	}
+
+	d.Set("cluster", cluster)
+	d.Set("secret_name", secret.Name)
+	d.Set("secret_namespace", secret.Namespace)
+	d.Set("cert_crn", secret.CRN)
+	d.Set("persistence", secret.Persistence)
+	d.Set("domain_name", secret.Domain)
+	d.Set("expires_on", secret.ExpiresOn)
+	d.Set("status", secret.Status)
+	d.Set("user_managed", secret.UserManaged)
+
+	return nil
+}
+
+func resourceIBMContainerIngressSecretTLSUpdate(d *schema.ResourceData, meta interface{}) error {
+	ingressClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("cert_crn") {
+		params := v2.SecretUpdateConfig{
+			CRN:       d.Get("cert_crn").(string),
+			Cluster:   d.Get("cluster").(string),
+			Name:      d.Get("secret_name").(string),
+			Namespace: d.Get("secret_namespace").(string),
+		}
+		_, err = ingressClient.Ingresses().UpdateIngressSecret(params)
+		if err != nil {
+			return fmt.Errorf("Error updating ingress secret %s: %s", d.Id(), err)
+		}
+
+		_, err = waitForContainerALBCert(d, meta, schema.TimeoutUpdate)
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for updating resource ingress secret (%s) : %s", d.Id(), err)
+		}
+	}
+	return resourceIBMContainerIngressSecretTLSRead(d, meta)
+}
+
+func resourceIBMContainerIngressSecretTLSDelete(d *schema.ResourceData, meta interface{}) error {
+	ingressClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+
+	params := v2.SecretDeleteConfig{
+		Cluster:   d.Get("cluster").(string),
+		Name:      d.Get("secret_name").(string),
+		Namespace: d.Get("secret_namespace").(string),
+	}
+	err = ingressClient.Ingresses().DeleteIngressSecret(params)
+	if err != nil {
+		return fmt.Errorf("Error deleting ingress secret %s: %s", d.Id(), err)
+	}
+	_, err = waitForALBCertDelete(d, meta, schema.TimeoutDelete)
+	if err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,93 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+)
+
+func TestAccIBMContainerIngressSecretTLSBasic(t *testing.T) {
+	secretName := fmt.Sprintf("tf-secret-tls-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMContainerIngressSecretDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerIngressSecretTLSBasic(secretName, secretsManagerCertCRN),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_secret_tls.secret", "secret_name", secretName),
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_secret_tls.secret", "secret_namespace", "default"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_secret_tls.secret", "cert_crn", secretsManagerCertCRN),
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_secret_tls.secret", "user_managed", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerIngressSecretTLSBasic(secretName, secretsManagerUpdatedCertCRN),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_ingress_secret_tls.secret", "cert_crn", secretsManagerUpdatedCertCRN),
+				),
+			},
+			{
+				ResourceName:      "ibm_container_ingress_secret_tls.secret",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerIngressSecretDestroy(s *terraform.State) error {
+	ingressClient, err := testAccProvider.Meta().(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_container_ingress_secret_tls" && rs.Type != "ibm_container_ingress_secret_opaque" {
+			continue
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		secret, err := ingressClient.Ingresses().GetIngressSecret(parts[0], parts[1], parts[2])
+		if err == nil && secret.Status != "deleted" {
+			return fmt.Errorf("Ingress secret still exists: %s", rs.Primary.ID)
+		}
+		if err != nil {
+			if apiErr, ok := err.(bmxerror.RequestFailure); !ok || apiErr.StatusCode() != 404 {
+				return fmt.Errorf("Error waiting for ingress secret (%s) to be destroyed: %s", rs.Primary.ID, err)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMContainerIngressSecretTLSBasic(secretName, certCRN string) string {
+	return fmt.Sprintf(`
+	data "ibm_container_cluster" "cluster" {
+	  cluster_name_id = "%s"
+	}
+	resource "ibm_container_ingress_secret_tls" "secret" {
+	  cluster          = data.ibm_container_cluster.cluster.id
+	  secret_name      = "%s"
+	  secret_namespace = "default"
+	  cert_crn         = "%s"
+	}
+	`, ingressClusterName, secretName, certCRN)
+}
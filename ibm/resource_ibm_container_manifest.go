@@ -0,0 +1,823 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+)
+
+const (
+	manifestRolloutPending = "rolling"
+	manifestRolloutDone    = "done"
+)
+
+var manifestDocumentSeparator = regexp.MustCompile(`(?m)^---\s*$`)
+
+func resourceIBMContainerManifest() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMContainerManifestCreate,
+		Read:   resourceIBMContainerManifestRead,
+		Update: resourceIBMContainerManifestUpdate,
+		Delete: resourceIBMContainerManifestDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMContainerManifestCustomizeDiff(diff)
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster name or ID",
+			},
+			"manifest": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validateKubeManifest,
+				Description:  "YAML manifest with one or more Kubernetes objects, separated by ---",
+			},
+			"namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "default",
+				Description: "Namespace of the namespaced objects of the manifest that do not set a namespace",
+			},
+			"server_side_apply": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Apply the objects with server-side apply. If false, the objects are created or replaced",
+			},
+			"field_manager": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "terraform-provider-ibm",
+				Description: "Name of the field manager of the applied objects",
+			},
+			"force_conflicts": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Take over the fields of the objects that are managed by other field managers with server-side apply",
+			},
+			"wait_for_rollout": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Wait for the rollout of the deployments, stateful sets and daemon sets of the manifest",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the resource group.",
+			},
+			"objects": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Objects of the manifest that exist in the cluster",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"api_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "API version of the object",
+						},
+						"kind": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Kind of the object",
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Namespace of the object as set in the manifest",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the object",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMContainerManifestCreate(d *schema.ResourceData, meta interface{}) error {
+	cluster := d.Get("cluster").(string)
+	objects, err := parseKubeManifest(d.Get("manifest").(string))
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	kube, err := newKubeAPI(meta, cluster, targetEnv)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cluster, resource.UniqueId()))
+
+	applied, err := applyKubeObjects(d, kube, objects, d.Timeout(schema.TimeoutCreate))
+	// The applied objects are kept in the state, so they are pruned later on
+	d.Set("objects", flattenKubeObjectKeys(applied))
+	if err != nil {
+		return err
+	}
+	if d.Get("wait_for_rollout").(bool) {
+		err = waitForKubeObjectsRollout(d, kube, applied, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMContainerManifestRead(d, meta)
+}
+
+func resourceIBMContainerManifestRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	cluster := parts[0]
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	kube, err := newKubeAPI(meta, cluster, targetEnv)
+	if err != nil {
+		var apiErr bmxerror.RequestFailure
+		if errors.As(err, &apiErr) && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	// Objects that were deleted outside of Terraform are dropped, so that the
+	// next apply creates them again
+	existing := []kubeObject{}
+	live := map[string]kubeObject{}
+	for _, key := range expandKubeObjectKeys(d.Get("objects").([]interface{})) {
+		res, err := kube.resourceFor(key.apiVersion(), key.kind())
+		if err != nil {
+			// The object is gone with its custom resource definition
+			if isKubeKindNotServed(err) {
+				log.Printf("[WARN] %s", err)
+				continue
+			}
+			return err
+		}
+		obj, err := kube.getObject(kube.objectPath(res, key.apiVersion(), kubeObjectNamespace(key, d), key.name()))
+		if err != nil {
+			if isKubeNotFound(err) {
+				log.Printf("[WARN] %s %s is not found in cluster %s", key.kind(), key.name(), cluster)
+				continue
+			}
+			return fmt.Errorf("Error retrieving %s %s: %s", key.kind(), key.name(), err)
+		}
+		existing = append(existing, key)
+		live[kubeObjectKey(key)] = obj
+	}
+
+	// When objects were changed outside of Terraform, the manifest is set to
+	// the live values of the changed fields, so that the next apply restores them
+	manifest, drifted, err := kubeManifestWithLiveValues(d.Get("manifest").(string), live)
+	if err != nil {
+		return err
+	}
+	if drifted {
+		log.Printf("[WARN] Objects of the manifest were changed outside of Terraform in cluster %s", cluster)
+		d.Set("manifest", manifest)
+	}
+
+	d.Set("cluster", cluster)
+	d.Set("objects", flattenKubeObjectKeys(existing))
+	return nil
+}
+
+func resourceIBMContainerManifestUpdate(d *schema.ResourceData, meta interface{}) error {
+	objects, err := parseKubeManifest(d.Get("manifest").(string))
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	kube, err := newKubeAPI(meta, d.Get("cluster").(string), targetEnv)
+	if err != nil {
+		return err
+	}
+
+	previous := expandKubeObjectKeys(d.Get("objects").([]interface{}))
+	applied, err := applyKubeObjects(d, kube, objects, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		d.Set("objects", flattenKubeObjectKeys(mergeKubeObjectKeys(previous, applied)))
+		return err
+	}
+
+	// Prune the objects that were removed from the manifest
+	keep := map[string]bool{}
+	for _, obj := range applied {
+		keep[kubeObjectKey(obj)] = true
+	}
+	pruned := []kubeObject{}
+	for _, obj := range previous {
+		if !keep[kubeObjectKey(obj)] {
+			pruned = append(pruned, obj)
+		}
+	}
+	err = deleteKubeObjects(d, kube, pruned, d.Timeout(schema.TimeoutUpdate))
+	if err != nil {
+		d.Set("objects", flattenKubeObjectKeys(mergeKubeObjectKeys(previous, applied)))
+		return err
+	}
+	d.Set("objects", flattenKubeObjectKeys(applied))
+
+	if d.Get("wait_for_rollout").(bool) {
+		err = waitForKubeObjectsRollout(d, kube, applied, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMContainerManifestRead(d, meta)
+}
+
+func resourceIBMContainerManifestDelete(d *schema.ResourceData, meta interface{}) error {
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	kube, err := newKubeAPI(meta, d.Get("cluster").(string), targetEnv)
+	if err != nil {
+		var apiErr bmxerror.RequestFailure
+		if errors.As(err, &apiErr) && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return err
+	}
+
+	err = deleteKubeObjects(d, kube, expandKubeObjectKeys(d.Get("objects").([]interface{})), d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceIBMContainerManifestCustomizeDiff plans an update when objects of the
+// manifest are missing in the cluster
+func resourceIBMContainerManifestCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" || !diff.NewValueKnown("manifest") {
+		return nil
+	}
+	objects, err := parseKubeManifest(diff.Get("manifest").(string))
+	if err != nil {
+		return err
+	}
+	existing := map[string]bool{}
+	for _, obj := range expandKubeObjectKeys(diff.Get("objects").([]interface{})) {
+		existing[kubeObjectKey(obj)] = true
+	}
+	if diff.HasChange("manifest") || len(existing) != len(objects) {
+		return diff.SetNewComputed("objects")
+	}
+	for _, obj := range objects {
+		if !existing[kubeObjectKey(obj)] {
+			return diff.SetNewComputed("objects")
+		}
+	}
+	return nil
+}
+
+func validateKubeManifest(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := parseKubeManifest(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %s", k, err))
+	}
+	return
+}
+
+// parseKubeManifest splits a YAML manifest into its objects. Namespaces and
+// custom resource definitions are ordered first, so the objects that depend on
+// them can be applied in the same manifest.
+func parseKubeManifest(manifest string) ([]kubeObject, error) {
+	objects := []kubeObject{}
+	for i, doc := range manifestDocumentSeparator.Split(manifest, -1) {
+		obj, err := parseKubeDocument(i, doc)
+		if err != nil {
+			return nil, err
+		}
+		if obj != nil {
+			objects = append(objects, obj)
+		}
+	}
+	if len(objects) == 0 {
+		return nil, fmt.Errorf("The manifest does not contain any Kubernetes object")
+	}
+	sort.SliceStable(objects, func(i, j int) bool {
+		return kubeObjectApplyOrder(objects[i]) < kubeObjectApplyOrder(objects[j])
+	})
+	return objects, nil
+}
+
+// kubeManifestWithLiveValues compares the fields of the manifest with the live
+// objects. The document of a changed object is set to the live values of the
+// changed fields, the documents of the other objects keep their text.
+func kubeManifestWithLiveValues(manifest string, live map[string]kubeObject) (string, bool, error) {
+	drifted := false
+	docs := manifestDocumentSeparator.Split(manifest, -1)
+	for i, doc := range docs {
+		obj, err := parseKubeDocument(i, doc)
+		if err != nil || obj == nil {
+			continue
+		}
+		liveObj, ok := live[kubeObjectKey(obj)]
+		if !ok {
+			continue
+		}
+		projected, equal := kubeLiveProjection(obj, liveObj)
+		if equal {
+			continue
+		}
+		out, err := yaml.Marshal(projected)
+		if err != nil {
+			return "", false, fmt.Errorf("Error marshalling %s %s: %s", obj.kind(), obj.name(), err)
+		}
+		docs[i] = string(out)
+		if i > 0 {
+			docs[i] = "\n" + docs[i]
+		}
+		drifted = true
+	}
+	return strings.Join(docs, "---"), drifted, nil
+}
+
+// parseKubeDocument parses the document with index i of the manifest, an empty
+// document is returned as nil
+func parseKubeDocument(i int, doc string) (kubeObject, error) {
+	if strings.TrimSpace(doc) == "" {
+		return nil, nil
+	}
+	raw, err := yaml.YAMLToJSON([]byte(doc))
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing document %d of the manifest: %s", i+1, err)
+	}
+	obj := kubeObject{}
+	if err := json.Unmarshal(raw, &obj); err != nil {
+		return nil, fmt.Errorf("Error parsing document %d of the manifest: %s", i+1, err)
+	}
+	if len(obj) == 0 {
+		return nil, nil
+	}
+	if obj.apiVersion() == "" || obj.kind() == "" || obj.name() == "" {
+		return nil, fmt.Errorf("Document %d of the manifest must set apiVersion, kind and metadata.name", i+1)
+	}
+	return obj, nil
+}
+
+func kubeObjectApplyOrder(obj kubeObject) int {
+	switch obj.kind() {
+	case "Namespace":
+		return 0
+	case "CustomResourceDefinition":
+		return 1
+	}
+	return 2
+}
+
+func kubeObjectKey(obj kubeObject) string {
+	return fmt.Sprintf("%s/%s/%s/%s", obj.apiVersion(), obj.kind(), obj.namespace(), obj.name())
+}
+
+// kubeObjectNamespace returns the namespace of a namespaced object
+func kubeObjectNamespace(obj kubeObject, d *schema.ResourceData) string {
+	if ns := obj.namespace(); ns != "" {
+		return ns
+	}
+	return d.Get("namespace").(string)
+}
+
+// applyKubeObjects applies the objects in order and returns the objects that
+// were applied until an error occurred
+func applyKubeObjects(d *schema.ResourceData, kube *kubeAPI, objects []kubeObject, timeout time.Duration) ([]kubeObject, error) {
+	serverSideApply := d.Get("server_side_apply").(bool)
+	fieldManager := d.Get("field_manager").(string)
+	force := d.Get("force_conflicts").(bool)
+
+	applied := []kubeObject{}
+	for _, obj := range objects {
+		err := resource.Retry(timeout, func() *resource.RetryError {
+			res, err := kube.resourceFor(obj.apiVersion(), obj.kind())
+			if err != nil {
+				// The kind of a custom resource definition that was just
+				// created is served once the definition is established
+				return resource.RetryableError(err)
+			}
+			namespace := kubeObjectNamespace(obj, d)
+			path := kube.objectPath(res, obj.apiVersion(), namespace, obj.name())
+
+			if serverSideApply {
+				_, err = kube.applyObject(path, obj, fieldManager, force)
+			} else {
+				var live kubeObject
+				live, err = kube.getObject(path)
+				if err == nil {
+					obj.metadata()["resourceVersion"] = live.metadata()["resourceVersion"]
+					_, err = kube.replaceObject(path, obj, fieldManager)
+					delete(obj.metadata(), "resourceVersion")
+				} else if isKubeNotFound(err) {
+					_, err = kube.createObject(kube.objectPath(res, obj.apiVersion(), namespace, ""), obj, fieldManager)
+				}
+			}
+			if err != nil {
+				// The namespace of the object may still be created
+				if isKubeNotFound(err) {
+					return resource.RetryableError(err)
+				}
+				if isKubeConflict(err) && serverSideApply {
+					return resource.NonRetryableError(fmt.Errorf("Error applying %s %s, fields are managed by another field manager. Set force_conflicts to take them over: %s", obj.kind(), obj.name(), err))
+				}
+				if isKubeConflict(err) {
+					return resource.RetryableError(err)
+				}
+				return resource.NonRetryableError(fmt.Errorf("Error applying %s %s: %s", obj.kind(), obj.name(), err))
+			}
+			return nil
+		})
+		if err != nil {
+			return applied, err
+		}
+		applied = append(applied, obj)
+	}
+	return applied, nil
+}
+
+// deleteKubeObjects deletes the objects in reverse order and waits until they
+// are gone
+func deleteKubeObjects(d *schema.ResourceData, kube *kubeAPI, objects []kubeObject, timeout time.Duration) error {
+	paths := []string{}
+	for i := len(objects) - 1; i >= 0; i-- {
+		obj := objects[i]
+		res, err := kube.resourceFor(obj.apiVersion(), obj.kind())
+		if err != nil {
+			// The kind is gone with its custom resource definition
+			if isKubeKindNotServed(err) {
+				log.Printf("[WARN] %s", err)
+				continue
+			}
+			return err
+		}
+		path := kube.objectPath(res, obj.apiVersion(), kubeObjectNamespace(obj, d), obj.name())
+		err = kube.deleteObject(path)
+		if err != nil && !isKubeNotFound(err) {
+			return fmt.Errorf("Error deleting %s %s: %s", obj.kind(), obj.name(), err)
+		}
+		paths = append(paths, path)
+	}
+
+	for _, path := range paths {
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{"deleting"},
+			Target:  []string{"deleted"},
+			Refresh: func() (interface{}, string, error) {
+				obj, err := kube.getObject(path)
+				if err != nil {
+					if isKubeNotFound(err) {
+						return obj, "deleted", nil
+					}
+					return nil, "", err
+				}
+				return obj, "deleting", nil
+			},
+			Timeout:    timeout,
+			Delay:      2 * time.Second,
+			MinTimeout: 5 * time.Second,
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Error waiting for %s to be deleted: %s", path, err)
+		}
+	}
+	return nil
+}
+
+// waitForKubeObjectsRollout waits until the deployments, stateful sets and
+// daemon sets run their current generation with all replicas available
+func waitForKubeObjectsRollout(d *schema.ResourceData, kube *kubeAPI, objects []kubeObject, timeout time.Duration) error {
+	for _, obj := range objects {
+		if !strings.HasPrefix(obj.apiVersion(), "apps/") {
+			continue
+		}
+		kind := obj.kind()
+		if kind != "Deployment" && kind != "StatefulSet" && kind != "DaemonSet" {
+			continue
+		}
+		res, err := kube.resourceFor(obj.apiVersion(), kind)
+		if err != nil {
+			return err
+		}
+		path := kube.objectPath(res, obj.apiVersion(), kubeObjectNamespace(obj, d), obj.name())
+
+		stateConf := &resource.StateChangeConf{
+			Pending: []string{manifestRolloutPending},
+			Target:  []string{manifestRolloutDone},
+			Refresh: func() (interface{}, string, error) {
+				live, err := kube.getObject(path)
+				if err != nil {
+					return nil, "", err
+				}
+				if kubeRolloutComplete(live) {
+					return live, manifestRolloutDone, nil
+				}
+				return live, manifestRolloutPending, nil
+			},
+			Timeout:    timeout,
+			Delay:      5 * time.Second,
+			MinTimeout: 5 * time.Second,
+		}
+		if _, err := stateConf.WaitForState(); err != nil {
+			return fmt.Errorf("Error waiting for the rollout of %s %s: %s", kind, obj.name(), err)
+		}
+	}
+	return nil
+}
+
+func kubeRolloutComplete(obj kubeObject) bool {
+	spec, _ := obj["spec"].(map[string]interface{})
+	status, _ := obj["status"].(map[string]interface{})
+	if kubeInt(status["observedGeneration"]) < kubeInt(obj.metadata()["generation"]) {
+		return false
+	}
+	replicas := int64(1)
+	if r, ok := spec["replicas"]; ok {
+		replicas = kubeInt(r)
+	}
+	switch obj.kind() {
+	case "Deployment":
+		return kubeInt(status["updatedReplicas"]) == replicas &&
+			kubeInt(status["availableReplicas"]) == replicas &&
+			kubeInt(status["replicas"]) == replicas
+	case "StatefulSet":
+		return kubeInt(status["readyReplicas"]) == replicas &&
+			kubeInt(status["updatedReplicas"]) == replicas
+	case "DaemonSet":
+		desired := kubeInt(status["desiredNumberScheduled"])
+		return kubeInt(status["updatedNumberScheduled"]) == desired &&
+			kubeInt(status["numberAvailable"]) == desired
+	}
+	return true
+}
+
+func kubeInt(v interface{}) int64 {
+	switch n := v.(type) {
+	case json.Number:
+		i, _ := n.Int64()
+		return i
+	case float64:
+		return int64(n)
+	case string:
+		i, _ := strconv.ParseInt(n, 10, 64)
+		return i
+	}
+	return 0
+}
+
+// kubeLiveProjection returns the manifest object with the live values of the
+// fields that differ and whether all its fields have the values of the
+// manifest. The fields that are set by the cluster, such as status or
+// defaulted fields, are not compared. Values that the API server normalizes,
+// such as 1024Mi to 1Gi or a port number to a string, are equal.
+func kubeLiveProjection(obj, live kubeObject) (kubeObject, bool) {
+	desired := kubeObject{}
+	for k, v := range obj {
+		desired[k] = v
+	}
+	if obj.kind() == "Secret" {
+		// stringData is write-only, it's merged into data by the API server
+		delete(desired, "stringData")
+	}
+	projected, equal := kubeProjectValue(map[string]interface{}(desired), map[string]interface{}(live))
+	result := kubeObject(projected.(map[string]interface{}))
+	if data, ok := obj["stringData"]; ok && obj.kind() == "Secret" {
+		result["stringData"] = data
+	}
+	return result, equal
+}
+
+func kubeProjectValue(desired, live interface{}) (interface{}, bool) {
+	switch dv := desired.(type) {
+	case map[string]interface{}:
+		lv, ok := live.(map[string]interface{})
+		if !ok {
+			if live == nil && kubeEmptyValue(desired) {
+				return desired, true
+			}
+			return live, false
+		}
+		projected := map[string]interface{}{}
+		equal := true
+		for k, v := range dv {
+			l, ok := lv[k]
+			if !ok {
+				// The API server drops empty values
+				if kubeEmptyValue(v) {
+					projected[k] = v
+				} else {
+					equal = false
+				}
+				continue
+			}
+			p, eq := kubeProjectValue(v, l)
+			projected[k] = p
+			equal = equal && eq
+		}
+		return projected, equal
+	case []interface{}:
+		lv, ok := live.([]interface{})
+		if !ok && live == nil && len(dv) == 0 {
+			return desired, true
+		}
+		if !ok || len(lv) != len(dv) {
+			return live, false
+		}
+		projected := make([]interface{}, len(dv))
+		equal := true
+		for i := range dv {
+			p, eq := kubeProjectValue(dv[i], lv[i])
+			projected[i] = p
+			equal = equal && eq
+		}
+		return projected, equal
+	}
+	if kubeScalarEqual(desired, live) {
+		return desired, true
+	}
+	return live, false
+}
+
+// kubeEmptyValue reports whether the value of the manifest is empty, so that
+// the API server may leave it out
+func kubeEmptyValue(v interface{}) bool {
+	switch value := v.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(value) == 0
+	case []interface{}:
+		return len(value) == 0
+	case string:
+		return value == ""
+	case bool:
+		return !value
+	}
+	return kubeScalarString(v) == "0"
+}
+
+// kubeScalarEqual compares the values of the manifest and of the API server.
+// Numbers and quantities are compared by their value, so that 1024Mi equals
+// 1Gi, 0.5 equals 500m and 8080 equals "8080".
+func kubeScalarEqual(desired, live interface{}) bool {
+	d, l := kubeScalarString(desired), kubeScalarString(live)
+	if d == l {
+		return true
+	}
+	if _, ok := desired.(bool); ok {
+		return false
+	}
+	dq, ok := kubeQuantity(d)
+	if !ok {
+		return false
+	}
+	lq, ok := kubeQuantity(l)
+	return ok && dq.Cmp(lq) == 0
+}
+
+var kubeQuantitySuffixes = []struct {
+	suffix     string
+	multiplier *big.Rat
+}{
+	{"Ki", new(big.Rat).SetInt64(1 << 10)},
+	{"Mi", new(big.Rat).SetInt64(1 << 20)},
+	{"Gi", new(big.Rat).SetInt64(1 << 30)},
+	{"Ti", new(big.Rat).SetInt64(1 << 40)},
+	{"Pi", new(big.Rat).SetInt64(1 << 50)},
+	{"Ei", new(big.Rat).SetInt64(1 << 60)},
+	{"n", big.NewRat(1, 1000000000)},
+	{"u", big.NewRat(1, 1000000)},
+	{"m", big.NewRat(1, 1000)},
+	{"k", new(big.Rat).SetInt64(1e3)},
+	{"M", new(big.Rat).SetInt64(1e6)},
+	{"G", new(big.Rat).SetInt64(1e9)},
+	{"T", new(big.Rat).SetInt64(1e12)},
+	{"P", new(big.Rat).SetInt64(1e15)},
+	{"E", new(big.Rat).SetInt64(1e18)},
+}
+
+// kubeQuantity parses a number or a Kubernetes resource quantity
+func kubeQuantity(s string) (*big.Rat, bool) {
+	multiplier := big.NewRat(1, 1)
+	for _, q := range kubeQuantitySuffixes {
+		if strings.HasSuffix(s, q.suffix) {
+			s = strings.TrimSuffix(s, q.suffix)
+			multiplier = q.multiplier
+			break
+		}
+	}
+	if s == "" || strings.ContainsAny(s, "/") {
+		return nil, false
+	}
+	value, ok := new(big.Rat).SetString(s)
+	if !ok {
+		return nil, false
+	}
+	return value.Mul(value, multiplier), true
+}
+
+// kubeScalarString formats the numbers of the manifest and of the API server,
+// which are decoded as float64 and json.Number, in the same way
+func kubeScalarString(v interface{}) string {
+	switch n := v.(type) {
+	case json.Number:
+		return n.String()
+	case float64:
+		return strconv.FormatFloat(n, 'f', -1, 64)
+	}
+	return fmt.Sprintf("%v", v)
+}
+
+func flattenKubeObjectKeys(objects []kubeObject) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(objects))
+	for _, obj := range objects {
+		result = append(result, map[string]interface{}{
+			"api_version": obj.apiVersion(),
+			"kind":        obj.kind(),
+			"namespace":   obj.namespace(),
+			"name":        obj.name(),
+		})
+	}
+	return result
+}
+
+func expandKubeObjectKeys(keys []interface{}) []kubeObject {
+	result := make([]kubeObject, 0, len(keys))
+	for _, k := range keys {
+		key := k.(map[string]interface{})
+		result = append(result, kubeObject{
+			"apiVersion": key["api_version"].(string),
+			"kind":       key["kind"].(string),
+			"metadata": map[string]interface{}{
+				"namespace": key["namespace"].(string),
+				"name":      key["name"].(string),
+			},
+		})
+	}
+	return result
+}
+
+func mergeKubeObjectKeys(previous, applied []kubeObject) []kubeObject {
+	merged := append([]kubeObject{}, previous...)
+	seen := map[string]bool{}
+	for _, obj := range previous {
+		seen[kubeObjectKey(obj)] = true
+	}
+	for _, obj := range applied {
+		if !seen[kubeObjectKey(obj)] {
+			merged = append(merged, obj)
+		}
+	}
+	return merged
+}
@@ -0,0 +1,284 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerManifestBasic(t *testing.T) {
+	namespace := fmt.Sprintf("tf-manifest-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerManifestBasic(namespace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_manifest.manifest", "objects.#", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_manifest.manifest", "objects.0.kind", "Namespace"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_manifest.manifest", "objects.0.name", namespace),
+					resource.TestCheckResourceAttr(
+						"ibm_container_manifest.manifest", "objects.2.kind", "Deployment"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerManifestUpdate(namespace),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_manifest.manifest", "objects.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_manifest.manifest", "objects.1.kind", "Deployment"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerManifestBasic(namespace string) string {
+	return fmt.Sprintf(`
+	data "ibm_container_cluster" "cluster" {
+	  cluster_name_id = "%[1]s"
+	}
+	resource "ibm_container_manifest" "manifest" {
+	  cluster          = data.ibm_container_cluster.cluster.id
+	  namespace        = "%[2]s"
+	  wait_for_rollout = true
+	  manifest         = <<-YAML
+	    apiVersion: v1
+	    kind: ConfigMap
+	    metadata:
+	      name: hello
+	    data:
+	      message: hello
+	    ---
+	    apiVersion: v1
+	    kind: Namespace
+	    metadata:
+	      name: %[2]s
+	    ---
+	    apiVersion: apps/v1
+	    kind: Deployment
+	    metadata:
+	      name: hello
+	    spec:
+	      replicas: 1
+	      selector:
+	        matchLabels:
+	          app: hello
+	      template:
+	        metadata:
+	          labels:
+	            app: hello
+	        spec:
+	          containers:
+	          - name: hello
+	            image: icr.io/ibm/liberty:latest
+	  YAML
+	}
+	`, ingressClusterName, namespace)
+}
+
+func testAccCheckIBMContainerManifestUpdate(namespace string) string {
+	return fmt.Sprintf(`
+	data "ibm_container_cluster" "cluster" {
+	  cluster_name_id = "%[1]s"
+	}
+	resource "ibm_container_manifest" "manifest" {
+	  cluster          = data.ibm_container_cluster.cluster.id
+	  namespace        = "%[2]s"
+	  wait_for_rollout = true
+	  manifest         = <<-YAML
+	    apiVersion: v1
+	    kind: Namespace
+	    metadata:
+	      name: %[2]s
+	    ---
+	    apiVersion: apps/v1
+	    kind: Deployment
+	    metadata:
+	      name: hello
+	    spec:
+	      replicas: 2
+	      selector:
+	        matchLabels:
+	          app: hello
+	      template:
+	        metadata:
+	          labels:
+	            app: hello
+	        spec:
+	          containers:
+	          - name: hello
+	            image: icr.io/ibm/liberty:latest
+	  YAML
+	}
+	`, ingressClusterName, namespace)
+}
+
+func TestKubeLiveProjection(t *testing.T) {
+	objects, err := parseKubeManifest(`
+apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: web
+spec:
+  replicas: 2
+  template:
+    spec:
+      containers:
+      - name: web
+        image: nginx:1.21
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	live := kubeObject{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "default", "resourceVersion": "42"},
+		"spec": map[string]interface{}{
+			"replicas": json.Number("2"),
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "web", "image": "nginx:1.21", "imagePullPolicy": "IfNotPresent"},
+					},
+				},
+			},
+		},
+		"status": map[string]interface{}{"replicas": json.Number("2")},
+	}
+	if _, equal := kubeLiveProjection(objects[0], live); !equal {
+		t.Errorf("Expected the live object to match the manifest")
+	}
+
+	live["spec"].(map[string]interface{})["replicas"] = json.Number("5")
+	projected, equal := kubeLiveProjection(objects[0], live)
+	if equal {
+		t.Errorf("Expected the changed replicas to be detected")
+	}
+	if replicas := projected["spec"].(map[string]interface{})["replicas"]; kubeScalarString(replicas) != "5" {
+		t.Errorf("Expected the live replicas in the projection, got %v", replicas)
+	}
+	if _, ok := projected["status"]; ok {
+		t.Errorf("Expected the status to be left out of the projection")
+	}
+}
+
+func TestKubeLiveProjectionNormalizedValues(t *testing.T) {
+	objects, err := parseKubeManifest(`
+apiVersion: v1
+kind: Pod
+metadata:
+  name: web
+  labels: {}
+spec:
+  containers:
+  - name: web
+    image: nginx:1.21
+    ports:
+    - containerPort: "8080"
+    resources:
+      limits:
+        memory: 1024Mi
+        cpu: 0.5
+`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	live := kubeObject{
+		"apiVersion": "v1",
+		"kind":       "Pod",
+		"metadata":   map[string]interface{}{"name": "web", "namespace": "default"},
+		"spec": map[string]interface{}{
+			"containers": []interface{}{
+				map[string]interface{}{
+					"name":  "web",
+					"image": "nginx:1.21",
+					"ports": []interface{}{
+						map[string]interface{}{"containerPort": json.Number("8080"), "protocol": "TCP"},
+					},
+					"resources": map[string]interface{}{
+						"limits": map[string]interface{}{"memory": "1Gi", "cpu": "500m"},
+					},
+				},
+			},
+		},
+	}
+	if _, equal := kubeLiveProjection(objects[0], live); !equal {
+		t.Errorf("Expected the normalized values to match the manifest")
+	}
+
+	limits := live["spec"].(map[string]interface{})["containers"].([]interface{})[0].(map[string]interface{})["resources"].(map[string]interface{})["limits"].(map[string]interface{})
+	limits["memory"] = "2Gi"
+	if _, equal := kubeLiveProjection(objects[0], live); equal {
+		t.Errorf("Expected the changed memory limit to be detected")
+	}
+}
+
+func TestKubeManifestWithLiveValues(t *testing.T) {
+	manifest := `apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: first
+data:
+  size: 1024Mi
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: second
+data:
+  key: value
+`
+	first := kubeObject{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "first"},
+		"data":       map[string]interface{}{"size": "1Gi"},
+	}
+	second := kubeObject{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "second"},
+		"data":       map[string]interface{}{"key": "value"},
+	}
+	live := map[string]kubeObject{
+		kubeObjectKey(first):  first,
+		kubeObjectKey(second): second,
+	}
+	result, drifted, err := kubeManifestWithLiveValues(manifest, live)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if drifted || result != manifest {
+		t.Errorf("Expected the manifest to be kept, got %q", result)
+	}
+
+	second["data"] = map[string]interface{}{"key": "changed"}
+	result, drifted, err = kubeManifestWithLiveValues(manifest, live)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !drifted {
+		t.Fatalf("Expected the changed object to be detected")
+	}
+	if !strings.HasPrefix(result, strings.Split(manifest, "---")[0]+"---") {
+		t.Errorf("Expected the document of the unchanged object to be kept, got %q", result)
+	}
+	if !strings.Contains(result, "key: changed") {
+		t.Errorf("Expected the live value in the manifest, got %q", result)
+	}
+}
@@ -0,0 +1,561 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.ibm.com/ibmcloud/kubernetesservice-go-sdk/kubernetesserviceapiv1"
+)
+
+func resourceIBMContainerNlbDns() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMContainerNlbDnsCreate,
+		Read:     resourceIBMContainerNlbDnsRead,
+		Update:   resourceIBMContainerNlbDnsUpdate,
+		Delete:   resourceIBMContainerNlbDnsDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster ID or name",
+			},
+			"nlb_ips": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				Set:          schema.HashString,
+				ExactlyOneOf: []string{"nlb_ips", "lb_hostname"},
+				Description:  "NLB IP addresses of a classic cluster to register with the subdomain",
+			},
+			"lb_hostname": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"nlb_ips", "lb_hostname"},
+				Description:  "Hostname of the VPC load balancer of a VPC cluster to register with the subdomain",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "public",
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private"}),
+				Description:  "Type of the VPC load balancer, public or private",
+			},
+			"secret_namespace": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "Namespace the TLS secret of the subdomain is created in",
+			},
+			"health_monitor": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Health check monitor of the subdomain of a classic cluster",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Enable the health check monitor",
+						},
+						"type": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "HTTP",
+							ValidateFunc: validateAllowedStringValue([]string{"HTTP", "HTTPS", "TCP"}),
+							Description:  "Protocol of the health check",
+						},
+						"method": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "GET",
+							ValidateFunc: validateAllowedStringValue([]string{"GET", "HEAD"}),
+							Description:  "HTTP method of the health check",
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "/",
+							Description: "Path of the health check",
+						},
+						"port": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validateAllowedRangeInt(1, 65535),
+							Description:  "Port of the health check. By default the port of the protocol is used",
+						},
+						"expected_codes": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "2xx",
+							Description: "HTTP codes a healthy NLB IP returns",
+						},
+						"expected_body": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Case-insensitive substring of the response body of a healthy NLB IP",
+						},
+						"timeout": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      5,
+							ValidateFunc: validateAllowedRangeInt(1, 10),
+							Description:  "Seconds to wait for the response of the health check",
+						},
+						"retries": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      2,
+							ValidateFunc: validateAllowedRangeInt(1, 5),
+							Description:  "Number of retries before an NLB IP is considered unhealthy",
+						},
+						"interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      60,
+							ValidateFunc: validateAllowedRangeInt(10, 3600),
+							Description:  "Seconds between two health checks",
+						},
+						"allow_insecure": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Do not validate the certificate of HTTPS health checks",
+						},
+						"follow_redirects": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Follow redirects of the health check",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Description of the health check monitor",
+						},
+					},
+				},
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the resource group.",
+			},
+			"nlb_host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The registered subdomain",
+			},
+			"secret_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the TLS secret of the subdomain",
+			},
+			"secret_status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the TLS secret of the subdomain",
+			},
+		},
+	}
+}
+
+func resourceIBMContainerNlbDnsCreate(d *schema.ResourceData, meta interface{}) error {
+	satClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	cluster := d.Get("cluster").(string)
+
+	var nlbHost string
+	if v, ok := d.GetOk("lb_hostname"); ok {
+		createOpts := &kubernetesserviceapiv1.CreateNlbDNSOptions{
+			Cluster:    &cluster,
+			LbHostname: ptrToString(v.(string)),
+			Type:       ptrToString(d.Get("type").(string)),
+		}
+		if ns, ok := d.GetOk("secret_namespace"); ok {
+			createOpts.SecretNamespace = ptrToString(ns.(string))
+		}
+		nlb, _, err := satClient.CreateNlbDNS(createOpts)
+		if err != nil {
+			return fmt.Errorf("Error registering load balancer hostname %s with cluster %s: %s", v.(string), cluster, err)
+		}
+		if nlb.NlbSubdomain != nil {
+			nlbHost = *nlb.NlbSubdomain
+		}
+	} else {
+		registerOpts := &kubernetesserviceapiv1.RegisterDNSWithIPOptions{
+			IdOrName:   &cluster,
+			NlbIPArray: expandStringList(d.Get("nlb_ips").(*schema.Set).List()),
+		}
+		if ns, ok := d.GetOk("secret_namespace"); ok {
+			registerOpts.SecretNamespace = ptrToString(ns.(string))
+		}
+		if targetEnv.ResourceGroup != "" {
+			registerOpts.XAuthResourceGroup = &targetEnv.ResourceGroup
+		}
+		nlb, _, err := satClient.RegisterDNSWithIP(registerOpts)
+		if err != nil {
+			return fmt.Errorf("Error registering NLB IPs with cluster %s: %s", cluster, err)
+		}
+		if nlb.NlbHost != nil {
+			nlbHost = *nlb.NlbHost
+		}
+	}
+	if nlbHost == "" {
+		return fmt.Errorf("The subdomain registered with cluster %s is not returned", cluster)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", cluster, nlbHost))
+
+	if _, ok := d.GetOk("health_monitor"); ok {
+		err = configureNlbDnsHealthMonitor(d, meta, cluster, nlbHost)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMContainerNlbDnsRead(d, meta)
+}
+
+func resourceIBMContainerNlbDnsRead(d *schema.ResourceData, meta interface{}) error {
+	satClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	cluster := parts[0]
+	nlbHost := parts[1]
+
+	// VPC clusters register load balancer hostnames, classic clusters NLB IPs.
+	// On import neither is known yet, so both lists are searched.
+	found := false
+	if _, ok := d.GetOk("nlb_ips"); !ok {
+		nlbs, response, err := satClient.GetNlbDNSList(&kubernetesserviceapiv1.GetNlbDNSListOptions{
+			Cluster: &cluster,
+		})
+		if err != nil {
+			if response != nil && response.StatusCode == http.StatusNotFound {
+				d.SetId("")
+				return nil
+			}
+			if _, ok := d.GetOk("lb_hostname"); ok {
+				return fmt.Errorf("Error retrieving the subdomains of cluster %s: %s", cluster, err)
+			}
+		}
+		for _, nlb := range nlbs {
+			if nlb.Nlb != nil && nlb.Nlb.NlbSubdomain != nil && *nlb.Nlb.NlbSubdomain == nlbHost {
+				found = true
+				d.Set("lb_hostname", nlb.Nlb.LbHostname)
+				if nlb.Nlb.Type != nil {
+					d.Set("type", *nlb.Nlb.Type)
+				}
+				d.Set("secret_namespace", nlb.Nlb.SecretNamespace)
+				d.Set("secret_name", nlb.SecretName)
+				d.Set("secret_status", nlb.SecretStatus)
+			}
+		}
+	}
+	if !found {
+		if _, ok := d.GetOk("lb_hostname"); !ok {
+			listOpts := &kubernetesserviceapiv1.ListNLBIPsForSubdomainOptions{
+				IdOrName: &cluster,
+			}
+			if targetEnv.ResourceGroup != "" {
+				listOpts.XAuthResourceGroup = &targetEnv.ResourceGroup
+			}
+			hosts, response, err := satClient.ListNLBIPsForSubdomain(listOpts)
+			if err != nil {
+				if response != nil && response.StatusCode == http.StatusNotFound {
+					d.SetId("")
+					return nil
+				}
+				return fmt.Errorf("Error retrieving the subdomains of cluster %s: %s", cluster, err)
+			}
+			for _, nlb := range hosts.Nlbs {
+				if nlb.NlbHost != nil && *nlb.NlbHost == nlbHost && len(nlb.NlbIPArray) > 0 {
+					found = true
+					d.Set("nlb_ips", nlb.NlbIPArray)
+					d.Set("secret_namespace", nlb.SecretNamespace)
+					d.Set("secret_name", nlb.NlbSslSecretName)
+					d.Set("secret_status", nlb.NlbSslSecretStatus)
+				}
+			}
+		}
+	}
+	if !found {
+		log.Printf("[WARN] Subdomain %s is not found in cluster %s, removing it from state", nlbHost, cluster)
+		d.SetId("")
+		return nil
+	}
+
+	if _, ok := d.GetOk("health_monitor"); ok {
+		monitorOpts := &kubernetesserviceapiv1.GetNlbDNSHealthMonitorOptions{
+			IdOrName: &cluster,
+			NlbHost:  &nlbHost,
+		}
+		if targetEnv.ResourceGroup != "" {
+			monitorOpts.XAuthResourceGroup = &targetEnv.ResourceGroup
+		}
+		monitor, _, err := satClient.GetNlbDNSHealthMonitor(monitorOpts)
+		if err != nil {
+			return fmt.Errorf("Error retrieving the health check monitor of subdomain %s: %s", nlbHost, err)
+		}
+		d.Set("health_monitor", flattenNlbDnsHealthMonitor(monitor))
+	}
+
+	d.Set("cluster", cluster)
+	d.Set("nlb_host", nlbHost)
+	return nil
+}
+
+func resourceIBMContainerNlbDnsUpdate(d *schema.ResourceData, meta interface{}) error {
+	satClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	cluster := d.Get("cluster").(string)
+	nlbHost := d.Get("nlb_host").(string)
+
+	if d.HasChange("lb_hostname") {
+		replaceOpts := &kubernetesserviceapiv1.ReplaceLBHostnameOptions{
+			Cluster:      &cluster,
+			LbHostname:   ptrToString(d.Get("lb_hostname").(string)),
+			NlbSubdomain: &nlbHost,
+			Type:         ptrToString(d.Get("type").(string)),
+		}
+		_, _, err = satClient.ReplaceLBHostname(replaceOpts)
+		if err != nil {
+			return fmt.Errorf("Error replacing the load balancer hostname of subdomain %s: %s", nlbHost, err)
+		}
+	}
+
+	if d.HasChange("nlb_ips") {
+		o, n := d.GetChange("nlb_ips")
+		added := expandStringList(n.(*schema.Set).Difference(o.(*schema.Set)).List())
+		removed := expandStringList(o.(*schema.Set).Difference(n.(*schema.Set)).List())
+
+		// Adding first keeps the subdomain registered when all IPs are replaced
+		if len(added) > 0 {
+			addOpts := &kubernetesserviceapiv1.UpdateDNSWithIPOptions{
+				IdOrName:   &cluster,
+				NlbHost:    &nlbHost,
+				NlbIPArray: added,
+			}
+			if targetEnv.ResourceGroup != "" {
+				addOpts.XAuthResourceGroup = &targetEnv.ResourceGroup
+			}
+			_, err = satClient.UpdateDNSWithIP(addOpts)
+			if err != nil {
+				return fmt.Errorf("Error adding NLB IPs to subdomain %s: %s", nlbHost, err)
+			}
+		}
+		for _, ip := range removed {
+			err = unregisterNlbDnsIP(satClient, cluster, nlbHost, ip, targetEnv.ResourceGroup)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	if d.HasChange("health_monitor") {
+		if _, ok := d.GetOk("health_monitor"); ok {
+			err = configureNlbDnsHealthMonitor(d, meta, cluster, nlbHost)
+		} else {
+			err = disableNlbDnsHealthMonitor(d, meta, cluster, nlbHost)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMContainerNlbDnsRead(d, meta)
+}
+
+func resourceIBMContainerNlbDnsDelete(d *schema.ResourceData, meta interface{}) error {
+	satClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	cluster := d.Get("cluster").(string)
+	nlbHost := d.Get("nlb_host").(string)
+
+	if v, ok := d.GetOk("lb_hostname"); ok {
+		removeOpts := &kubernetesserviceapiv1.RemoveLBHostnameOptions{
+			Cluster:      &cluster,
+			LbHostname:   ptrToString(v.(string)),
+			NlbSubdomain: &nlbHost,
+			Type:         ptrToString(d.Get("type").(string)),
+		}
+		_, err = satClient.RemoveLBHostname(removeOpts)
+		if err != nil {
+			return fmt.Errorf("Error removing the load balancer hostname of subdomain %s: %s", nlbHost, err)
+		}
+		d.SetId("")
+		return nil
+	}
+
+	if _, ok := d.GetOk("health_monitor"); ok {
+		err = disableNlbDnsHealthMonitor(d, meta, cluster, nlbHost)
+		if err != nil {
+			return err
+		}
+	}
+	for _, ip := range expandStringList(d.Get("nlb_ips").(*schema.Set).List()) {
+		err = unregisterNlbDnsIP(satClient, cluster, nlbHost, ip, targetEnv.ResourceGroup)
+		if err != nil {
+			return err
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+func unregisterNlbDnsIP(satClient *kubernetesserviceapiv1.KubernetesServiceApiV1, cluster, nlbHost, ip, resourceGroup string) error {
+	removeOpts := &kubernetesserviceapiv1.UnregisterDNSWithIPOptions{
+		IdOrName: &cluster,
+		NlbHost:  &nlbHost,
+		NlbIP:    &ip,
+	}
+	if resourceGroup != "" {
+		removeOpts.XAuthResourceGroup = &resourceGroup
+	}
+	_, err := satClient.UnregisterDNSWithIP(removeOpts)
+	if err != nil {
+		return fmt.Errorf("Error removing NLB IP %s from subdomain %s: %s", ip, nlbHost, err)
+	}
+	return nil
+}
+
+func configureNlbDnsHealthMonitor(d *schema.ResourceData, meta interface{}, cluster, nlbHost string) error {
+	monitor := d.Get("health_monitor").([]interface{})[0].(map[string]interface{})
+	monitorState := "Disabled"
+	if monitor["enabled"].(bool) {
+		monitorState = "Enabled"
+	}
+	properties := map[string]interface{}{
+		"type":             monitor["type"].(string),
+		"method":           monitor["method"].(string),
+		"path":             monitor["path"].(string),
+		"expectedCodes":    monitor["expected_codes"].(string),
+		"expectedBody":     monitor["expected_body"].(string),
+		"timeout":          monitor["timeout"].(int),
+		"retries":          monitor["retries"].(int),
+		"interval":         monitor["interval"].(int),
+		"allow_insecure":   monitor["allow_insecure"].(bool),
+		"follow_redirects": monitor["follow_redirects"].(bool),
+	}
+	if port := monitor["port"].(int); port != 0 {
+		properties["port"] = port
+	}
+	return setNlbDnsHealthMonitor(d, meta, cluster, nlbHost, monitorState, monitor["description"].(string), properties)
+}
+
+func disableNlbDnsHealthMonitor(d *schema.ResourceData, meta interface{}, cluster, nlbHost string) error {
+	return setNlbDnsHealthMonitor(d, meta, cluster, nlbHost, "Disabled", "", nil)
+}
+
+func setNlbDnsHealthMonitor(d *schema.ResourceData, meta interface{}, cluster, nlbHost, monitorState, description string, properties map[string]interface{}) error {
+	satClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	monitorOpts := &kubernetesserviceapiv1.AddNlbDNSHealthMonitorOptions{
+		IdOrName:              &cluster,
+		NlbHost:               &nlbHost,
+		MonitorState:          &monitorState,
+		HealthcheckProperties: properties,
+	}
+	if description != "" {
+		monitorOpts.Desc = &description
+	}
+	if targetEnv.ResourceGroup != "" {
+		monitorOpts.XAuthResourceGroup = &targetEnv.ResourceGroup
+	}
+	_, _, err = satClient.AddNlbDNSHealthMonitor(monitorOpts)
+	if err != nil {
+		return fmt.Errorf("Error configuring the health check monitor of subdomain %s: %s", nlbHost, err)
+	}
+	return nil
+}
+
+func flattenNlbDnsHealthMonitor(monitor *kubernetesserviceapiv1.NlbHealthConfig) []map[string]interface{} {
+	m := map[string]interface{}{
+		"enabled": monitor.MonitorState != nil && *monitor.MonitorState == "Enabled",
+	}
+	if monitor.Desc != nil {
+		m["description"] = *monitor.Desc
+	}
+	if p := monitor.HealthcheckProperties; p != nil {
+		if p.Type != nil {
+			m["type"] = *p.Type
+		}
+		if p.Method != nil {
+			m["method"] = *p.Method
+		}
+		if p.Path != nil {
+			m["path"] = *p.Path
+		}
+		if p.Port != nil {
+			m["port"] = int(*p.Port)
+		}
+		if p.ExpectedCodes != nil {
+			m["expected_codes"] = *p.ExpectedCodes
+		}
+		if p.ExpectedBody != nil {
+			m["expected_body"] = *p.ExpectedBody
+		}
+		if p.Timeout != nil {
+			m["timeout"] = int(*p.Timeout)
+		}
+		if p.Retries != nil {
+			m["retries"] = int(*p.Retries)
+		}
+		if p.Interval != nil {
+			m["interval"] = int(*p.Interval)
+		}
+		if p.AllowInsecure != nil {
+			m["allow_insecure"] = *p.AllowInsecure
+		}
+		if p.FollowRedirects != nil {
+			m["follow_redirects"] = *p.FollowRedirects
+		}
+	}
+	return []map[string]interface{}{m}
+}
@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerNlbDnsBasic(t *testing.T) {
+	ips := strings.Split(nlbIPs, ",")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerNlbDnsBasic(ips[:1]),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_nlb_dns.dns", "nlb_ips.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_container_nlb_dns.dns", "nlb_host"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_nlb_dns.dns", "health_monitor.0.path", "/healthz"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerNlbDnsBasic(ips),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_nlb_dns.dns", "nlb_ips.#", fmt.Sprintf("%d", len(ips))),
+				),
+			},
+			{
+				ResourceName:            "ibm_container_nlb_dns.dns",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"health_monitor"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerNlbDnsBasic(ips []string) string {
+	return fmt.Sprintf(`
+	data "ibm_container_cluster" "cluster" {
+	  cluster_name_id = "%s"
+	}
+	resource "ibm_container_nlb_dns" "dns" {
+	  cluster = data.ibm_container_cluster.cluster.id
+	  nlb_ips = ["%s"]
+	  health_monitor {
+		type = "HTTP"
+		path = "/healthz"
+	  }
+	}
+	`, ingressClusterName, strings.Join(ips, `", "`))
+}
@@ -654,7 +654,7 @@ func resourceIBMContainerVpcClusterUpdate(d *schema.ResourceData, meta interface
 
 					if waitForWorkerUpdate {
 						//1. wait for worker node to delete
-						_, deleteError := waitForWorkerNodetoDelete(d, meta, targetEnv, worker.ID)
+						_, deleteError := waitForWorkerNodetoDelete(meta, d.Id(), targetEnv, worker.ID, d.Timeout(schema.TimeoutDelete))
 						if deleteError != nil {
 							d.Set("patch_version", nil)
 							return fmt.Errorf("Worker node - %s is failed to replace", worker.ID)
@@ -1284,14 +1284,13 @@ func vpcClusterWorkersVersionRefreshFunc(client v2.Workers, workerID, clusterID
 	}
 }
 
-func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetEnv v2.ClusterTargetHeader, workerID string) (interface{}, error) {
+func waitForWorkerNodetoDelete(meta interface{}, clusterID string, targetEnv v2.ClusterTargetHeader, workerID string, timeout time.Duration) (interface{}, error) {
 
 	csClient, err := meta.(ClientSession).VpcContainerAPI()
 	if err != nil {
 		return nil, err
 	}
 
-	clusterID := d.Id()
 	deleteStateConf := &resource.StateChangeConf{
 		Pending: []string{workerDeletePending},
 		Target:  []string{workerDeleteState},
@@ -1305,7 +1304,7 @@ func waitForWorkerNodetoDelete(d *schema.ResourceData, meta interface{}, targetE
 			}
 			return worker, workerDeletePending, nil
 		},
-		Timeout:      d.Timeout(schema.TimeoutDelete),
+		Timeout:      timeout,
 		Delay:        10 * time.Second,
 		MinTimeout:   5 * time.Second,
 		PollInterval: 5 * time.Second,
@@ -15,6 +15,7 @@ import (
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
 	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.ibm.com/ibmcloud/kubernetesservice-go-sdk/kubernetesserviceapiv1"
 )
 
 const (
@@ -32,6 +33,7 @@ func resourceIBMContainerVpcWorkerPool() *schema.Resource {
 		Importer: &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(90 * time.Minute),
+			Update: schema.DefaultTimeout(90 * time.Minute),
 			Delete: schema.DefaultTimeout(90 * time.Minute),
 		},
 
@@ -46,7 +48,6 @@ func resourceIBMContainerVpcWorkerPool() *schema.Resource {
 			"flavor": {
 				Type:        schema.TypeString,
 				Required:    true,
-				ForceNew:    true,
 				Description: "cluster node falvor",
 			},
 
@@ -86,6 +87,32 @@ func resourceIBMContainerVpcWorkerPool() *schema.Resource {
 				Description: "Labels",
 			},
 
+			"taints": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "WorkerPool Taints",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Key for taint",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Value for taint",
+						},
+						"effect": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"NoSchedule", "PreferNoSchedule", "NoExecute"}),
+							Description:  "Effect for taint. Accepted values are NoSchedule, PreferNoSchedule and NoExecute.",
+						},
+					},
+				},
+			},
+
 			"resource_group_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -100,9 +127,16 @@ func resourceIBMContainerVpcWorkerPool() *schema.Resource {
 				ForceNew:    true,
 			},
 			"worker_count": {
-				Type:        schema.TypeInt,
-				Required:    true,
-				Description: "The number of workers",
+				Type:             schema.TypeInt,
+				Required:         true,
+				DiffSuppressFunc: suppressAutoscaledWorkerCount,
+				Description:      "The number of workers",
+			},
+			"autoscaling_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Set to true if the worker pool is scaled by the cluster autoscaler, changes to worker_count are then ignored",
 			},
 			"entitlement": {
 				Type:             schema.TypeString,
@@ -110,6 +144,12 @@ func resourceIBMContainerVpcWorkerPool() *schema.Resource {
 				DiffSuppressFunc: applyOnce,
 				Description:      "Entitlement option reduces additional OCP Licence cost in Openshift Clusters",
 			},
+			"wait_for_worker_update": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Wait for the surge worker pool to be removed when the flavor of the worker pool is changed",
+			},
 			ResourceControllerURL: {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -121,19 +161,36 @@ func resourceIBMContainerVpcWorkerPool() *schema.Resource {
 
 func resourceIBMContainerVpcWorkerPoolCreate(d *schema.ResourceData, meta interface{}) error {
 
-	wpClient, err := meta.(ClientSession).VpcContainerAPI()
+	clusterNameorID := d.Get("cluster").(string)
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
 	if err != nil {
 		return err
 	}
 
-	clusterNameorID := d.Get("cluster").(string)
-	var zonei []interface{}
+	workerPoolID, err := createVpcWorkerPool(d, meta, clusterNameorID, d.Get("worker_pool_name").(string), true, d.Timeout(schema.TimeoutCreate), targetEnv)
+	if workerPoolID != "" {
+		d.SetId(fmt.Sprintf("%s/%s", clusterNameorID, workerPoolID))
+	}
+	if err != nil {
+		return err
+	}
+
+	return resourceIBMContainerVpcWorkerPoolUpdate(d, meta)
+}
+
+// createVpcWorkerPool creates a worker pool with the given name from the configuration
+// of the resource and returns the ID of the new worker pool
+func createVpcWorkerPool(d *schema.ResourceData, meta interface{}, clusterNameorID, workerPoolName string, wait bool, timeout time.Duration, targetEnv v2.ClusterTargetHeader) (string, error) {
+
+	wpClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return "", err
+	}
 
 	zone := []v2.Zone{}
 
 	if res, ok := d.GetOk("zones"); ok {
-		zonei = res.(*schema.Set).List()
-		for _, e := range zonei {
+		for _, e := range res.(*schema.Set).List() {
 			r, _ := e.(map[string]interface{})
 			zoneParam := v2.Zone{
 				ID:       r["name"].(string),
@@ -144,15 +201,8 @@ func resourceIBMContainerVpcWorkerPoolCreate(d *schema.ResourceData, meta interf
 
 	}
 
-	// for _, e := range d.Get("zones").(*schema.Set).List() {
-	// 	value := e.(map[string]interface{})
-	// 	id := value["id"].(string)
-	// 	subnetid := value["subnet_id"].(string)
-
-	// }
-
 	workerPoolConfig := v2.WorkerPoolConfig{
-		Name:        d.Get("worker_pool_name").(string),
+		Name:        workerPoolName,
 		VpcID:       d.Get("vpc_id").(string),
 		Flavor:      d.Get("flavor").(string),
 		WorkerCount: d.Get("worker_count").(int),
@@ -166,41 +216,46 @@ func resourceIBMContainerVpcWorkerPoolCreate(d *schema.ResourceData, meta interf
 		}
 		workerPoolConfig.Labels = labels
 	}
-	params := v2.WorkerPoolRequest{
-		WorkerPoolConfig: workerPoolConfig,
-		Cluster:          clusterNameorID,
-	}
 
 	// Update workerpoolConfig with Entitlement option if provided
 	if v, ok := d.GetOk("entitlement"); ok {
 		workerPoolConfig.Entitlement = v.(string)
 	}
 
-	workerPoolsAPI := wpClient.WorkerPools()
-	targetEnv, err := getVpcClusterTargetHeader(d, meta)
-	if err != nil {
-		return err
+	params := v2.WorkerPoolRequest{
+		WorkerPoolConfig: workerPoolConfig,
+		Cluster:          clusterNameorID,
 	}
 
-	res, err := workerPoolsAPI.CreateWorkerPool(params, targetEnv)
+	res, err := wpClient.WorkerPools().CreateWorkerPool(params, targetEnv)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	d.SetId(fmt.Sprintf("%s/%s", clusterNameorID, res.ID))
-
-	//wait for workerpool availability
-	_, err = WaitForWorkerPoolAvailable(d, meta, clusterNameorID, res.ID, d.Timeout(schema.TimeoutCreate), targetEnv)
-	if err != nil {
-		return fmt.Errorf(
-			"Error waiting for workerpool (%s) to become ready: %s", d.Id(), err)
+	if wait {
+		//wait for workerpool availability
+		_, err = WaitForWorkerPoolAvailable(d, meta, clusterNameorID, res.ID, timeout, targetEnv)
+		if err != nil {
+			return res.ID, fmt.Errorf(
+				"Error waiting for workerpool (%s) to become ready: %s", workerPoolName, err)
+		}
 	}
 
-	return resourceIBMContainerVpcWorkerPoolUpdate(d, meta)
+	return res.ID, nil
 }
 
 func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interface{}) error {
 
+	if d.HasChange("flavor") && !d.IsNewResource() {
+		// The new worker pool is created from the complete configuration, so
+		// no other change is left to apply
+		err := replaceVpcWorkerPoolFlavor(d, meta)
+		if err != nil {
+			return err
+		}
+		return resourceIBMContainerVpcWorkerPoolRead(d, meta)
+	}
+
 	if d.HasChange("labels") && !d.IsNewResource() {
 		clusterNameOrID := d.Get("cluster").(string)
 		workerPoolName := d.Get("worker_pool_name").(string)
@@ -216,19 +271,36 @@ func resourceIBMContainerVpcWorkerPoolUpdate(d *schema.ResourceData, meta interf
 		if err != nil {
 			return err
 		}
-		ClusterClient, err := meta.(ClientSession).ContainerAPI()
+		satClient, err := meta.(ClientSession).SatelliteClientSession()
 		if err != nil {
 			return err
 		}
-		Env := v1.ClusterTargetHeader{ResourceGroup: targetEnv.ResourceGroup}
-
-		err = ClusterClient.WorkerPools().UpdateLabelsWorkerPool(clusterNameOrID, workerPoolName, labels, Env)
+		labelOpts := &kubernetesserviceapiv1.V2SetWorkerPoolLabelsOptions{
+			Cluster:    &clusterNameOrID,
+			Workerpool: &workerPoolName,
+			Labels:     labels,
+		}
+		if targetEnv.ResourceGroup != "" {
+			labelOpts.XAuthResourceGroup = &targetEnv.ResourceGroup
+		}
+		_, err = satClient.V2SetWorkerPoolLabels(labelOpts)
 		if err != nil {
 			return fmt.Errorf(
 				"Error updating the labels: %s", err)
 		}
 	}
 
+	if d.HasChange("taints") {
+		targetEnv, err := getVpcClusterTargetHeader(d, meta)
+		if err != nil {
+			return err
+		}
+		err = updateVpcWorkerPoolTaints(d, meta, d.Get("cluster").(string), d.Get("worker_pool_name").(string), targetEnv)
+		if err != nil {
+			return err
+		}
+	}
+
 	if d.HasChange("worker_count") {
 		clusterNameOrID := d.Get("cluster").(string)
 		workerPoolName := d.Get("worker_pool_name").(string)
@@ -355,8 +427,25 @@ func resourceIBMContainerVpcWorkerPoolRead(d *schema.ResourceData, meta interfac
 		return fmt.Errorf("Error retrieving conatiner vpc cluster: %s", err)
 	}
 
+	satClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+	getWorkerPoolOptions := &kubernetesserviceapiv1.GetWorkerPoolOptions{
+		Cluster:    &cluster,
+		Workerpool: &workerPoolID,
+	}
+	if targetEnv.ResourceGroup != "" {
+		getWorkerPoolOptions.XAuthResourceGroup = &targetEnv.ResourceGroup
+	}
+	poolDetails, _, err := satClient.GetWorkerPool(getWorkerPoolOptions)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the taints of the worker pool: %s", err)
+	}
+
 	d.Set("worker_pool_name", workerPool.PoolName)
 	d.Set("flavor", workerPool.Flavor)
+	d.Set("taints", flattenWorkerPoolTaints(poolDetails.Taints))
 	d.Set("worker_count", workerPool.WorkerCount)
 	// d.Set("provider", workerPool.Provider)
 	d.Set("labels", IgnoreSystemLabels(workerPool.Labels))
@@ -513,3 +602,137 @@ func vpcworkerPoolDeleteStateRefreshFunc(client v2.Workers, instanceID, workerPo
 		return workerFields, workerDeleteState, nil
 	}
 }
+
+func updateVpcWorkerPoolTaints(d *schema.ResourceData, meta interface{}, clusterNameOrID, workerPoolName string, targetEnv v2.ClusterTargetHeader) error {
+	satClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+	taintOpts := &kubernetesserviceapiv1.V2SetWorkerPoolTaintsOptions{
+		Cluster:    &clusterNameOrID,
+		Workerpool: &workerPoolName,
+		Taints:     expandWorkerPoolTaints(d.Get("taints").(*schema.Set).List()),
+	}
+	if targetEnv.ResourceGroup != "" {
+		taintOpts.XAuthResourceGroup = &targetEnv.ResourceGroup
+	}
+	_, err = satClient.V2SetWorkerPoolTaints(taintOpts)
+	if err != nil {
+		return fmt.Errorf("Error updating the taints: %s", err)
+	}
+	return nil
+}
+
+// replaceVpcWorkerPoolFlavor rolls the worker pool over to a new flavor. A worker
+// pool cannot change its flavor, so a surge pool with the new flavor takes the
+// workload while the worker pool is recreated under the same name.
+func replaceVpcWorkerPoolFlavor(d *schema.ResourceData, meta interface{}) error {
+	wpClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	clusterNameOrID := parts[0]
+	timeout := d.Timeout(schema.TimeoutUpdate)
+
+	rollover := vpcWorkerPoolRollover{
+		clusterNameOrID: clusterNameOrID,
+		createPool: func(name string) (string, error) {
+			return createVpcWorkerPool(d, meta, clusterNameOrID, name, true, timeout, targetEnv)
+		},
+		updateTaints: func(name string) error {
+			return updateVpcWorkerPoolTaints(d, meta, clusterNameOrID, name, targetEnv)
+		},
+		deletePool: func(workerPoolID string) error {
+			return wpClient.WorkerPools().DeleteWorkerPool(clusterNameOrID, workerPoolID, targetEnv)
+		},
+		waitForDelete: func(workerPoolID string) error {
+			_, err := WaitForVpcWorkerDelete(clusterNameOrID, workerPoolID, meta, timeout, targetEnv)
+			return err
+		},
+		setID: func(workerPoolID string) {
+			d.SetId(fmt.Sprintf("%s/%s", clusterNameOrID, workerPoolID))
+		},
+	}
+	return rollover.roll(parts[1], d.Get("worker_pool_name").(string), d.Get("wait_for_worker_update").(bool))
+}
+
+// vpcWorkerPoolRollover holds the calls of the steps of a flavor change
+type vpcWorkerPoolRollover struct {
+	clusterNameOrID string
+	createPool      func(name string) (string, error)
+	updateTaints    func(name string) error
+	deletePool      func(workerPoolID string) error
+	waitForDelete   func(workerPoolID string) error
+	setID           func(workerPoolID string)
+}
+
+// roll replaces the old worker pool. The surge pool is removed when the
+// replacement fails before the old worker pool is removed. Once it's removed,
+// the surge pool is the only one left with the workload and it's kept.
+func (r vpcWorkerPoolRollover) roll(oldWorkerPoolID, workerPoolName string, waitForWorkerUpdate bool) (err error) {
+	clusterNameOrID := r.clusterNameOrID
+	surgePoolName := fmt.Sprintf("%s-surge", workerPoolName)
+
+	//1. bring up the surge pool with the new flavor
+	surgePoolID, err := r.createPool(surgePoolName)
+	removeSurgePool := true
+	defer func() {
+		if err != nil && surgePoolID != "" && removeSurgePool {
+			log.Printf("[INFO] Removing surge worker pool (%s) of cluster (%s)", surgePoolName, clusterNameOrID)
+			if deleteErr := r.deletePool(surgePoolID); deleteErr != nil {
+				log.Printf("[ERROR] Error removing surge worker pool (%s) of cluster (%s): %s", surgePoolName, clusterNameOrID, deleteErr)
+			}
+		}
+	}()
+	if err != nil {
+		return fmt.Errorf("Error creating the surge worker pool %s: %s", surgePoolName, err)
+	}
+	if err = r.updateTaints(surgePoolName); err != nil {
+		return err
+	}
+
+	//2. remove the workers with the old flavor
+	err = r.deletePool(oldWorkerPoolID)
+	if err != nil {
+		return fmt.Errorf("Error removing worker pool (%s) of cluster (%s): %s", oldWorkerPoolID, clusterNameOrID, err)
+	}
+	removeSurgePool = false
+	if err = r.waitForDelete(oldWorkerPoolID); err != nil {
+		return fmt.Errorf(
+			"Error waiting for removing workers of worker pool (%s) of cluster (%s), the surge worker pool (%s) keeps the workload: %s", oldWorkerPoolID, clusterNameOrID, surgePoolName, err)
+	}
+
+	//3. recreate the worker pool with the new flavor, the surge pool keeps the
+	// workload until the new workers are deployed
+	workerPoolID, err := r.createPool(workerPoolName)
+	if workerPoolID != "" {
+		r.setID(workerPoolID)
+	}
+	if err != nil {
+		return fmt.Errorf("Error recreating worker pool (%s) of cluster (%s), the surge worker pool (%s) keeps the workload: %s", workerPoolName, clusterNameOrID, surgePoolName, err)
+	}
+	if err = r.updateTaints(workerPoolName); err != nil {
+		return fmt.Errorf("%s, the surge worker pool (%s) keeps the workload", err, surgePoolName)
+	}
+
+	//4. remove the surge pool
+	err = r.deletePool(surgePoolID)
+	if err != nil {
+		return fmt.Errorf("Error removing surge worker pool (%s) of cluster (%s): %s", surgePoolName, clusterNameOrID, err)
+	}
+	if waitForWorkerUpdate {
+		if err = r.waitForDelete(surgePoolID); err != nil {
+			return fmt.Errorf(
+				"Error waiting for removing workers of surge worker pool (%s) of cluster (%s): %s", surgePoolName, clusterNameOrID, err)
+		}
+	}
+	return nil
+}
@@ -35,7 +35,7 @@ func TestAccIBMContainerVpcClusterWorkerPoolBasic(t *testing.T) {
 				),
 			},
 			{
-				Config: testAccCheckIBMVpcContainerWorkerPoolUpdate(name),
+				Config: testAccCheckIBMVpcContainerWorkerPoolUpdate(name, "cx2.2x4"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr(
 						"ibm_container_vpc_worker_pool.test_pool", "flavor", "cx2.2x4"),
@@ -43,12 +43,28 @@ func TestAccIBMContainerVpcClusterWorkerPoolBasic(t *testing.T) {
 						"ibm_container_vpc_worker_pool.test_pool", "zones.#", "2"),
 					resource.TestCheckResourceAttr(
 						"ibm_container_vpc_worker_pool.test_pool", "labels.%", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "taints.#", "1"),
 				),
 			},
 			{
-				ResourceName:      "ibm_container_vpc_worker_pool.test_pool",
-				ImportState:       true,
-				ImportStateVerify: true,
+				Config: testAccCheckIBMVpcContainerWorkerPoolUpdate(name, "cx2.4x8"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "flavor", "cx2.4x8"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "zones.#", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "labels.%", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_vpc_worker_pool.test_pool", "taints.#", "1"),
+				),
+			},
+			{
+				ResourceName:            "ibm_container_vpc_worker_pool.test_pool",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"autoscaling_enabled", "wait_for_worker_update"},
 			},
 		},
 	})
@@ -145,7 +161,7 @@ func testAccCheckIBMVpcContainerWorkerPoolBasic(name string) string {
 		`, name)
 }
 
-func testAccCheckIBMVpcContainerWorkerPoolUpdate(name string) string {
+func testAccCheckIBMVpcContainerWorkerPoolUpdate(name, flavor string) string {
 	return fmt.Sprintf(`
 	provider "ibm" {
 		region="eu-de"
@@ -183,7 +199,7 @@ func testAccCheckIBMVpcContainerWorkerPoolUpdate(name string) string {
 	resource "ibm_container_vpc_worker_pool" "test_pool" {
 	  cluster           = ibm_container_vpc_cluster.cluster.id
 	  worker_pool_name  = "%[1]s"
-	  flavor            = "cx2.2x4"
+	  flavor            = "%[2]s"
 	  vpc_id            = ibm_is_vpc.vpc.id
 	  worker_count      = 1
 	  resource_group_id = data.ibm_resource_group.resource_group.id
@@ -200,6 +216,71 @@ func testAccCheckIBMVpcContainerWorkerPoolUpdate(name string) string {
 		"test1" = "test-pool1"
 		"test2" = "test-pool2"
 	  }
+	  taints {
+		key    = "dedicated"
+		value  = "gpu"
+		effect = "NoSchedule"
+	  }
+	}
+		`, name, flavor)
+}
+
+// testVpcWorkerPoolRollover records the calls of a flavor change, the step
+// named fail returns an error
+func testVpcWorkerPoolRollover(fail string, deleted *[]string) vpcWorkerPoolRollover {
+	failure := fmt.Errorf("%s failed", fail)
+	return vpcWorkerPoolRollover{
+		clusterNameOrID: "cluster",
+		createPool: func(name string) (string, error) {
+			if fail == "create "+name {
+				return "", failure
+			}
+			return name + "-id", nil
+		},
+		updateTaints: func(name string) error {
+			if fail == "taints "+name {
+				return failure
+			}
+			return nil
+		},
+		deletePool: func(workerPoolID string) error {
+			if fail == "delete "+workerPoolID {
+				return failure
+			}
+			*deleted = append(*deleted, workerPoolID)
+			return nil
+		},
+		waitForDelete: func(workerPoolID string) error {
+			if fail == "wait "+workerPoolID {
+				return failure
+			}
+			return nil
+		},
+		setID: func(workerPoolID string) {},
+	}
+}
+
+func TestVpcWorkerPoolRolloverSurgePool(t *testing.T) {
+	for _, tc := range []struct {
+		fail    string
+		deleted []string
+	}{
+		// The old worker pool is kept, the surge pool is removed
+		{fail: "taints pool-surge", deleted: []string{"pool-surge-id"}},
+		{fail: "delete old-id", deleted: []string{"pool-surge-id"}},
+		// The old worker pool is removed, the surge pool keeps the workload
+		{fail: "wait old-id", deleted: []string{"old-id"}},
+		{fail: "create pool", deleted: []string{"old-id"}},
+		{fail: "taints pool", deleted: []string{"old-id"}},
+		{fail: "", deleted: []string{"old-id", "pool-surge-id"}},
+	} {
+		deleted := []string{}
+		err := testVpcWorkerPoolRollover(tc.fail, &deleted).roll("old-id", "pool", true)
+		if (err != nil) != (tc.fail != "") {
+			t.Errorf("%q: unexpected error %v", tc.fail, err)
+		}
+		if strings.Join(deleted, ",") != strings.Join(tc.deleted, ",") {
+			t.Errorf("%q: deleted worker pools %v, expected %v", tc.fail, deleted, tc.deleted)
+		}
 	}
-		`, name)
 }
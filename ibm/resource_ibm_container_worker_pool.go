@@ -51,10 +51,18 @@ func resourceIBMContainerWorkerPool() *schema.Resource {
 			},
 
 			"size_per_zone": {
-				Type:         schema.TypeInt,
-				Required:     true,
-				ValidateFunc: validateSizePerZone,
-				Description:  "Number of nodes per zone",
+				Type:             schema.TypeInt,
+				Required:         true,
+				ValidateFunc:     validateSizePerZone,
+				DiffSuppressFunc: suppressAutoscaledWorkerCount,
+				Description:      "Number of nodes per zone",
+			},
+
+			"autoscaling_enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Set to true if the worker pool is scaled by the cluster autoscaler, changes to size_per_zone are then ignored",
 			},
 
 			"entitlement": {
@@ -0,0 +1,248 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	autoscalerConfigMapName      = "iks-ca-configmap"
+	autoscalerConfigMapNamespace = "kube-system"
+	autoscalerWorkerPoolsKey     = "workerPoolsConfig.json"
+)
+
+// autoscalerWorkerPoolConfig is one entry of the workerPoolsConfig.json key of
+// the cluster autoscaler add-on config map.
+type autoscalerWorkerPoolConfig struct {
+	Name    string `json:"name"`
+	MinSize int    `json:"minSize"`
+	MaxSize int    `json:"maxSize"`
+	Enabled bool   `json:"enabled"`
+}
+
+func resourceIBMContainerWorkerPoolAutoscaling() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMContainerWorkerPoolAutoscalingCreate,
+		Read:     resourceIBMContainerWorkerPoolAutoscalingRead,
+		Update:   resourceIBMContainerWorkerPoolAutoscalingUpdate,
+		Delete:   resourceIBMContainerWorkerPoolAutoscalingDelete,
+		Importer: &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster name or ID",
+			},
+			"worker_pool": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the worker pool to autoscale",
+			},
+			"min_size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateAllowedRangeInt(0, 500),
+				Description:  "Minimum number of workers per zone the autoscaler keeps in the worker pool",
+			},
+			"max_size": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateAllowedRangeInt(1, 500),
+				Description:  "Maximum number of workers per zone the autoscaler scales the worker pool to",
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Enable autoscaling of the worker pool",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the resource group.",
+			},
+		},
+	}
+}
+
+func resourceIBMContainerWorkerPoolAutoscalingCreate(d *schema.ResourceData, meta interface{}) error {
+	cluster := d.Get("cluster").(string)
+	workerPool := d.Get("worker_pool").(string)
+
+	err := setAutoscalerWorkerPoolConfig(d, meta, cluster, autoscalerWorkerPoolConfig{
+		Name:    workerPool,
+		MinSize: d.Get("min_size").(int),
+		MaxSize: d.Get("max_size").(int),
+		Enabled: d.Get("enabled").(bool),
+	}, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cluster, workerPool))
+	return resourceIBMContainerWorkerPoolAutoscalingRead(d, meta)
+}
+
+func resourceIBMContainerWorkerPoolAutoscalingRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	cluster := parts[0]
+	workerPool := parts[1]
+
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	kube, err := newKubeAPI(meta, cluster, targetEnv)
+	if err != nil {
+		return err
+	}
+	configMap, err := kube.getConfigMap(autoscalerConfigMapNamespace, autoscalerConfigMapName)
+	if err != nil {
+		if isKubeNotFound(err) {
+			log.Printf("[WARN] The cluster autoscaler config map is not found in cluster %s, removing autoscaling of worker pool %s from state", cluster, workerPool)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving the cluster autoscaler config map: %s", err)
+	}
+	pools, err := autoscalerWorkerPoolConfigs(configMap)
+	if err != nil {
+		return err
+	}
+	for _, pool := range pools {
+		if pool.Name == workerPool {
+			d.Set("cluster", cluster)
+			d.Set("worker_pool", pool.Name)
+			d.Set("min_size", pool.MinSize)
+			d.Set("max_size", pool.MaxSize)
+			d.Set("enabled", pool.Enabled)
+			return nil
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func resourceIBMContainerWorkerPoolAutoscalingUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("min_size") || d.HasChange("max_size") || d.HasChange("enabled") {
+		err := setAutoscalerWorkerPoolConfig(d, meta, d.Get("cluster").(string), autoscalerWorkerPoolConfig{
+			Name:    d.Get("worker_pool").(string),
+			MinSize: d.Get("min_size").(int),
+			MaxSize: d.Get("max_size").(int),
+			Enabled: d.Get("enabled").(bool),
+		}, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+	return resourceIBMContainerWorkerPoolAutoscalingRead(d, meta)
+}
+
+// The entry is kept with autoscaling disabled, which is how the add-on itself
+// lists worker pools that are not autoscaled
+func resourceIBMContainerWorkerPoolAutoscalingDelete(d *schema.ResourceData, meta interface{}) error {
+	err := setAutoscalerWorkerPoolConfig(d, meta, d.Get("cluster").(string), autoscalerWorkerPoolConfig{
+		Name:    d.Get("worker_pool").(string),
+		MinSize: d.Get("min_size").(int),
+		MaxSize: d.Get("max_size").(int),
+		Enabled: false,
+	}, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
+
+func autoscalerWorkerPoolConfigs(configMap *kubeConfigMap) ([]autoscalerWorkerPoolConfig, error) {
+	pools := []autoscalerWorkerPoolConfig{}
+	raw, ok := configMap.Data[autoscalerWorkerPoolsKey]
+	if !ok || raw == "" {
+		return pools, nil
+	}
+	if err := json.Unmarshal([]byte(raw), &pools); err != nil {
+		return nil, fmt.Errorf("Error parsing %s of the cluster autoscaler config map: %s", autoscalerWorkerPoolsKey, err)
+	}
+	return pools, nil
+}
+
+// setAutoscalerWorkerPoolConfig adds or replaces the entry of one worker pool in
+// the autoscaler config map, leaving the other worker pools untouched.
+func setAutoscalerWorkerPoolConfig(d *schema.ResourceData, meta interface{}, cluster string, poolConfig autoscalerWorkerPoolConfig, timeout time.Duration) error {
+	if poolConfig.MinSize > poolConfig.MaxSize {
+		return fmt.Errorf("min_size (%d) of worker pool %s must not be greater than max_size (%d)", poolConfig.MinSize, poolConfig.Name, poolConfig.MaxSize)
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	kube, err := newKubeAPI(meta, cluster, targetEnv)
+	if err != nil {
+		return err
+	}
+
+	// All worker pools of a cluster share the config map
+	ibmMutexKV.Lock(cluster)
+	defer ibmMutexKV.Unlock(cluster)
+
+	return resource.Retry(timeout, func() *resource.RetryError {
+		configMap, err := kube.getConfigMap(autoscalerConfigMapNamespace, autoscalerConfigMapName)
+		if err != nil {
+			if isKubeNotFound(err) {
+				return resource.NonRetryableError(fmt.Errorf("The cluster autoscaler config map is not found in cluster %s, enable the cluster-autoscaler add-on with ibm_container_addons first", cluster))
+			}
+			return resource.NonRetryableError(fmt.Errorf("Error retrieving the cluster autoscaler config map: %s", err))
+		}
+		pools, err := autoscalerWorkerPoolConfigs(configMap)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		found := false
+		for i := range pools {
+			if pools[i].Name == poolConfig.Name {
+				pools[i] = poolConfig
+				found = true
+			}
+		}
+		if !found {
+			pools = append(pools, poolConfig)
+		}
+		raw, err := json.Marshal(pools)
+		if err != nil {
+			return resource.NonRetryableError(err)
+		}
+		if configMap.Data == nil {
+			configMap.Data = map[string]string{}
+		}
+		configMap.Data[autoscalerWorkerPoolsKey] = string(raw)
+
+		_, err = kube.updateConfigMap(configMap)
+		if err != nil {
+			if isKubeConflict(err) {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(fmt.Errorf("Error updating the cluster autoscaler config map: %s", err))
+		}
+		return nil
+	})
+}
@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+)
+
+func TestAccIBMContainerWorkerPoolAutoscalingBasic(t *testing.T) {
+
+	name := fmt.Sprintf("tf-vpc-autoscale-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMContainerWorkerPoolAutoscalingDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerWorkerPoolAutoscalingBasic(name, 1, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_autoscaling.autoscaling", "min_size", "1"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_autoscaling.autoscaling", "max_size", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_autoscaling.autoscaling", "enabled", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMContainerWorkerPoolAutoscalingBasic(name, 1, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_pool_autoscaling.autoscaling", "max_size", "3"),
+				),
+			},
+			{
+				ResourceName:            "ibm_container_worker_pool_autoscaling.autoscaling",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resource_group_id"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerWorkerPoolAutoscalingDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_container_worker_pool_autoscaling" {
+			continue
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		kube, err := newKubeAPI(testAccProvider.Meta(), parts[0], v2.ClusterTargetHeader{})
+		if err != nil {
+			// The cluster is destroyed with the autoscaling configuration
+			continue
+		}
+		configMap, err := kube.getConfigMap(autoscalerConfigMapNamespace, autoscalerConfigMapName)
+		if err != nil {
+			continue
+		}
+		pools, err := autoscalerWorkerPoolConfigs(configMap)
+		if err != nil {
+			return err
+		}
+		for _, pool := range pools {
+			if pool.Name == parts[1] && pool.Enabled {
+				return fmt.Errorf("Autoscaling of worker pool %s is still enabled", parts[1])
+			}
+		}
+	}
+	return nil
+}
+
+func testAccCheckIBMContainerWorkerPoolAutoscalingBasic(name string, minSize, maxSize int) string {
+	return fmt.Sprintf(`
+	provider "ibm" {
+		region="eu-de"
+	}
+	data "ibm_resource_group" "resource_group" {
+		is_default=true
+	}
+	resource "ibm_is_vpc" "vpc" {
+	  name = "%[1]s"
+	}
+	resource "ibm_is_subnet" "subnet1" {
+	  name                     = "%[1]s-1"
+	  vpc                      = ibm_is_vpc.vpc.id
+	  zone                     = "eu-de-1"
+	  total_ipv4_address_count = 256
+	}
+	resource "ibm_container_vpc_cluster" "cluster" {
+	  name              = "%[1]s"
+	  vpc_id            = ibm_is_vpc.vpc.id
+	  flavor            = "cx2.2x4"
+	  worker_count      = 1
+	  resource_group_id = data.ibm_resource_group.resource_group.id
+	  zones {
+		subnet_id = ibm_is_subnet.subnet1.id
+		name      = "eu-de-1"
+	  }
+	}
+	resource "ibm_container_addons" "addons" {
+	  cluster = ibm_container_vpc_cluster.cluster.id
+	  addons {
+		name = "cluster-autoscaler"
+	  }
+	}
+	resource "ibm_container_vpc_worker_pool" "test_pool" {
+	  cluster             = ibm_container_vpc_cluster.cluster.id
+	  worker_pool_name    = "%[1]s"
+	  flavor              = "cx2.2x4"
+	  vpc_id              = ibm_is_vpc.vpc.id
+	  worker_count        = 1
+	  autoscaling_enabled = true
+	  resource_group_id   = data.ibm_resource_group.resource_group.id
+	  zones {
+		name      = "eu-de-1"
+		subnet_id = ibm_is_subnet.subnet1.id
+	  }
+	}
+	resource "ibm_container_worker_pool_autoscaling" "autoscaling" {
+	  cluster           = ibm_container_vpc_cluster.cluster.id
+	  worker_pool       = ibm_container_vpc_worker_pool.test_pool.worker_pool_name
+	  min_size          = %[2]d
+	  max_size          = %[3]d
+	  resource_group_id = data.ibm_resource_group.resource_group.id
+	  depends_on        = [ibm_container_addons.addons]
+	}
+	`, name, minSize, maxSize)
+}
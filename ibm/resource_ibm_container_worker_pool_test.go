@@ -60,9 +60,10 @@ func TestAccIBMContainerWorkerPoolBasic(t *testing.T) {
 				),
 			},
 			{
-				ResourceName:      "ibm_container_worker_pool.test_pool",
-				ImportState:       true,
-				ImportStateVerify: true,
+				ResourceName:            "ibm_container_worker_pool.test_pool",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"autoscaling_enabled"},
 			},
 		},
 	})
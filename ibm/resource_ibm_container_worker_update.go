@@ -0,0 +1,439 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	v2 "github.com/IBM-Cloud/bluemix-go/api/container/containerv2"
+	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	workerUpdatePending   = "updating"
+	workerUpdateNodeReady = "node_not_ready"
+	workerUpdateFailed    = "failed"
+	workerUpdateReady     = "ready"
+
+	workerUpdateStatusUpdated = "updated"
+	workerUpdateStatusFailed  = "failed"
+)
+
+func resourceIBMContainerWorkerUpdate() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMContainerWorkerUpdateCreate,
+		Read:   resourceIBMContainerWorkerUpdateRead,
+		Delete: resourceIBMContainerWorkerUpdateDelete,
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(180 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cluster": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cluster name or ID",
+			},
+			"worker_pools": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Names of the worker pools to update, in the order they are updated. All worker pools are updated by default",
+			},
+			"max_unavailable": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validateAllowedRangeInt(1, 100),
+				Description:  "Maximum number of workers of a worker pool that are replaced at the same time",
+			},
+			"update_all_workers": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Replace all workers, including the workers that already run the target version",
+			},
+			"check_node_readiness": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Wait for the Kubernetes node of a replaced worker to be Ready before the next workers are replaced",
+			},
+			"pause_on_failure_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      0,
+				ValidateFunc: validateAllowedRangeInt(0, 1440),
+				Description:  "Minutes to wait for a replaced worker that fails the health checks to recover before the update is stopped",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary values that start a new update of the workers when they are changed",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "ID of the resource group.",
+			},
+			"workers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Progress of the update of each worker",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"pool_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the worker pool",
+						},
+						"previous_worker_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the replaced worker",
+						},
+						"worker_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the new worker",
+						},
+						"kube_version": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Kubernetes version of the new worker",
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the update of the worker",
+						},
+						"message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Details of a failed update",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMContainerWorkerUpdateCreate(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	cluster := d.Get("cluster").(string)
+
+	workers, err := csClient.Workers().ListWorkers(cluster, false, targetEnv)
+	if err != nil {
+		return fmt.Errorf("Error retrieving workers for cluster: %s", err)
+	}
+
+	pools := []string{}
+	if v, ok := d.GetOk("worker_pools"); ok {
+		for _, p := range v.([]interface{}) {
+			pools = append(pools, p.(string))
+		}
+	} else {
+		seen := map[string]bool{}
+		for _, w := range workers {
+			if !seen[w.PoolName] {
+				seen[w.PoolName] = true
+				pools = append(pools, w.PoolName)
+			}
+		}
+	}
+
+	var kube *kubeAPI
+	if d.Get("check_node_readiness").(bool) {
+		kube, err = newKubeAPI(meta, cluster, targetEnv)
+		if err != nil {
+			return err
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%d", cluster, time.Now().Unix()))
+
+	u := &workerUpdate{
+		meta:           meta,
+		csClient:       csClient,
+		kube:           kube,
+		cluster:        cluster,
+		targetEnv:      targetEnv,
+		timeout:        d.Timeout(schema.TimeoutCreate),
+		pauseOnFailure: time.Duration(d.Get("pause_on_failure_timeout").(int)) * time.Minute,
+		report:         []map[string]interface{}{},
+	}
+	maxUnavailable := d.Get("max_unavailable").(int)
+	updateAll := d.Get("update_all_workers").(bool)
+
+	for _, pool := range pools {
+		pending := []v2.Worker{}
+		for _, w := range workers {
+			if w.PoolName == pool && (updateAll || w.KubeVersion.Actual != w.KubeVersion.Target) {
+				pending = append(pending, w)
+			}
+		}
+		log.Printf("[INFO] Updating %d workers of worker pool %s of cluster %s", len(pending), pool, cluster)
+
+		for start := 0; start < len(pending); start += maxUnavailable {
+			end := start + maxUnavailable
+			if end > len(pending) {
+				end = len(pending)
+			}
+			err = u.replaceBatch(pool, pending[start:end])
+			// The report is kept in the state even when the update is stopped
+			d.Set("workers", u.report)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return resourceIBMContainerWorkerUpdateRead(d, meta)
+}
+
+func resourceIBMContainerWorkerUpdateRead(d *schema.ResourceData, meta interface{}) error {
+	csClient, err := meta.(ClientSession).VpcContainerAPI()
+	if err != nil {
+		return err
+	}
+	targetEnv, err := getVpcClusterTargetHeader(d, meta)
+	if err != nil {
+		return err
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	cluster := parts[0]
+
+	_, err = csClient.Clusters().GetCluster(cluster, targetEnv)
+	if err != nil {
+		if apiErr, ok := err.(bmxerror.RequestFailure); ok && apiErr.StatusCode() == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving cluster %s: %s", cluster, err)
+	}
+	d.Set("cluster", cluster)
+	return nil
+}
+
+// The replaced workers stay in the cluster, so destroying the resource only
+// removes the update report from the state
+func resourceIBMContainerWorkerUpdateDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+// workerUpdate replaces the workers of a cluster and records the progress of
+// each worker
+type workerUpdate struct {
+	meta           interface{}
+	csClient       v2.ContainerServiceAPI
+	kube           *kubeAPI
+	cluster        string
+	targetEnv      v2.ClusterTargetHeader
+	timeout        time.Duration
+	pauseOnFailure time.Duration
+	report         []map[string]interface{}
+}
+
+// replaceBatch replaces the workers of one worker pool at the same time and
+// waits until all their replacements pass the health checks
+func (u *workerUpdate) replaceBatch(pool string, batch []v2.Worker) error {
+	current, err := u.csClient.Workers().ListByWorkerPool(u.cluster, pool, false, u.targetEnv)
+	if err != nil {
+		return fmt.Errorf("Error retrieving workers of worker pool %s: %s", pool, err)
+	}
+	known := map[string]bool{}
+	for _, w := range current {
+		known[w.ID] = true
+	}
+
+	for _, w := range batch {
+		_, err := u.csClient.Workers().ReplaceWokerNode(u.cluster, w.ID, u.targetEnv)
+		// As API returns http response 204 NO CONTENT, error raised will be exempted.
+		if err != nil && !strings.Contains(err.Error(), "EmptyResponseBody") {
+			u.record(pool, w.ID, "", "", workerUpdateStatusFailed, err.Error())
+			return fmt.Errorf("Error replacing the worker node %s from the cluster: %s", w.ID, err)
+		}
+	}
+	for _, w := range batch {
+		_, err := waitForWorkerNodetoDelete(u.meta, u.cluster, u.targetEnv, w.ID, u.timeout)
+		if err != nil {
+			u.record(pool, w.ID, "", "", workerUpdateStatusFailed, err.Error())
+			return fmt.Errorf("Worker node %s is failed to replace: %s", w.ID, err)
+		}
+	}
+
+	newWorkers, err := u.waitForNewWorkers(pool, known, len(batch))
+	if err != nil {
+		for _, w := range batch {
+			u.record(pool, w.ID, "", "", workerUpdateStatusFailed, err.Error())
+		}
+		return fmt.Errorf("Failed to spawn new worker nodes in worker pool %s: %s", pool, err)
+	}
+
+	// A replacement is created in the zone of the worker it replaces
+	replaced := map[string]string{}
+	unmatched := []string{}
+	for _, nw := range newWorkers {
+		matched := false
+		for _, w := range batch {
+			if _, ok := replaced[w.ID]; !ok && w.Location == nw.Location {
+				replaced[w.ID] = nw.ID
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			unmatched = append(unmatched, nw.ID)
+		}
+	}
+	for _, w := range batch {
+		if _, ok := replaced[w.ID]; !ok && len(unmatched) > 0 {
+			replaced[w.ID] = unmatched[0]
+			unmatched = unmatched[1:]
+		}
+	}
+
+	for _, w := range batch {
+		newWorkerID := replaced[w.ID]
+		worker, err := u.waitForWorkerReady(newWorkerID)
+		if err != nil {
+			u.record(pool, w.ID, newWorkerID, worker.KubeVersion.Actual, workerUpdateStatusFailed, err.Error())
+			return fmt.Errorf("Error waiting for the worker node %s that replaces %s to become ready: %s", newWorkerID, w.ID, err)
+		}
+		u.record(pool, w.ID, newWorkerID, worker.KubeVersion.Actual, workerUpdateStatusUpdated, "")
+	}
+	return nil
+}
+
+func (u *workerUpdate) record(pool, previousWorkerID, workerID, kubeVersion, status, message string) {
+	u.report = append(u.report, map[string]interface{}{
+		"pool_name":          pool,
+		"previous_worker_id": previousWorkerID,
+		"worker_id":          workerID,
+		"kube_version":       kubeVersion,
+		"status":             status,
+		"message":            message,
+	})
+}
+
+// waitForNewWorkers waits until the worker pool has count workers that are
+// not in known
+func (u *workerUpdate) waitForNewWorkers(pool string, known map[string]bool, count int) ([]v2.Worker, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"creating"},
+		Target:  []string{"created"},
+		Refresh: func() (interface{}, string, error) {
+			workers, err := u.csClient.Workers().ListByWorkerPool(u.cluster, pool, false, u.targetEnv)
+			if err != nil {
+				return nil, "", fmt.Errorf("Error in retriving the list of worker nodes")
+			}
+			newWorkers := []v2.Worker{}
+			for _, w := range workers {
+				if !known[w.ID] {
+					newWorkers = append(newWorkers, w)
+				}
+			}
+			if len(newWorkers) >= count {
+				return newWorkers, "created", nil
+			}
+			return newWorkers, "creating", nil
+		},
+		Timeout:      u.timeout,
+		Delay:        10 * time.Second,
+		MinTimeout:   5 * time.Second,
+		PollInterval: 5 * time.Second,
+	}
+	newWorkers, err := stateConf.WaitForState()
+	if err != nil {
+		return nil, err
+	}
+	return newWorkers.([]v2.Worker), nil
+}
+
+// waitForWorkerReady waits until the worker is deployed with its target version,
+// is healthy and, if requested, its Kubernetes node is Ready. A worker that fails
+// is given pauseOnFailure to recover.
+func (u *workerUpdate) waitForWorkerReady(workerID string) (v2.Worker, error) {
+	refresh := func() (interface{}, string, error) {
+		worker, err := u.csClient.Workers().Get(u.cluster, workerID, u.targetEnv)
+		if err != nil {
+			return nil, "", fmt.Errorf("Error retrieving worker %s: %s", workerID, err)
+		}
+		if strings.Contains(worker.LifeCycle.ActualState, "failed") || worker.Health.State == "critical" {
+			log.Printf("[WARN] Worker %s failed: %s %s", workerID, worker.LifeCycle.Message, worker.Health.Message)
+			return worker, workerUpdateFailed, nil
+		}
+		if worker.LifeCycle.ActualState != "deployed" || worker.Health.State != "normal" || worker.KubeVersion.Actual != worker.KubeVersion.Target {
+			return worker, workerUpdatePending, nil
+		}
+		if u.kube != nil {
+			nodeName := ""
+			for _, n := range worker.NetworkInterfaces {
+				if n.Primary || nodeName == "" {
+					nodeName = n.IpAddress
+				}
+			}
+			node, err := u.kube.getNode(nodeName)
+			if err != nil {
+				if isKubeNotFound(err) {
+					return worker, workerUpdateNodeReady, nil
+				}
+				return nil, "", fmt.Errorf("Error retrieving the node of worker %s: %s", workerID, err)
+			}
+			if !node.isReady() {
+				return worker, workerUpdateNodeReady, nil
+			}
+		}
+		return worker, workerUpdateReady, nil
+	}
+
+	stateConf := &resource.StateChangeConf{
+		Pending:      []string{workerUpdatePending, workerUpdateNodeReady},
+		Target:       []string{workerUpdateReady},
+		Refresh:      refresh,
+		Timeout:      u.timeout,
+		Delay:        10 * time.Second,
+		MinTimeout:   10 * time.Second,
+		PollInterval: 10 * time.Second,
+	}
+	worker, err := stateConf.WaitForState()
+	if err != nil && u.pauseOnFailure > 0 {
+		if _, ok := err.(*resource.UnexpectedStateError); ok {
+			log.Printf("[INFO] Pausing the update for %s to let worker %s recover", u.pauseOnFailure, workerID)
+			stateConf.Pending = []string{workerUpdatePending, workerUpdateNodeReady, workerUpdateFailed}
+			stateConf.Timeout = u.pauseOnFailure
+			worker, err = stateConf.WaitForState()
+		}
+	}
+	if w, ok := worker.(v2.Worker); ok {
+		return w, err
+	}
+	return v2.Worker{}, err
+}
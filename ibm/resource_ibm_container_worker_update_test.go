@@ -0,0 +1,90 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMContainerWorkerUpdateBasic(t *testing.T) {
+
+	name := fmt.Sprintf("tf-vpc-wupdate-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerWorkerUpdateBasic(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_update.update", "max_unavailable", "2"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_update.update", "workers.#", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_update.update", "workers.0.pool_name", "default"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_update.update", "workers.0.status", "updated"),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_update.update", "workers.2.pool_name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_container_worker_update.update", "workers.2.status", "updated"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMContainerWorkerUpdateBasic(name string) string {
+	return fmt.Sprintf(`
+	provider "ibm" {
+		region="eu-de"
+	}
+	data "ibm_resource_group" "resource_group" {
+		is_default=true
+	}
+	resource "ibm_is_vpc" "vpc" {
+	  name = "%[1]s"
+	}
+	resource "ibm_is_subnet" "subnet1" {
+	  name                     = "%[1]s-1"
+	  vpc                      = ibm_is_vpc.vpc.id
+	  zone                     = "eu-de-1"
+	  total_ipv4_address_count = 256
+	}
+	resource "ibm_container_vpc_cluster" "cluster" {
+	  name              = "%[1]s"
+	  vpc_id            = ibm_is_vpc.vpc.id
+	  flavor            = "cx2.2x4"
+	  worker_count      = 2
+	  resource_group_id = data.ibm_resource_group.resource_group.id
+	  zones {
+		subnet_id = ibm_is_subnet.subnet1.id
+		name      = "eu-de-1"
+	  }
+	}
+	resource "ibm_container_vpc_worker_pool" "test_pool" {
+	  cluster           = ibm_container_vpc_cluster.cluster.id
+	  worker_pool_name  = "%[1]s"
+	  flavor            = "cx2.2x4"
+	  vpc_id            = ibm_is_vpc.vpc.id
+	  worker_count      = 1
+	  resource_group_id = data.ibm_resource_group.resource_group.id
+	  zones {
+		name      = "eu-de-1"
+		subnet_id = ibm_is_subnet.subnet1.id
+	  }
+	}
+	resource "ibm_container_worker_update" "update" {
+	  cluster            = ibm_container_vpc_cluster.cluster.id
+	  worker_pools       = ["default", ibm_container_vpc_worker_pool.test_pool.worker_pool_name]
+	  max_unavailable    = 2
+	  update_all_workers = true
+	  resource_group_id  = data.ibm_resource_group.resource_group.id
+	}
+	`, name)
+}
@@ -4,18 +4,28 @@
 package ibm
 
 import (
+	"context"
+	"crypto/md5"
+	"encoding/base64"
+	"encoding/xml"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"log"
 	"regexp"
 	"strings"
 	"time"
 
+	bxsession "github.com/IBM-Cloud/bluemix-go/session"
 	"github.com/IBM/ibm-cos-sdk-go-config/resourceconfigurationv1"
 	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
 	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -50,6 +60,12 @@ func resourceIBMCOSBucket() *schema.Resource {
 		Exists:   resourceIBMCOSBucketExists,
 		Importer: &schema.ResourceImporter{},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMCOSBucketCustomizeDiff(diff)
+			},
+		),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
 			Update: schema.DefaultTimeout(20 * time.Minute),
@@ -119,6 +135,20 @@ func resourceIBMCOSBucket() *schema.Resource {
 				DiffSuppressFunc: applyOnce,
 				Default:          "public",
 			},
+			"hmac_access_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"hmac_secret_access_key"},
+				Description:  "HMAC access key ID used to sign the S3 requests instead of IAM",
+			},
+			"hmac_secret_access_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"hmac_access_key_id"},
+				Description:  "HMAC secret access key used to sign the S3 requests instead of IAM",
+			},
 			"s3_endpoint_public": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -295,6 +325,112 @@ func resourceIBMCOSBucket() *schema.Resource {
 					},
 				},
 			},
+			"lifecycle_rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1000,
+				Description: "Lifecycle rules of the bucket with the full lifecycle configuration. They are combined with archive_rule and expire_rule",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Unique ID of the rule",
+						},
+						"enable": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Enable or disable the rule",
+						},
+						"prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The rule applies to the objects with names that match the prefix",
+						},
+						"expiration": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Deletes the current version of the objects",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validateAllowedRangeInt(1, 3650),
+										Description:  "Number of days after the creation of the objects",
+									},
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateCOSLifecycleDate,
+										Description:  "Date in the format YYYY-MM-DD",
+									},
+									"expired_object_delete_marker": {
+										Type:        schema.TypeBool,
+										Optional:    true,
+										Description: "Removes the delete markers that have no noncurrent versions",
+									},
+								},
+							},
+						},
+						"transition": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Archives the objects",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"days": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validateAllowedRangeInt(0, 3650),
+										Description:  "Number of days after the creation of the objects",
+									},
+									"date": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateCOSLifecycleDate,
+										Description:  "Date in the format YYYY-MM-DD",
+									},
+									"storage_class": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateAllowedStringValue([]string{"GLACIER", "ACCELERATED", "Glacier", "Accelerated"}),
+										Description:  "Archive type: GLACIER or ACCELERATED",
+										DiffSuppressFunc: func(k, o, n string, d *schema.ResourceData) bool {
+											return strings.EqualFold(o, n)
+										},
+									},
+								},
+							},
+						},
+						"noncurrent_version_expiration": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Deletes the noncurrent versions of the objects of a versioned bucket",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"noncurrent_days": {
+										Type:         schema.TypeInt,
+										Required:     true,
+										ValidateFunc: validateAllowedRangeInt(1, 3650),
+										Description:  "Number of days after the objects become noncurrent",
+									},
+								},
+							},
+						},
+						"abort_incomplete_multipart_upload_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validateAllowedRangeInt(1, 3650),
+							Description:  "Number of days after which the incomplete multipart uploads are aborted",
+						},
+					},
+				},
+			},
 			"object_versioning": {
 				Type:          schema.TypeList,
 				Optional:      true,
@@ -312,6 +448,242 @@ func resourceIBMCOSBucket() *schema.Resource {
 					},
 				},
 			},
+			"replication_rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1000,
+				Description: "Replication rules of the bucket, the objects are copied to the destination buckets. Object versioning must be enabled on both sides",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rule_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Unique ID of the rule",
+						},
+						"enable": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Enable or disable the rule",
+						},
+						"priority": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Default:     0,
+							Description: "Priority of the rule when several rules apply to an object, the highest wins",
+						},
+						"prefix": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The rule applies to the objects with names that match the prefix",
+						},
+						"destination_bucket_crn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "CRN of the bucket the objects are replicated to",
+						},
+						"delete_marker_replication": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Replicate the delete markers",
+						},
+					},
+				},
+			},
+			"object_lock_configuration": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Object Lock configuration of the bucket. Object versioning must be enabled and Object Lock can't be disabled once enabled",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enable": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "Enable Object Lock on the bucket",
+						},
+						"default_retention": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Retention applied to the new objects of the bucket",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"mode": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: validateAllowedStringValue([]string{"COMPLIANCE"}),
+										Description:  "Retention mode: COMPLIANCE",
+									},
+									"days": {
+										Type:          schema.TypeInt,
+										Optional:      true,
+										ValidateFunc:  validateAllowedRangeInt(1, 36500),
+										ConflictsWith: []string{"object_lock_configuration.0.default_retention.0.years"},
+										Description:   "Retention period in days",
+									},
+									"years": {
+										Type:          schema.TypeInt,
+										Optional:      true,
+										ValidateFunc:  validateAllowedRangeInt(1, 100),
+										ConflictsWith: []string{"object_lock_configuration.0.default_retention.0.days"},
+										Description:   "Retention period in years",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"cors_rule": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    100,
+				Description: "Cross-origin resource sharing (CORS) rules of the bucket",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_headers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Headers that are allowed in a preflight request",
+						},
+						"allowed_methods": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validateAllowedStringValue([]string{"GET", "PUT", "POST", "DELETE", "HEAD"}),
+							},
+							Description: "HTTP methods that the origins are allowed to execute",
+						},
+						"allowed_origins": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Origins that are allowed to access the bucket",
+						},
+						"expose_headers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Response headers that the clients are allowed to access",
+						},
+						"max_age_seconds": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "Time in seconds that the browser caches a preflight response",
+						},
+					},
+				},
+			},
+			"website": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Static website hosting configuration of the bucket",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index_document": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Suffix appended to requests for a directory, such as index.html",
+						},
+						"error_document": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Object key returned when a 4XX error occurs",
+						},
+						"redirect_all_requests_to": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "Redirects all requests to another host. It can't be combined with the other website arguments",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host_name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "Host name to redirect the requests to",
+									},
+									"protocol": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validateAllowedStringValue([]string{"http", "https"}),
+										Description:  "Protocol to use when redirecting the requests",
+									},
+								},
+							},
+						},
+						"routing_rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Rules that redirect requests matching a condition",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"condition": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										MaxItems:    1,
+										Description: "Condition that a request must match for the redirect to apply",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"http_error_code_returned_equals": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "HTTP error code of the request",
+												},
+												"key_prefix_equals": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "Object key prefix of the request",
+												},
+											},
+										},
+									},
+									"redirect": {
+										Type:        schema.TypeList,
+										Required:    true,
+										MaxItems:    1,
+										Description: "Redirect of the matching requests",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"host_name": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "Host name to use in the redirect",
+												},
+												"http_redirect_code": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "HTTP redirect code to use in the response",
+												},
+												"protocol": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validateAllowedStringValue([]string{"http", "https"}),
+													Description:  "Protocol to use in the redirect",
+												},
+												"replace_key_prefix_with": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "Object key prefix that replaces key_prefix_equals in the redirect",
+												},
+												"replace_key_with": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "Object key to use in the redirect",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"force_delete": {
 				Type:        schema.TypeBool,
 				Optional:    true,
@@ -420,6 +792,567 @@ func expireRuleList(expireList []interface{}) []*s3.LifecycleRule {
 	return rules
 }
 
+func corsRuleList(corsList []interface{}) []*s3.CORSRule {
+	var rules []*s3.CORSRule
+
+	for _, l := range corsList {
+		corsMap, _ := l.(map[string]interface{})
+
+		cors_rule := s3.CORSRule{
+			AllowedHeaders: aws.StringSlice(expandStringList(corsMap["allowed_headers"].([]interface{}))),
+			AllowedMethods: aws.StringSlice(expandStringList(corsMap["allowed_methods"].([]interface{}))),
+			AllowedOrigins: aws.StringSlice(expandStringList(corsMap["allowed_origins"].([]interface{}))),
+			ExposeHeaders:  aws.StringSlice(expandStringList(corsMap["expose_headers"].([]interface{}))),
+		}
+		if maxAge := corsMap["max_age_seconds"].(int); maxAge > 0 {
+			cors_rule.MaxAgeSeconds = aws.Int64(int64(maxAge))
+		}
+
+		rules = append(rules, &cors_rule)
+	}
+	return rules
+}
+
+func websiteConfiguration(websiteList []interface{}) *s3.WebsiteConfiguration {
+	websiteConf := &s3.WebsiteConfiguration{}
+	if len(websiteList) == 0 || websiteList[0] == nil {
+		return websiteConf
+	}
+	websiteMap, _ := websiteList[0].(map[string]interface{})
+
+	if index := websiteMap["index_document"].(string); index != "" {
+		websiteConf.IndexDocument = &s3.IndexDocument{
+			Suffix: aws.String(index),
+		}
+	}
+	if errorKey := websiteMap["error_document"].(string); errorKey != "" {
+		websiteConf.ErrorDocument = &s3.ErrorDocument{
+			Key: aws.String(errorKey),
+		}
+	}
+	if redirectAll := websiteMap["redirect_all_requests_to"].([]interface{}); len(redirectAll) > 0 && redirectAll[0] != nil {
+		redirectMap := redirectAll[0].(map[string]interface{})
+		websiteConf.RedirectAllRequestsTo = &s3.RedirectAllRequestsTo{
+			HostName: aws.String(redirectMap["host_name"].(string)),
+		}
+		if protocol := redirectMap["protocol"].(string); protocol != "" {
+			websiteConf.RedirectAllRequestsTo.Protocol = aws.String(protocol)
+		}
+	}
+	for _, r := range websiteMap["routing_rule"].([]interface{}) {
+		ruleMap, _ := r.(map[string]interface{})
+		routing_rule := s3.RoutingRule{
+			Redirect: &s3.Redirect{},
+		}
+		if condition := ruleMap["condition"].([]interface{}); len(condition) > 0 && condition[0] != nil {
+			conditionMap := condition[0].(map[string]interface{})
+			routing_rule.Condition = &s3.Condition{}
+			if v := conditionMap["http_error_code_returned_equals"].(string); v != "" {
+				routing_rule.Condition.HttpErrorCodeReturnedEquals = aws.String(v)
+			}
+			if v := conditionMap["key_prefix_equals"].(string); v != "" {
+				routing_rule.Condition.KeyPrefixEquals = aws.String(v)
+			}
+		}
+		if redirect := ruleMap["redirect"].([]interface{}); len(redirect) > 0 && redirect[0] != nil {
+			redirectMap := redirect[0].(map[string]interface{})
+			if v := redirectMap["host_name"].(string); v != "" {
+				routing_rule.Redirect.HostName = aws.String(v)
+			}
+			if v := redirectMap["http_redirect_code"].(string); v != "" {
+				routing_rule.Redirect.HttpRedirectCode = aws.String(v)
+			}
+			if v := redirectMap["protocol"].(string); v != "" {
+				routing_rule.Redirect.Protocol = aws.String(v)
+			}
+			if v := redirectMap["replace_key_prefix_with"].(string); v != "" {
+				routing_rule.Redirect.ReplaceKeyPrefixWith = aws.String(v)
+			}
+			if v := redirectMap["replace_key_with"].(string); v != "" {
+				routing_rule.Redirect.ReplaceKeyWith = aws.String(v)
+			}
+		}
+		websiteConf.RoutingRules = append(websiteConf.RoutingRules, &routing_rule)
+	}
+	return websiteConf
+}
+
+// cosXMLNamespace is the namespace of the documents of the S3 API
+const cosXMLNamespace = "http://s3.amazonaws.com/doc/2006-03-01/"
+
+// The lifecycle types of the S3 client lack the noncurrent version, delete
+// marker and multipart upload elements. The types below add them and are
+// sent with sendCOSRequest.
+type cosLifecycleConfiguration struct {
+	XMLName xml.Name            `xml:"LifecycleConfiguration"`
+	Xmlns   string              `xml:"xmlns,attr,omitempty"`
+	Rules   []*cosLifecycleRule `xml:"Rule"`
+}
+
+type cosLifecycleRule struct {
+	ID                             *string                            `xml:"ID,omitempty"`
+	Filter                         *cosLifecycleFilter                `xml:"Filter"`
+	Status                         *string                            `xml:"Status"`
+	Expiration                     *cosLifecycleExpiration            `xml:"Expiration"`
+	Transitions                    []*cosTransition                   `xml:"Transition"`
+	NoncurrentVersionExpiration    *cosNoncurrentVersionExpiration    `xml:"NoncurrentVersionExpiration"`
+	AbortIncompleteMultipartUpload *cosAbortIncompleteMultipartUpload `xml:"AbortIncompleteMultipartUpload"`
+}
+
+type cosLifecycleFilter struct {
+	Prefix *string `xml:"Prefix"`
+}
+
+type cosLifecycleExpiration struct {
+	Date                      *time.Time `xml:"Date"`
+	Days                      *int64     `xml:"Days"`
+	ExpiredObjectDeleteMarker *bool      `xml:"ExpiredObjectDeleteMarker"`
+}
+
+type cosTransition struct {
+	Date         *time.Time `xml:"Date"`
+	Days         *int64     `xml:"Days"`
+	StorageClass *string    `xml:"StorageClass"`
+}
+
+type cosNoncurrentVersionExpiration struct {
+	NoncurrentDays *int64 `xml:"NoncurrentDays"`
+}
+
+type cosAbortIncompleteMultipartUpload struct {
+	DaysAfterInitiation *int64 `xml:"DaysAfterInitiation"`
+}
+
+// sendCOSRequest sends a request of an operation the S3 client has no types
+// for. uriInput is a type of the S3 client that fills the bucket and the key
+// of the path, body and output are marshalled with encoding/xml. The body of
+// the response is discarded when there is no output.
+func sendCOSRequest(s3Client *s3.S3, op *request.Operation, uriInput, body, output interface{}) error {
+	req := s3Client.NewRequest(op, uriInput, nil)
+	if body != nil {
+		req.Handlers.Build.PushBack(func(r *request.Request) {
+			b, err := xml.Marshal(body)
+			if err != nil {
+				r.Error = awserr.New(request.ErrCodeSerialization, "failed to encode the request body", err)
+				return
+			}
+			sum := md5.Sum(b)
+			r.SetBufferBody(b)
+			r.HTTPRequest.Header.Set("Content-Type", "application/xml")
+			r.HTTPRequest.Header.Set("Content-MD5", base64.StdEncoding.EncodeToString(sum[:]))
+		})
+	}
+	req.Handlers.Unmarshal.Clear()
+	req.Handlers.Unmarshal.PushBack(func(r *request.Request) {
+		defer r.HTTPResponse.Body.Close()
+		if output == nil {
+			io.Copy(ioutil.Discard, r.HTTPResponse.Body)
+			return
+		}
+		if err := xml.NewDecoder(r.HTTPResponse.Body).Decode(output); err != nil {
+			r.Error = awserr.New(request.ErrCodeSerialization, "failed to decode the response body", err)
+		}
+	})
+	return req.Send()
+}
+
+func putCOSBucketLifecycle(s3Client *s3.S3, bucketName string, rules []*cosLifecycleRule) error {
+	op := &request.Operation{
+		Name:       "PutBucketLifecycleConfiguration",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}?lifecycle",
+	}
+	conf := &cosLifecycleConfiguration{
+		Xmlns: cosXMLNamespace,
+		Rules: rules,
+	}
+	return sendCOSRequest(s3Client, op, &s3.HeadBucketInput{Bucket: aws.String(bucketName)}, conf, nil)
+}
+
+func getCOSBucketLifecycle(s3Client *s3.S3, bucketName string) (*cosLifecycleConfiguration, error) {
+	op := &request.Operation{
+		Name:       "GetBucketLifecycleConfiguration",
+		HTTPMethod: "GET",
+		HTTPPath:   "/{Bucket}?lifecycle",
+	}
+	output := &cosLifecycleConfiguration{}
+	err := sendCOSRequest(s3Client, op, &s3.HeadBucketInput{Bucket: aws.String(bucketName)}, nil, output)
+	return output, err
+}
+
+// cosBucketLifecycleRules returns the rules of the lifecycle configuration of
+// the bucket. archive_rule, expire_rule and lifecycle_rule share it, the
+// configuration is always sent with the rules of all of them.
+func cosBucketLifecycleRules(d *schema.ResourceData) []*cosLifecycleRule {
+	var rules []*cosLifecycleRule
+	for _, r := range expireRuleList(d.Get("expire_rule").([]interface{})) {
+		rules = append(rules, cosLifecycleRuleFromS3(r))
+	}
+	for _, r := range archiveRuleList(d.Get("archive_rule").([]interface{})) {
+		rules = append(rules, cosLifecycleRuleFromS3(r))
+	}
+	return append(rules, lifecycleRuleList(d.Get("lifecycle_rule").([]interface{}))...)
+}
+
+// cosLifecycleRuleFromS3 converts a rule of archive_rule or expire_rule
+func cosLifecycleRuleFromS3(in *s3.LifecycleRule) *cosLifecycleRule {
+	rule := &cosLifecycleRule{
+		ID:     in.ID,
+		Status: in.Status,
+		Filter: &cosLifecycleFilter{},
+	}
+	if in.Filter != nil {
+		rule.Filter.Prefix = in.Filter.Prefix
+	}
+	if in.Expiration != nil {
+		rule.Expiration = &cosLifecycleExpiration{
+			Date: in.Expiration.Date,
+			Days: in.Expiration.Days,
+		}
+	}
+	for _, t := range in.Transitions {
+		rule.Transitions = append(rule.Transitions, &cosTransition{
+			Date:         t.Date,
+			Days:         t.Days,
+			StorageClass: t.StorageClass,
+		})
+	}
+	return rule
+}
+
+// s3LifecycleRule converts the rule for archiveRuleGet and expireRuleGet
+func (r *cosLifecycleRule) s3LifecycleRule() *s3.LifecycleRule {
+	rule := &s3.LifecycleRule{
+		ID:     r.ID,
+		Status: r.Status,
+	}
+	if r.Filter != nil {
+		rule.Filter = &s3.LifecycleRuleFilter{Prefix: r.Filter.Prefix}
+	}
+	if r.Expiration != nil {
+		rule.Expiration = &s3.LifecycleExpiration{
+			Date: r.Expiration.Date,
+			Days: r.Expiration.Days,
+		}
+	}
+	for _, t := range r.Transitions {
+		rule.Transitions = append(rule.Transitions, &s3.Transition{
+			Date:         t.Date,
+			Days:         t.Days,
+			StorageClass: t.StorageClass,
+		})
+	}
+	return rule
+}
+
+// isArchiveRule reports whether the rule can be represented by archive_rule,
+// a transition after a number of days of all the objects
+func (r *cosLifecycleRule) isArchiveRule() bool {
+	return len(r.Transitions) == 1 && r.Transitions[0].Days != nil && r.Transitions[0].Date == nil &&
+		(r.Filter == nil || aws.StringValue(r.Filter.Prefix) == "") &&
+		r.Expiration == nil && r.NoncurrentVersionExpiration == nil && r.AbortIncompleteMultipartUpload == nil
+}
+
+// isExpireRule reports whether the rule can be represented by expire_rule,
+// the expiration after a number of days of the objects with a prefix
+func (r *cosLifecycleRule) isExpireRule() bool {
+	return r.Expiration != nil && r.Expiration.Days != nil && r.Expiration.Date == nil &&
+		!aws.BoolValue(r.Expiration.ExpiredObjectDeleteMarker) &&
+		len(r.Transitions) == 0 && r.NoncurrentVersionExpiration == nil && r.AbortIncompleteMultipartUpload == nil
+}
+
+func lifecycleRuleList(lifecycleList []interface{}) []*cosLifecycleRule {
+	var rules []*cosLifecycleRule
+
+	for _, l := range lifecycleList {
+		ruleMap, _ := l.(map[string]interface{})
+
+		lifecycle_rule := cosLifecycleRule{
+			ID:     aws.String(ruleMap["rule_id"].(string)),
+			Status: aws.String("Disabled"),
+			Filter: &cosLifecycleFilter{},
+		}
+		if ruleMap["enable"].(bool) {
+			lifecycle_rule.Status = aws.String("Enabled")
+		}
+		if prefix := ruleMap["prefix"].(string); prefix != "" {
+			lifecycle_rule.Filter.Prefix = aws.String(prefix)
+		}
+		if expiration := ruleMap["expiration"].([]interface{}); len(expiration) > 0 && expiration[0] != nil {
+			expirationMap := expiration[0].(map[string]interface{})
+			lifecycle_rule.Expiration = &cosLifecycleExpiration{}
+			if days := expirationMap["days"].(int); days > 0 {
+				lifecycle_rule.Expiration.Days = aws.Int64(int64(days))
+			}
+			if date := expirationMap["date"].(string); date != "" {
+				t, _ := time.Parse("2006-01-02", date)
+				lifecycle_rule.Expiration.Date = aws.Time(t)
+			}
+			if expirationMap["expired_object_delete_marker"].(bool) {
+				lifecycle_rule.Expiration.ExpiredObjectDeleteMarker = aws.Bool(true)
+			}
+		}
+		if transition := ruleMap["transition"].([]interface{}); len(transition) > 0 && transition[0] != nil {
+			transitionMap := transition[0].(map[string]interface{})
+			t := &cosTransition{
+				StorageClass: aws.String(strings.ToUpper(transitionMap["storage_class"].(string))),
+			}
+			if date := transitionMap["date"].(string); date != "" {
+				tDate, _ := time.Parse("2006-01-02", date)
+				t.Date = aws.Time(tDate)
+			} else {
+				t.Days = aws.Int64(int64(transitionMap["days"].(int)))
+			}
+			lifecycle_rule.Transitions = []*cosTransition{t}
+		}
+		if noncurrent := ruleMap["noncurrent_version_expiration"].([]interface{}); len(noncurrent) > 0 && noncurrent[0] != nil {
+			noncurrentMap := noncurrent[0].(map[string]interface{})
+			lifecycle_rule.NoncurrentVersionExpiration = &cosNoncurrentVersionExpiration{
+				NoncurrentDays: aws.Int64(int64(noncurrentMap["noncurrent_days"].(int))),
+			}
+		}
+		if days := ruleMap["abort_incomplete_multipart_upload_days"].(int); days > 0 {
+			lifecycle_rule.AbortIncompleteMultipartUpload = &cosAbortIncompleteMultipartUpload{
+				DaysAfterInitiation: aws.Int64(int64(days)),
+			}
+		}
+
+		rules = append(rules, &lifecycle_rule)
+	}
+	return rules
+}
+
+// The S3 client has no replication and Object Lock operations, they are sent
+// with the types below like the lifecycle rules.
+type cosReplicationConfiguration struct {
+	XMLName xml.Name              `xml:"ReplicationConfiguration"`
+	Xmlns   string                `xml:"xmlns,attr,omitempty"`
+	Rules   []*cosReplicationRule `xml:"Rule"`
+}
+
+type cosReplicationRule struct {
+	ID                      *string                    `xml:"ID,omitempty"`
+	Priority                *int64                     `xml:"Priority"`
+	Status                  *string                    `xml:"Status"`
+	Filter                  *cosLifecycleFilter        `xml:"Filter"`
+	Destination             *cosReplicationDestination `xml:"Destination"`
+	DeleteMarkerReplication *cosReplicationStatus      `xml:"DeleteMarkerReplication"`
+}
+
+type cosReplicationStatus struct {
+	Status *string `xml:"Status"`
+}
+
+type cosReplicationDestination struct {
+	Bucket *string `xml:"Bucket"`
+}
+
+type cosObjectLockConfiguration struct {
+	XMLName           xml.Name           `xml:"ObjectLockConfiguration"`
+	Xmlns             string             `xml:"xmlns,attr,omitempty"`
+	ObjectLockEnabled *string            `xml:"ObjectLockEnabled"`
+	Rule              *cosObjectLockRule `xml:"Rule"`
+}
+
+type cosObjectLockRule struct {
+	DefaultRetention *cosDefaultRetention `xml:"DefaultRetention"`
+}
+
+type cosDefaultRetention struct {
+	Mode  *string `xml:"Mode"`
+	Days  *int64  `xml:"Days"`
+	Years *int64  `xml:"Years"`
+}
+
+func putCOSBucketReplication(s3Client *s3.S3, bucketName string, rules []*cosReplicationRule) error {
+	op := &request.Operation{
+		Name:       "PutBucketReplication",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}?replication",
+	}
+	conf := &cosReplicationConfiguration{
+		Xmlns: cosXMLNamespace,
+		Rules: rules,
+	}
+	return sendCOSRequest(s3Client, op, &s3.HeadBucketInput{Bucket: aws.String(bucketName)}, conf, nil)
+}
+
+func getCOSBucketReplication(s3Client *s3.S3, bucketName string) (*cosReplicationConfiguration, error) {
+	op := &request.Operation{
+		Name:       "GetBucketReplication",
+		HTTPMethod: "GET",
+		HTTPPath:   "/{Bucket}?replication",
+	}
+	output := &cosReplicationConfiguration{}
+	err := sendCOSRequest(s3Client, op, &s3.HeadBucketInput{Bucket: aws.String(bucketName)}, nil, output)
+	return output, err
+}
+
+func deleteCOSBucketReplication(s3Client *s3.S3, bucketName string) error {
+	op := &request.Operation{
+		Name:       "DeleteBucketReplication",
+		HTTPMethod: "DELETE",
+		HTTPPath:   "/{Bucket}?replication",
+	}
+	return sendCOSRequest(s3Client, op, &s3.HeadBucketInput{Bucket: aws.String(bucketName)}, nil, nil)
+}
+
+func putCOSObjectLockConfiguration(s3Client *s3.S3, bucketName string, conf *cosObjectLockConfiguration) error {
+	op := &request.Operation{
+		Name:       "PutObjectLockConfiguration",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}?object-lock",
+	}
+	conf.Xmlns = cosXMLNamespace
+	return sendCOSRequest(s3Client, op, &s3.HeadBucketInput{Bucket: aws.String(bucketName)}, conf, nil)
+}
+
+func getCOSObjectLockConfiguration(s3Client *s3.S3, bucketName string) (*cosObjectLockConfiguration, error) {
+	op := &request.Operation{
+		Name:       "GetObjectLockConfiguration",
+		HTTPMethod: "GET",
+		HTTPPath:   "/{Bucket}?object-lock",
+	}
+	output := &cosObjectLockConfiguration{}
+	err := sendCOSRequest(s3Client, op, &s3.HeadBucketInput{Bucket: aws.String(bucketName)}, nil, output)
+	return output, err
+}
+
+func replicationRuleList(replicationList []interface{}) []*cosReplicationRule {
+	var rules []*cosReplicationRule
+
+	for _, l := range replicationList {
+		ruleMap, _ := l.(map[string]interface{})
+
+		replication_rule := cosReplicationRule{
+			Status:   aws.String("Disabled"),
+			Priority: aws.Int64(int64(ruleMap["priority"].(int))),
+			Filter:   &cosLifecycleFilter{},
+			Destination: &cosReplicationDestination{
+				Bucket: aws.String(ruleMap["destination_bucket_crn"].(string)),
+			},
+			DeleteMarkerReplication: &cosReplicationStatus{
+				Status: aws.String("Disabled"),
+			},
+		}
+		if id := ruleMap["rule_id"].(string); id != "" {
+			replication_rule.ID = aws.String(id)
+		}
+		if ruleMap["enable"].(bool) {
+			replication_rule.Status = aws.String("Enabled")
+		}
+		if prefix := ruleMap["prefix"].(string); prefix != "" {
+			replication_rule.Filter.Prefix = aws.String(prefix)
+		}
+		if ruleMap["delete_marker_replication"].(bool) {
+			replication_rule.DeleteMarkerReplication.Status = aws.String("Enabled")
+		}
+
+		rules = append(rules, &replication_rule)
+	}
+	return rules
+}
+
+func objectLockConfiguration(objectLockList []interface{}) *cosObjectLockConfiguration {
+	conf := &cosObjectLockConfiguration{}
+	if len(objectLockList) == 0 || objectLockList[0] == nil {
+		return conf
+	}
+	objectLockMap := objectLockList[0].(map[string]interface{})
+	if objectLockMap["enable"].(bool) {
+		conf.ObjectLockEnabled = aws.String("Enabled")
+	}
+	if retention := objectLockMap["default_retention"].([]interface{}); len(retention) > 0 && retention[0] != nil {
+		retentionMap := retention[0].(map[string]interface{})
+		conf.Rule = &cosObjectLockRule{
+			DefaultRetention: &cosDefaultRetention{
+				Mode: aws.String(retentionMap["mode"].(string)),
+			},
+		}
+		if days := retentionMap["days"].(int); days > 0 {
+			conf.Rule.DefaultRetention.Days = aws.Int64(int64(days))
+		}
+		if years := retentionMap["years"].(int); years > 0 {
+			conf.Rule.DefaultRetention.Years = aws.Int64(int64(years))
+		}
+	}
+	return conf
+}
+
+// checkCOSReplicationDestination makes sure the versioning is enabled on the
+// destination bucket of a replication rule. The bucket is looked up in its
+// service instance to find its location. The requests are signed with the
+// HMAC keys of the resource when they are set.
+func checkCOSReplicationDestination(d *schema.ResourceData, bxSession *bxsession.Session, bucketLocation, endpointType, destinationCRN string) error {
+	if !strings.Contains(destinationCRN, ":bucket:") {
+		return fmt.Errorf("Incorrect destination bucket CRN %s", destinationCRN)
+	}
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(destinationCRN, ":bucket:")[0])
+	destinationName := strings.Split(destinationCRN, ":bucket:")[1]
+
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return err
+	}
+	setCOSHMACCredentials(s3Client, d)
+	bucketOutput, err := s3Client.ListBucketsExtended(&s3.ListBucketsExtendedInput{})
+	if err != nil {
+		return fmt.Errorf("failed to list the buckets of the destination service instance %s, %v", instanceCRN, err)
+	}
+	destinationLocation := ""
+	for _, b := range bucketOutput.Buckets {
+		if aws.StringValue(b.Name) == destinationName {
+			// The location constraint is the location followed by the storage class
+			constraint := aws.StringValue(b.LocationConstraint)
+			if i := strings.LastIndex(constraint, "-"); i > 0 {
+				destinationLocation = constraint[:i]
+			}
+		}
+	}
+	if destinationLocation == "" {
+		return fmt.Errorf("Destination bucket %s of the replication rules not found", destinationCRN)
+	}
+
+	if destinationLocation != bucketLocation {
+		s3Client, err = getS3Client(bxSession, destinationLocation, endpointType, instanceCRN)
+		if err != nil {
+			return err
+		}
+		setCOSHMACCredentials(s3Client, d)
+	}
+	versioning, err := s3Client.GetBucketVersioning(&s3.GetBucketVersioningInput{
+		Bucket: aws.String(destinationName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to read the object versioning of the destination bucket %s, %v", destinationCRN, err)
+	}
+	if aws.StringValue(versioning.Status) != "Enabled" {
+		return fmt.Errorf("Object versioning must be enabled on the destination bucket %s of the replication rules", destinationCRN)
+	}
+	return nil
+}
+
+// resourceIBMCOSBucketCustomizeDiff checks the object versioning that the
+// replication rules and Object Lock depend on
+func resourceIBMCOSBucketCustomizeDiff(diff *schema.ResourceDiff) error {
+	oldLock, newLock := diff.GetChange("object_lock_configuration.0.enable")
+	if oldLock.(bool) && !newLock.(bool) {
+		return fmt.Errorf("Object Lock can't be disabled once enabled on the bucket")
+	}
+
+	if !diff.NewValueKnown("object_versioning") {
+		return nil
+	}
+	versioning := diff.Get("object_versioning.0.enable").(bool)
+	if _, ok := diff.GetOk("replication_rule"); ok && !versioning {
+		return fmt.Errorf("object_versioning must be enabled on the bucket to set replication_rule")
+	}
+	if newLock.(bool) && !versioning {
+		return fmt.Errorf("object_versioning must be enabled on the bucket to enable Object Lock")
+	}
+	return nil
+}
+
 func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error {
 	var s3Conf *aws.Config
 	rsConClient, err := meta.(ClientSession).BluemixSession()
@@ -457,40 +1390,21 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 	}
 	s3Sess := session.Must(session.NewSession())
 	s3Client := s3.New(s3Sess, s3Conf)
+	setCOSHMACCredentials(s3Client, d)
 
-	//// Update  the lifecycle (Archive or Expire)
-	if d.HasChange("archive_rule") || d.HasChange("expire_rule") {
-		var archive, archive_ok = d.GetOk("archive_rule")
-		var expire, expire_ok = d.GetOk("expire_rule")
-		var rules []*s3.LifecycleRule
-		if archive_ok || expire_ok {
-			if expire_ok {
-				rules = append(rules, expireRuleList(expire.([]interface{}))...)
-			}
-			if archive_ok {
-				rules = append(rules, archiveRuleList(archive.([]interface{}))...)
-			}
-
-			lInput := &s3.PutBucketLifecycleConfigurationInput{
-				Bucket: aws.String(bucketName),
-				LifecycleConfiguration: &s3.LifecycleConfiguration{
-					Rules: rules,
-				},
-			}
-			_, err := s3Client.PutBucketLifecycleConfiguration(lInput)
+	//// Update the lifecycle, archive_rule, expire_rule and lifecycle_rule share it
+	if d.HasChanges("archive_rule", "expire_rule", "lifecycle_rule") {
+		if rules := cosBucketLifecycleRules(d); len(rules) > 0 {
+			err := putCOSBucketLifecycle(s3Client, bucketName, rules)
 			if err != nil {
-				return fmt.Errorf("failed to update the archive rule on COS bucket %s, %v", bucketName, err)
+				return fmt.Errorf("failed to update the lifecycle rules on COS bucket %s, %v", bucketName, err)
 			}
-
 		} else {
-			DelInput := &s3.DeleteBucketLifecycleInput{
+			_, err := s3Client.DeleteBucketLifecycle(&s3.DeleteBucketLifecycleInput{
 				Bucket: aws.String(bucketName),
-			}
-
-			delarchive, _ := s3Client.DeleteBucketLifecycleRequest(DelInput)
-			err := delarchive.Send()
+			})
 			if err != nil {
-				return err
+				return fmt.Errorf("failed to delete the lifecycle rules on COS bucket %s, %v", bucketName, err)
 			}
 		}
 	}
@@ -550,6 +1464,14 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	//// Delete the replication rules first, the versioning can't be suspended while they exist
+	if _, ok := d.GetOk("replication_rule"); d.HasChange("replication_rule") && !ok {
+		err := deleteCOSBucketReplication(s3Client, bucketName)
+		if err != nil {
+			return fmt.Errorf("failed to delete the replication rules on COS bucket %s, %v", bucketName, err)
+		}
+	}
+
 	//update the object versioning (object versioning)
 	if d.HasChange("object_versioning") {
 		versioningConf := &s3.VersioningConfiguration{}
@@ -581,6 +1503,82 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	//// Update the replication rules
+	if replication, ok := d.GetOk("replication_rule"); d.HasChange("replication_rule") && ok {
+		rules := replicationRuleList(replication.([]interface{}))
+		checked := map[string]bool{}
+		for _, rule := range rules {
+			destinationCRN := aws.StringValue(rule.Destination.Bucket)
+			if checked[destinationCRN] {
+				continue
+			}
+			err := checkCOSReplicationDestination(d, rsConClient, parseBucketId(d.Id(), "bLocation"), endpointType, destinationCRN)
+			if err != nil {
+				return err
+			}
+			checked[destinationCRN] = true
+		}
+		err := putCOSBucketReplication(s3Client, bucketName, rules)
+		if err != nil {
+			return fmt.Errorf("failed to update the replication rules on COS bucket %s, %v", bucketName, err)
+		}
+	}
+
+	//// Update the Object Lock configuration
+	if d.HasChange("object_lock_configuration") {
+		conf := objectLockConfiguration(d.Get("object_lock_configuration").([]interface{}))
+		if conf.ObjectLockEnabled != nil {
+			err := putCOSObjectLockConfiguration(s3Client, bucketName, conf)
+			if err != nil {
+				return fmt.Errorf("failed to update the Object Lock configuration on COS bucket %s, %v", bucketName, err)
+			}
+		}
+	}
+
+	//// Update the CORS rules
+	if d.HasChange("cors_rule") {
+		if cors, ok := d.GetOk("cors_rule"); ok {
+			corsInput := &s3.PutBucketCorsInput{
+				Bucket: aws.String(bucketName),
+				CORSConfiguration: &s3.CORSConfiguration{
+					CORSRules: corsRuleList(cors.([]interface{})),
+				},
+			}
+			_, err := s3Client.PutBucketCors(corsInput)
+			if err != nil {
+				return fmt.Errorf("failed to update the CORS rules on COS bucket %s, %v", bucketName, err)
+			}
+		} else {
+			_, err := s3Client.DeleteBucketCors(&s3.DeleteBucketCorsInput{
+				Bucket: aws.String(bucketName),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to delete the CORS rules on COS bucket %s, %v", bucketName, err)
+			}
+		}
+	}
+
+	//// Update the static website configuration
+	if d.HasChange("website") {
+		if website, ok := d.GetOk("website"); ok {
+			websiteInput := &s3.PutBucketWebsiteInput{
+				Bucket:               aws.String(bucketName),
+				WebsiteConfiguration: websiteConfiguration(website.([]interface{})),
+			}
+			_, err := s3Client.PutBucketWebsite(websiteInput)
+			if err != nil {
+				return fmt.Errorf("failed to update the website configuration on COS bucket %s, %v", bucketName, err)
+			}
+		} else {
+			_, err := s3Client.DeleteBucketWebsite(&s3.DeleteBucketWebsiteInput{
+				Bucket: aws.String(bucketName),
+			})
+			if err != nil {
+				return fmt.Errorf("failed to delete the website configuration on COS bucket %s, %v", bucketName, err)
+			}
+		}
+	}
+
 	sess, err := meta.(ClientSession).CosConfigV1API()
 	if err != nil {
 		return err
@@ -713,6 +1711,7 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 	}
 	s3Sess := session.Must(session.NewSession())
 	s3Client := s3.New(s3Sess, s3Conf)
+	setCOSHMACCredentials(s3Client, d)
 
 	headInput := &s3.HeadBucketInput{
 		Bucket: aws.String(bucketName),
@@ -800,27 +1799,16 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("metrics_monitoring", flattenMetricsMonitor(bucketPtr.MetricsMonitoring))
 		}
 	}
-	// Read the lifecycle configuration (archive & expiration)
-
-	gInput := &s3.GetBucketLifecycleConfigurationInput{
-		Bucket: aws.String(bucketName),
-	}
-
-	lifecycleptr, err := s3Client.GetBucketLifecycleConfiguration(gInput)
-
+	// Read the lifecycle configuration (archive, expiration and lifecycle rules)
+	lifecycle, err := getCOSBucketLifecycle(s3Client, bucketName)
 	if (err != nil && !strings.Contains(err.Error(), "NoSuchLifecycleConfiguration: The lifecycle configuration does not exist")) && (err != nil && bucketPtr != nil && bucketPtr.Firewall != nil && !strings.Contains(err.Error(), "AccessDenied: Access Denied")) {
 		return err
 	}
-
-	if lifecycleptr != nil {
-		archiveRules := archiveRuleGet(lifecycleptr.Rules)
-		expireRules := expireRuleGet(lifecycleptr.Rules)
-		if len(archiveRules) > 0 {
-			d.Set("archive_rule", archiveRules)
-		}
-		if len(expireRules) > 0 {
-			d.Set("expire_rule", expireRules)
-		}
+	if err == nil || strings.Contains(err.Error(), "NoSuchLifecycleConfiguration") {
+		archiveRules, expireRules, lifecycleRules := lifecycleRulesGet(lifecycle, d.Get("lifecycle_rule").([]interface{}))
+		d.Set("archive_rule", archiveRules)
+		d.Set("expire_rule", expireRules)
+		d.Set("lifecycle_rule", lifecycleRules)
 	}
 
 	// Read retention rule
@@ -857,6 +1845,52 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("object_versioning", nil)
 		}
 	}
+
+	// Read the replication rules
+	replicationPtr, err := getCOSBucketReplication(s3Client, bucketName)
+	if err != nil && strings.Contains(err.Error(), "ReplicationConfigurationNotFoundError") {
+		d.Set("replication_rule", nil)
+	} else if err != nil && bucketPtr != nil && bucketPtr.Firewall != nil && !strings.Contains(err.Error(), "AccessDenied: Access Denied") {
+		return err
+	} else if err == nil && replicationPtr != nil {
+		d.Set("replication_rule", replicationRuleGet(replicationPtr))
+	}
+
+	// Read the Object Lock configuration
+	objectLockPtr, err := getCOSObjectLockConfiguration(s3Client, bucketName)
+	if err != nil && strings.Contains(err.Error(), "ObjectLockConfigurationNotFoundError") {
+		d.Set("object_lock_configuration", objectLockConfigurationGet(&cosObjectLockConfiguration{}, d.Get("object_lock_configuration").([]interface{})))
+	} else if err != nil && bucketPtr != nil && bucketPtr.Firewall != nil && !strings.Contains(err.Error(), "AccessDenied: Access Denied") {
+		return err
+	} else if err == nil && objectLockPtr != nil {
+		d.Set("object_lock_configuration", objectLockConfigurationGet(objectLockPtr, d.Get("object_lock_configuration").([]interface{})))
+	}
+
+	// Read the CORS rules
+	corsInput := &s3.GetBucketCorsInput{
+		Bucket: aws.String(bucketName),
+	}
+	corsPtr, err := s3Client.GetBucketCors(corsInput)
+	if err != nil && strings.Contains(err.Error(), "NoSuchCORSConfiguration") {
+		d.Set("cors_rule", nil)
+	} else if err != nil && bucketPtr != nil && bucketPtr.Firewall != nil && !strings.Contains(err.Error(), "AccessDenied: Access Denied") {
+		return err
+	} else if err == nil && corsPtr != nil {
+		d.Set("cors_rule", corsRuleGet(corsPtr.CORSRules))
+	}
+
+	// Read the static website configuration
+	websiteInput := &s3.GetBucketWebsiteInput{
+		Bucket: aws.String(bucketName),
+	}
+	websitePtr, err := s3Client.GetBucketWebsite(websiteInput)
+	if err != nil && strings.Contains(err.Error(), "NoSuchWebsiteConfiguration") {
+		d.Set("website", nil)
+	} else if err != nil && bucketPtr != nil && bucketPtr.Firewall != nil && !strings.Contains(err.Error(), "AccessDenied: Access Denied") {
+		return err
+	} else if err == nil && websitePtr != nil {
+		d.Set("website", websiteGet(websitePtr))
+	}
 	return nil
 }
 
@@ -934,6 +1968,7 @@ func resourceIBMCOSBucketCreate(d *schema.ResourceData, meta interface{}) error
 
 	s3Sess := session.Must(session.NewSession())
 	s3Client := s3.New(s3Sess, s3Conf)
+	setCOSHMACCredentials(s3Client, d)
 
 	_, err = s3Client.CreateBucket(create)
 	if err != nil {
@@ -1001,6 +2036,7 @@ func resourceIBMCOSBucketDelete(d *schema.ResourceData, meta interface{}) error
 
 	s3Sess := session.Must(session.NewSession())
 	s3Client := s3.New(s3Sess, s3Conf)
+	setCOSHMACCredentials(s3Client, d)
 
 	delete := &s3.DeleteBucketInput{
 		Bucket: aws.String(bucketName),
@@ -1081,6 +2117,7 @@ func resourceIBMCOSBucketExists(d *schema.ResourceData, meta interface{}) (bool,
 
 	s3Sess := session.Must(session.NewSession())
 	s3Client := s3.New(s3Sess, s3Conf)
+	setCOSHMACCredentials(s3Client, d)
 
 	bucketList, err := s3Client.ListBuckets(&s3.ListBucketsInput{})
 	if err != nil {
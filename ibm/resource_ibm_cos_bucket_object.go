@@ -6,7 +6,10 @@ package ibm
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/xml"
 	"fmt"
 	"io"
 	"log"
@@ -18,12 +21,16 @@ import (
 	bxsession "github.com/IBM-Cloud/bluemix-go/session"
 	"github.com/IBM/ibm-cos-sdk-go/aws"
 	"github.com/IBM/ibm-cos-sdk-go/aws/awserr"
+	"github.com/IBM/ibm-cos-sdk-go/aws/credentials"
 	"github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam"
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
+	"github.com/IBM/ibm-cos-sdk-go/aws/request"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func resourceIBMCOSBucketObject() *schema.Resource {
@@ -92,6 +99,20 @@ func resourceIBMCOSBucketObject() *schema.Resource {
 				Description:  "COS endpoint type: public, private, direct",
 				Default:      "public",
 			},
+			"hmac_access_key_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"hmac_secret_access_key"},
+				Description:  "HMAC access key ID used to sign the requests instead of IAM",
+			},
+			"hmac_secret_access_key": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"hmac_access_key_id"},
+				Description:  "HMAC secret access key used to sign the requests instead of IAM",
+			},
 			"etag": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -119,6 +140,83 @@ func resourceIBMCOSBucketObject() *schema.Resource {
 				Default:     true,
 				Description: "COS buckets need to be empty before they can be deleted. force_delete option empty the bucket and delete it.",
 			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validateAllowedRangeInt(5, 5120),
+				Description:  "Part size in MiB of the multipart uploads, the content is uploaded in parts when it's larger",
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validateAllowedRangeInt(1, 100),
+				Description:  "Number of parts uploaded in parallel",
+			},
+			"object_etag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "COS object ETag, it is not the MD5 hexdigest of multipart and encrypted objects",
+			},
+			"metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "COS object user metadata, the keys must be in lowercase",
+			},
+			"cache_control": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "COS object caching behavior",
+			},
+			"website_redirect": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "URL or object key the requests of the object are redirected to when the bucket is a website",
+			},
+			"server_side_encryption": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Computed:      true,
+				ValidateFunc:  validateAllowedStringValue([]string{keyAlgorithm}),
+				ConflictsWith: []string{"sse_customer_key"},
+				Description:   "Server side encryption of the COS object: AES256",
+			},
+			"sse_customer_key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validateCOSSSECustomerKey,
+				ConflictsWith: []string{"server_side_encryption"},
+				Description:   "Base64 encoded 256-bit key used to encrypt the COS object (SSE-C)",
+			},
+			"key_protect": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CRN of the Key Protect root key that encrypts the COS object when the bucket uses SSE-KP",
+			},
+			"object_lock_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"object_lock_retain_until_date"},
+				ValidateFunc: validateAllowedStringValue([]string{"COMPLIANCE"}),
+				Description:  "Retention mode of the COS object: COMPLIANCE",
+			},
+			"object_lock_retain_until_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				RequiredWith:     []string{"object_lock_mode"},
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: suppressEquivalentRFC3339Time,
+				Description:      "Date until the COS object is retained, in RFC3339 format",
+			},
+			"object_lock_legal_hold_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"ON", "OFF"}),
+				Description:  "Legal hold status of the COS object: ON or OFF",
+			},
 		},
 	}
 }
@@ -140,6 +238,7 @@ func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	setCOSHMACCredentials(s3Client, d)
 
 	objectKey := d.Get("key").(string)
 
@@ -153,47 +252,17 @@ func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(fmt.Errorf("error COS bucket (%s) object (%s) already exists", bucketName, objectKey))
 	}
 
-	var body io.ReadSeeker
-
-	if v, ok := d.GetOk("content"); ok {
-		content := v.(string)
-		body = bytes.NewReader([]byte(content))
-	} else if v, ok := d.GetOk("content_base64"); ok {
-		content := v.(string)
-		contentRaw, err := base64.StdEncoding.DecodeString(content)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("error decoding content_base64: %s", err))
-		}
-		body = bytes.NewReader(contentRaw)
-	} else if v, ok := d.GetOk("content_file"); ok {
-		path := v.(string)
-		file, err := os.Open(path)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("error opening COS object file (%s): %s", path, err))
-		}
-
-		body = file
-		defer func() {
-			err := file.Close()
-			if err != nil {
-				log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
-			}
-		}()
-	}
-
-	putInput := &s3.PutObjectInput{
-		Bucket: aws.String(bucketName),
-		Key:    aws.String(objectKey),
-		Body:   body,
-	}
-
-	if _, err := s3Client.PutObject(putInput); err != nil {
-		return diag.FromErr(fmt.Errorf("error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+	if err := uploadCOSObject(s3Client, d, bucketName, objectKey); err != nil {
+		return diag.FromErr(err)
 	}
 
 	objectID := getObjectId(bucketCRN, objectKey, bucketLocation)
 	d.SetId(objectID)
 
+	if err := putCOSObjectLock(s3Client, d, bucketName, objectKey, true); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceIBMCOSBucketObjectRead(ctx, d, m)
 }
 
@@ -218,26 +287,54 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	setCOSHMACCredentials(s3Client, d)
 
 	objectKey := parseObjectId(objectID, "objectKey")
 	headInput := &s3.HeadObjectInput{
 		Bucket: aws.String(bucketName),
 		Key:    aws.String(objectKey),
 	}
+	sseCustomerKey, err := cosObjectSSECustomerKey(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if sseCustomerKey != "" {
+		headInput.SSECustomerAlgorithm = aws.String(keyAlgorithm)
+		headInput.SSECustomerKey = aws.String(sseCustomerKey)
+	}
 
-	out, err := s3Client.HeadObject(headInput)
+	// The Object Lock and Key Protect headers are not in the output of the S3 client
+	headReq, out := s3Client.HeadObjectRequest(headInput)
+	err = headReq.Send()
 	if err != nil {
 		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "NotFound" {
 			d.SetId("") // Set state back to empty for terraform refresh
 		}
 		return diag.FromErr(fmt.Errorf("failed getting COS bucket (%s) object (%s): %w", bucketName, objectKey, err))
 	}
+	headers := headReq.HTTPResponse.Header
 
 	log.Printf("[DEBUG] Received COS object: %s", out)
 
 	d.Set("content_length", out.ContentLength)
 	d.Set("content_type", out.ContentType)
-	d.Set("etag", strings.Trim(aws.StringValue(out.ETag), `"`))
+	// The ETag of multipart or encrypted objects is not the MD5 of the content,
+	// the MD5 computed at upload is kept while the object is not changed
+	objectETag := strings.Trim(aws.StringValue(out.ETag), `"`)
+	if objectETag != d.Get("object_etag").(string) {
+		d.Set("etag", objectETag)
+	}
+	d.Set("object_etag", objectETag)
+	d.Set("cache_control", out.CacheControl)
+	d.Set("website_redirect", out.WebsiteRedirectLocation)
+	d.Set("server_side_encryption", out.ServerSideEncryption)
+	d.Set("key_protect", headers.Get("ibm-sse-kp-customer-root-key-crn"))
+	metadata := make(map[string]string, len(out.Metadata))
+	for k, v := range out.Metadata {
+		// The S3 client returns the keys in canonical form
+		metadata[strings.ToLower(k)] = aws.StringValue(v)
+	}
+	d.Set("metadata", metadata)
 	if out.LastModified != nil {
 		d.Set("last_modified", out.LastModified.Format(time.RFC1123))
 	} else {
@@ -249,6 +346,10 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 			Bucket: aws.String(bucketName),
 			Key:    aws.String(objectKey),
 		}
+		if sseCustomerKey != "" {
+			getInput.SSECustomerAlgorithm = aws.String(keyAlgorithm)
+			getInput.SSECustomerKey = aws.String(sseCustomerKey)
+		}
 		out, err := s3Client.GetObject(&getInput)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("failed getting COS object: %w", err))
@@ -274,12 +375,22 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 
 	d.Set("key", objectKey)
 	d.Set("version_id", out.VersionId)
+	d.Set("object_lock_mode", headers.Get("x-amz-object-lock-mode"))
+	if retainUntil := headers.Get("x-amz-object-lock-retain-until-date"); retainUntil != "" {
+		if t, err := time.Parse(time.RFC3339, retainUntil); err == nil {
+			retainUntil = t.Format(time.RFC3339)
+		}
+		d.Set("object_lock_retain_until_date", retainUntil)
+	} else {
+		d.Set("object_lock_retain_until_date", "")
+	}
+	d.Set("object_lock_legal_hold_status", headers.Get("x-amz-object-lock-legal-hold"))
 
 	return nil
 }
 
 func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	if d.HasChanges("content", "content_base64", "content_file", "etag") {
+	if d.HasChanges("content", "content_base64", "content_file", "etag", "metadata", "cache_control", "website_redirect", "server_side_encryption", "sse_customer_key") {
 		bucketCRN := d.Get("bucket_crn").(string)
 		bucketName := strings.Split(bucketCRN, ":bucket:")[1]
 		instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
@@ -296,49 +407,39 @@ func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceDat
 		if err != nil {
 			return diag.FromErr(err)
 		}
+		setCOSHMACCredentials(s3Client, d)
 
-		var body io.ReadSeeker
-
-		if v, ok := d.GetOk("content"); ok {
-			content := v.(string)
-			body = bytes.NewReader([]byte(content))
-		} else if v, ok := d.GetOk("content_base64"); ok {
-			content := v.(string)
-			contentRaw, err := base64.StdEncoding.DecodeString(content)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("error decoding content_base64: %s", err))
-			}
-			body = bytes.NewReader(contentRaw)
-		} else if v, ok := d.GetOk("content_file"); ok {
-			path := v.(string)
-			file, err := os.Open(path)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("error opening COS object file (%s): %s", path, err))
-			}
+		objectKey := d.Get("key").(string)
 
-			body = file
-			defer func() {
-				err := file.Close()
-				if err != nil {
-					log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
-				}
-			}()
+		if err := uploadCOSObject(s3Client, d, bucketName, objectKey); err != nil {
+			return diag.FromErr(err)
 		}
 
-		objectKey := d.Get("key").(string)
+		objectID := getObjectId(bucketCRN, objectKey, bucketLocation)
+		d.SetId(objectID)
 
-		putInput := &s3.PutObjectInput{
-			Bucket: aws.String(bucketName),
-			Key:    aws.String(objectKey),
-			Body:   body,
+		if err := putCOSObjectLock(s3Client, d, bucketName, objectKey, true); err != nil {
+			return diag.FromErr(err)
+		}
+	} else if d.HasChanges("object_lock_mode", "object_lock_retain_until_date", "object_lock_legal_hold_status") {
+		bucketCRN := d.Get("bucket_crn").(string)
+		bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+		instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+		bxSession, err := m.(ClientSession).BluemixSession()
+		if err != nil {
+			return diag.FromErr(err)
 		}
 
-		if _, err := s3Client.PutObject(putInput); err != nil {
-			return diag.FromErr(fmt.Errorf("error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+		s3Client, err := getS3Client(bxSession, d.Get("bucket_location").(string), d.Get("endpoint_type").(string), instanceCRN)
+		if err != nil {
+			return diag.FromErr(err)
 		}
+		setCOSHMACCredentials(s3Client, d)
 
-		objectID := getObjectId(bucketCRN, objectKey, bucketLocation)
-		d.SetId(objectID)
+		if err := putCOSObjectLock(s3Client, d, bucketName, d.Get("key").(string), false); err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceIBMCOSBucketObjectRead(ctx, d, m)
@@ -361,8 +462,16 @@ func resourceIBMCOSBucketObjectDelete(ctx context.Context, d *schema.ResourceDat
 	if err != nil {
 		return diag.FromErr(err)
 	}
+	setCOSHMACCredentials(s3Client, d)
 	objectKey := d.Get("key").(string)
 
+	// An object under legal hold can't be deleted
+	if d.Get("object_lock_legal_hold_status").(string) == "ON" && d.Get("force_delete").(bool) {
+		if err := putCOSObjectLegalHold(s3Client, bucketName, objectKey, "OFF"); err != nil {
+			return diag.FromErr(fmt.Errorf("error removing the legal hold of object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
+		}
+	}
+
 	if _, ok := d.GetOk("version_id"); ok {
 		err = deleteAllCOSObjectVersions(s3Client, bucketName, objectKey, d.Get("force_delete").(bool), false)
 	} else {
@@ -375,6 +484,177 @@ func resourceIBMCOSBucketObjectDelete(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
+// uploadCOSObject uploads the content of the configuration with the S3
+// manager, in parts when it's larger than the part size
+func uploadCOSObject(s3Client *s3.S3, d *schema.ResourceData, bucketName, objectKey string) error {
+	var body io.ReadSeeker = bytes.NewReader([]byte{})
+
+	if v, ok := d.GetOk("content"); ok {
+		content := v.(string)
+		body = bytes.NewReader([]byte(content))
+	} else if v, ok := d.GetOk("content_base64"); ok {
+		content := v.(string)
+		contentRaw, err := base64.StdEncoding.DecodeString(content)
+		if err != nil {
+			return fmt.Errorf("error decoding content_base64: %s", err)
+		}
+		body = bytes.NewReader(contentRaw)
+	} else if v, ok := d.GetOk("content_file"); ok {
+		path := v.(string)
+		file, err := os.Open(path)
+		if err != nil {
+			return fmt.Errorf("error opening COS object file (%s): %s", path, err)
+		}
+
+		body = file
+		defer func() {
+			err := file.Close()
+			if err != nil {
+				log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
+			}
+		}()
+	}
+
+	// The content is read twice: once for its MD5 and once to upload it
+	hash := md5.New()
+	if _, err := io.Copy(hash, body); err != nil {
+		return fmt.Errorf("error reading content of object (%s): %s", objectKey, err)
+	}
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return fmt.Errorf("error reading content of object (%s): %s", objectKey, err)
+	}
+
+	uploadInput := &s3manager.UploadInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+		Body:   body,
+	}
+	if v, ok := d.GetOk("metadata"); ok {
+		uploadInput.Metadata = make(map[string]*string)
+		for k, value := range v.(map[string]interface{}) {
+			uploadInput.Metadata[k] = aws.String(value.(string))
+		}
+	}
+	if v, ok := d.GetOk("cache_control"); ok {
+		uploadInput.CacheControl = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("website_redirect"); ok {
+		uploadInput.WebsiteRedirectLocation = aws.String(v.(string))
+	}
+	if v, ok := d.GetOk("server_side_encryption"); ok {
+		uploadInput.ServerSideEncryption = aws.String(v.(string))
+	}
+	sseCustomerKey, err := cosObjectSSECustomerKey(d)
+	if err != nil {
+		return err
+	}
+	if sseCustomerKey != "" {
+		uploadInput.SSECustomerAlgorithm = aws.String(keyAlgorithm)
+		uploadInput.SSECustomerKey = aws.String(sseCustomerKey)
+	}
+
+	uploader := s3manager.NewUploaderWithClient(s3Client, func(u *s3manager.Uploader) {
+		u.PartSize = int64(d.Get("part_size").(int)) * 1024 * 1024
+		u.Concurrency = d.Get("concurrency").(int)
+	})
+	out, err := uploader.Upload(uploadInput)
+	if err != nil {
+		return fmt.Errorf("error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+	}
+
+	d.Set("etag", hex.EncodeToString(hash.Sum(nil)))
+	d.Set("object_etag", strings.Trim(aws.StringValue(out.ETag), `"`))
+	return nil
+}
+
+// cosObjectSSECustomerKey returns the raw SSE-C key of the configuration, the
+// S3 client encodes it again in base64
+func cosObjectSSECustomerKey(d *schema.ResourceData) (string, error) {
+	v, ok := d.GetOk("sse_customer_key")
+	if !ok {
+		return "", nil
+	}
+	key, err := base64.StdEncoding.DecodeString(v.(string))
+	if err != nil {
+		return "", fmt.Errorf("error decoding sse_customer_key: %s", err)
+	}
+	return string(key), nil
+}
+
+type cosObjectRetention struct {
+	XMLName         xml.Name   `xml:"Retention"`
+	Xmlns           string     `xml:"xmlns,attr,omitempty"`
+	Mode            *string    `xml:"Mode"`
+	RetainUntilDate *time.Time `xml:"RetainUntilDate"`
+}
+
+type cosObjectLegalHold struct {
+	XMLName xml.Name `xml:"LegalHold"`
+	Xmlns   string   `xml:"xmlns,attr,omitempty"`
+	Status  *string  `xml:"Status"`
+}
+
+func putCOSObjectRetention(s3Client *s3.S3, bucketName, objectKey, mode string, retainUntil time.Time) error {
+	op := &request.Operation{
+		Name:       "PutObjectRetention",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}/{Key+}?retention",
+	}
+	retention := &cosObjectRetention{
+		Xmlns:           cosXMLNamespace,
+		Mode:            aws.String(mode),
+		RetainUntilDate: aws.Time(retainUntil),
+	}
+	uriInput := &s3.HeadObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+	}
+	return sendCOSRequest(s3Client, op, uriInput, retention, nil)
+}
+
+func putCOSObjectLegalHold(s3Client *s3.S3, bucketName, objectKey, status string) error {
+	op := &request.Operation{
+		Name:       "PutObjectLegalHold",
+		HTTPMethod: "PUT",
+		HTTPPath:   "/{Bucket}/{Key+}?legal-hold",
+	}
+	legalHold := &cosObjectLegalHold{
+		Xmlns:  cosXMLNamespace,
+		Status: aws.String(status),
+	}
+	uriInput := &s3.HeadObjectInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+	}
+	return sendCOSRequest(s3Client, op, uriInput, legalHold, nil)
+}
+
+// putCOSObjectLock applies the retention and the legal hold of the
+// configuration to the current version of an object, a new version gets all
+// of them. A retention in COMPLIANCE mode can only be extended, it is never
+// removed.
+func putCOSObjectLock(s3Client *s3.S3, d *schema.ResourceData, bucketName, objectKey string, newVersion bool) error {
+	if newVersion || d.HasChanges("object_lock_mode", "object_lock_retain_until_date") {
+		if mode, ok := d.GetOk("object_lock_mode"); ok {
+			retainUntil, _ := time.Parse(time.RFC3339, d.Get("object_lock_retain_until_date").(string))
+			if err := putCOSObjectRetention(s3Client, bucketName, objectKey, mode.(string), retainUntil); err != nil {
+				return fmt.Errorf("error setting the retention of object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+			}
+		} else if !newVersion {
+			return fmt.Errorf("the retention of object (%s) in COS bucket (%s) can't be removed", objectKey, bucketName)
+		}
+	}
+	if status, ok := d.GetOk("object_lock_legal_hold_status"); (newVersion && ok) || (!newVersion && d.HasChange("object_lock_legal_hold_status")) {
+		if status == "" {
+			status = "OFF"
+		}
+		if err := putCOSObjectLegalHold(s3Client, bucketName, objectKey, status.(string)); err != nil {
+			return fmt.Errorf("error setting the legal hold of object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+		}
+	}
+	return nil
+}
+
 func getCosEndpoint(bucketLocation string, endpointType string) string {
 	if bucketLocation != "" {
 		switch endpointType {
@@ -426,6 +706,16 @@ func getS3Client(bxSession *bxsession.Session, bucketLocation string, endpointTy
 	return s3.New(s3Sess, s3Conf), nil
 }
 
+// setCOSHMACCredentials signs the requests of the client with the HMAC keys
+// of the resource instead of an IAM token when they are set
+func setCOSHMACCredentials(s3Client *s3.S3, d *schema.ResourceData) {
+	accessKeyID := d.Get("hmac_access_key_id").(string)
+	secretAccessKey := d.Get("hmac_secret_access_key").(string)
+	if accessKeyID != "" && secretAccessKey != "" {
+		s3Client.Config.Credentials = credentials.NewStaticCredentials(accessKeyID, secretAccessKey, "")
+	}
+}
+
 // This is to prevent potential issues w/ binary files
 // and generally unprintable characters
 // See https://github.com/hashicorp/terraform/pull/3858#issuecomment-156856738
@@ -4,9 +4,12 @@
 package ibm
 
 import (
+	"bytes"
 	"encoding/base64"
 	"fmt"
 	"io/ioutil"
+	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
@@ -63,6 +66,70 @@ func TestAccIBMCOSBucketObject_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMCOSBucketObject_multipart(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-mp-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := cosCRN
+
+	// 12 MiB are uploaded in 3 parts of 5 MiB
+	objectFile, err := ioutil.TempFile("", "cos-multipart")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(objectFile.Name())
+	objectFile.Write(bytes.Repeat([]byte("a"), 12*1024*1024))
+	objectFile.Close()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckCOS(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectConfig_multipart(name, instanceCRN, objectFile.Name(), "max-age=60"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_object.testacc", "id"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "content_length", "12582912"),
+					resource.TestMatchResourceAttr("ibm_cos_bucket_object.testacc", "object_etag", regexp.MustCompile(`-3$`)),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "metadata.owner", "terraform"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "cache_control", "max-age=60"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "server_side_encryption", "AES256"),
+				),
+			},
+			{
+				Config: testAccIBMCOSBucketObjectConfig_multipart(name, instanceCRN, objectFile.Name(), "no-cache"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "cache_control", "no-cache"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMCOSBucketObject_legalHold(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-lock-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := cosCRN
+	objectBody := "Acceptance Testing"
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckCOS(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectConfig_legalHold(name, instanceCRN, objectBody, "ON"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_object.testacc", "id"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_object.testacc", "version_id"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "object_lock_legal_hold_status", "ON"),
+				),
+			},
+			{
+				Config: testAccIBMCOSBucketObjectConfig_legalHold(name, instanceCRN, objectBody, "OFF"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "object_lock_legal_hold_status", "OFF"),
+				),
+			},
+		},
+	})
+}
+
 func testAccIBMCOSBucketObjectConfig_plaintext(name string, instanceCRN string, objectBody string) string {
 	return fmt.Sprintf(`
 		resource "ibm_cos_bucket" "testacc" {
@@ -110,3 +177,50 @@ func testAccIBMCOSBucketObjectConfig_file(name string, instanceCRN string, objec
 			content_file	  = "%[3]s"
 		}`, name, instanceCRN, objectFile)
 }
+
+func testAccIBMCOSBucketObjectConfig_legalHold(name string, instanceCRN string, objectBody string, legalHold string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+			object_versioning {
+				enable = true
+			}
+			object_lock_configuration {
+				enable = true
+			}
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			bucket_crn                    = ibm_cos_bucket.testacc.crn
+			bucket_location               = ibm_cos_bucket.testacc.region_location
+			key                           = "%[1]s.txt"
+			content                       = "%[3]s"
+			object_lock_legal_hold_status = "%[4]s"
+		}`, name, instanceCRN, objectBody, legalHold)
+}
+
+func testAccIBMCOSBucketObjectConfig_multipart(name string, instanceCRN string, objectFile string, cacheControl string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			bucket_crn             = ibm_cos_bucket.testacc.crn
+			bucket_location        = ibm_cos_bucket.testacc.region_location
+			key                    = "%[1]s.bin"
+			content_file           = "%[3]s"
+			etag                   = filemd5("%[3]s")
+			part_size              = 5
+			concurrency            = 3
+			cache_control          = "%[4]s"
+			server_side_encryption = "AES256"
+			metadata = {
+				owner = "terraform"
+			}
+		}`, name, instanceCRN, objectFile, cacheControl)
+}
@@ -0,0 +1,543 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMCOSBucketObjects() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketObjectsCreate,
+		ReadContext:   resourceIBMCOSBucketObjectsRead,
+		UpdateContext: resourceIBMCOSBucketObjectsUpdate,
+		DeleteContext: resourceIBMCOSBucketObjectsDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMCOSBucketObjectsCustomizeDiff(diff)
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"source_dir": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Local directory synchronized with the bucket",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Prefix of the object keys, the relative paths of the files are appended to it",
+			},
+			"exclude": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Patterns of the files that are not synchronized, matched against the relative path and the name of the files",
+			},
+			"prune": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the objects under the prefix that are not in the directory",
+			},
+			"content_types": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Content types of the file extensions, they override the types inferred from the extensions",
+			},
+			"concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      10,
+				ValidateFunc: validateAllowedRangeInt(1, 100),
+				Description:  "Number of files uploaded in parallel",
+			},
+			"files": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "MD5 hexdigest of the synchronized objects by key",
+			},
+			"etags": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "ETag of the synchronized objects by key as returned on their upload",
+			},
+		},
+	}
+}
+
+// cosSyncFile is a local file of the directory uploaded to the bucket
+type cosSyncFile struct {
+	key  string
+	path string
+	md5  string
+}
+
+// cosSyncExcluded tells whether the file at the relative path relPath matches
+// one of the exclude patterns
+func cosSyncExcluded(relPath string, exclude []string) bool {
+	for _, pattern := range exclude {
+		if matched, _ := path.Match(pattern, relPath); matched {
+			return true
+		}
+		if matched, _ := path.Match(pattern, path.Base(relPath)); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// cosSyncLocalFiles walks the source directory and computes the MD5 of its
+// files, indexed by object key
+func cosSyncLocalFiles(sourceDir, prefix string, exclude []string) (map[string]cosSyncFile, error) {
+	files := map[string]cosSyncFile{}
+	err := filepath.Walk(sourceDir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			return nil
+		}
+		relPath, err := filepath.Rel(sourceDir, filePath)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+		if cosSyncExcluded(relPath, exclude) {
+			return nil
+		}
+
+		file, err := os.Open(filePath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		hash := md5.New()
+		if _, err := io.Copy(hash, file); err != nil {
+			return err
+		}
+
+		key := prefix + relPath
+		files[key] = cosSyncFile{
+			key:  key,
+			path: filePath,
+			md5:  hex.EncodeToString(hash.Sum(nil)),
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error reading directory %s: %s", sourceDir, err)
+	}
+	return files, nil
+}
+
+// resourceIBMCOSBucketObjectsCustomizeDiff plans the upload of the files that
+// changed in the directory since the last apply
+func resourceIBMCOSBucketObjectsCustomizeDiff(diff *schema.ResourceDiff) error {
+	if !diff.NewValueKnown("source_dir") || !diff.NewValueKnown("prefix") || !diff.NewValueKnown("exclude") {
+		return diff.SetNewComputed("files")
+	}
+	localFiles, err := cosSyncLocalFiles(diff.Get("source_dir").(string), diff.Get("prefix").(string), expandStringList(diff.Get("exclude").([]interface{})))
+	if err != nil {
+		return err
+	}
+	files := make(map[string]interface{}, len(localFiles))
+	for key, file := range localFiles {
+		files[key] = file.md5
+	}
+
+	oldFiles := diff.Get("files").(map[string]interface{})
+	if diff.Id() != "" && len(oldFiles) == len(files) {
+		changed := false
+		for key, md5 := range files {
+			if oldFiles[key] != md5 {
+				changed = true
+				break
+			}
+		}
+		if !changed {
+			return nil
+		}
+	}
+	if err := diff.SetNew("files", files); err != nil {
+		return err
+	}
+	return diff.SetNewComputed("etags")
+}
+
+func resourceIBMCOSBucketObjectsClient(d *schema.ResourceData, m interface{}) (*s3.S3, string, error) {
+	bucketCRN := d.Get("bucket_crn").(string)
+	if !strings.Contains(bucketCRN, ":bucket:") {
+		return nil, "", fmt.Errorf("Incorrect bucket CRN %s", bucketCRN)
+	}
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bxSession, err := m.(ClientSession).BluemixSession()
+	if err != nil {
+		return nil, "", err
+	}
+	s3Client, err := getS3Client(bxSession, d.Get("bucket_location").(string), d.Get("endpoint_type").(string), instanceCRN)
+	if err != nil {
+		return nil, "", err
+	}
+	return s3Client, bucketName, nil
+}
+
+func resourceIBMCOSBucketObjectsCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	// The ID is set first, so that the objects uploaded before a failure are
+	// kept in the state and deleted with the resource
+	d.SetId(fmt.Sprintf("%s:prefix:%s:location:%s", d.Get("bucket_crn").(string), d.Get("prefix").(string), d.Get("bucket_location").(string)))
+
+	if err := syncCOSBucketObjects(d, m, map[string]interface{}{}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMCOSBucketObjectsRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketObjectsRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s3Client, bucketName, err := resourceIBMCOSBucketObjectsClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	prefix := d.Get("prefix").(string)
+	exclude := expandStringList(d.Get("exclude").([]interface{}))
+	prune := d.Get("prune").(bool)
+	stateFiles := d.Get("files").(map[string]interface{})
+	stateETags := d.Get("etags").(map[string]interface{})
+
+	// One listing of the prefix replaces a request per object. The ETag of
+	// an object is not always the MD5 of its content, such as in encrypted
+	// buckets, so it's compared with the ETag of its upload. The objects that
+	// changed in the bucket get their ETag, so they are uploaded again.
+	files := make(map[string]interface{}, len(stateFiles))
+	etags := make(map[string]interface{}, len(stateFiles))
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+		Prefix: aws.String(prefix),
+	}
+	err = s3Client.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			key := aws.StringValue(object.Key)
+			etag := strings.Trim(aws.StringValue(object.ETag), `"`)
+			if md5, ok := stateFiles[key]; ok {
+				uploadETag, recorded := stateETags[key]
+				if !recorded {
+					// The ETag of the objects of earlier versions is taken over
+					uploadETag = etag
+				}
+				if uploadETag == etag {
+					files[key] = md5
+				} else {
+					files[key] = etag
+				}
+				etags[key] = uploadETag
+			} else if prune && !cosSyncExcluded(strings.TrimPrefix(key, prefix), exclude) {
+				files[key] = etag
+			}
+		}
+		return !lastPage
+	})
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed listing COS bucket (%s) objects with prefix (%s): %s", bucketName, prefix, err))
+	}
+
+	d.Set("files", files)
+	d.Set("etags", etags)
+
+	return nil
+}
+
+func resourceIBMCOSBucketObjectsUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	if d.HasChanges("files", "content_types", "prune") {
+		oldFiles, _ := d.GetChange("files")
+		if d.HasChange("content_types") {
+			// All the objects are uploaded again with their new content type
+			oldFiles = map[string]interface{}{}
+		}
+		if err := syncCOSBucketObjects(d, m, oldFiles.(map[string]interface{})); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMCOSBucketObjectsRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketObjectsDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s3Client, bucketName, err := resourceIBMCOSBucketObjectsClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var keys []string
+	for key := range d.Get("files").(map[string]interface{}) {
+		keys = append(keys, key)
+	}
+	if err := deleteCOSObjects(s3Client, bucketName, keys); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// syncCOSBucketObjects uploads the files of the directory that are not in
+// oldFiles with the same MD5, and deletes the objects that are no longer in
+// the directory when prune is set
+func syncCOSBucketObjects(d *schema.ResourceData, m interface{}, oldFiles map[string]interface{}) error {
+	s3Client, bucketName, err := resourceIBMCOSBucketObjectsClient(d, m)
+	if err != nil {
+		return err
+	}
+
+	prefix := d.Get("prefix").(string)
+	exclude := expandStringList(d.Get("exclude").([]interface{}))
+	localFiles, err := cosSyncLocalFiles(d.Get("source_dir").(string), prefix, exclude)
+	if err != nil {
+		return err
+	}
+
+	var uploads []cosSyncFile
+	for key, file := range localFiles {
+		if oldFiles[key] != file.md5 {
+			uploads = append(uploads, file)
+		}
+	}
+	var deletes []string
+	for key := range oldFiles {
+		if _, ok := localFiles[key]; !ok {
+			deletes = append(deletes, key)
+		}
+	}
+	prune := d.Get("prune").(bool)
+	if prune {
+		// The objects that were never in the directory are deleted too
+		input := &s3.ListObjectsV2Input{
+			Bucket: aws.String(bucketName),
+			Prefix: aws.String(prefix),
+		}
+		err = s3Client.ListObjectsV2Pages(input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+			for _, object := range page.Contents {
+				key := aws.StringValue(object.Key)
+				_, local := localFiles[key]
+				_, old := oldFiles[key]
+				if !local && !old && !cosSyncExcluded(strings.TrimPrefix(key, prefix), exclude) {
+					deletes = append(deletes, key)
+				}
+			}
+			return !lastPage
+		})
+		if err != nil {
+			return fmt.Errorf("failed listing COS bucket (%s) objects with prefix (%s): %s", bucketName, prefix, err)
+		}
+	}
+
+	contentTypes := d.Get("content_types").(map[string]interface{})
+	uploaded, err := uploadCOSObjects(s3Client, bucketName, uploads, contentTypes, d.Get("concurrency").(int))
+	etags := d.Get("etags").(map[string]interface{})
+	for key, etag := range uploaded {
+		if etag != "" {
+			etags[key] = etag
+		} else {
+			// The ETag of the listing is taken over on the next read
+			delete(etags, key)
+		}
+	}
+	if err != nil {
+		// The uploaded objects are kept in the state, so that they are
+		// deleted with the resource
+		files := make(map[string]interface{}, len(oldFiles)+len(uploaded))
+		for key, md5 := range oldFiles {
+			files[key] = md5
+		}
+		for key := range uploaded {
+			files[key] = localFiles[key].md5
+		}
+		d.Set("files", files)
+		d.Set("etags", etags)
+		return err
+	}
+
+	files := make(map[string]interface{}, len(localFiles))
+	for key, file := range localFiles {
+		files[key] = file.md5
+	}
+	for key := range etags {
+		if _, ok := files[key]; !ok {
+			delete(etags, key)
+		}
+	}
+	d.Set("etags", etags)
+	if prune {
+		if err := deleteCOSObjects(s3Client, bucketName, deletes); err != nil {
+			return err
+		}
+	} else if len(deletes) > 0 {
+		log.Printf("[INFO] Keeping %d objects of COS bucket (%s) that are no longer in the directory", len(deletes), bucketName)
+	}
+	d.Set("files", files)
+	return nil
+}
+
+// cosSyncContentType returns the content type of a file from its extension
+func cosSyncContentType(key string, contentTypes map[string]interface{}) string {
+	ext := path.Ext(key)
+	if contentType, ok := contentTypes[strings.TrimPrefix(ext, ".")]; ok {
+		return contentType.(string)
+	}
+	if contentType, ok := contentTypes[ext]; ok {
+		return contentType.(string)
+	}
+	return mime.TypeByExtension(ext)
+}
+
+// cosSyncUpload is the result of the upload of a file
+type cosSyncUpload struct {
+	key  string
+	etag string
+	err  error
+}
+
+// uploadCOSObjects uploads the files with a pool of concurrency workers. It
+// returns the ETags of the uploaded objects by key and the first error.
+func uploadCOSObjects(s3Client *s3.S3, bucketName string, files []cosSyncFile, contentTypes map[string]interface{}, concurrency int) (map[string]string, error) {
+	uploader := s3manager.NewUploaderWithClient(s3Client)
+	queue := make(chan cosSyncFile)
+	results := make(chan cosSyncUpload, len(files))
+
+	var wg sync.WaitGroup
+	for i := 0; i < concurrency; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for file := range queue {
+				etag, err := uploadCOSSyncFile(uploader, bucketName, file, cosSyncContentType(file.key, contentTypes))
+				results <- cosSyncUpload{key: file.key, etag: etag, err: err}
+			}
+		}()
+	}
+	for _, file := range files {
+		queue <- file
+	}
+	close(queue)
+	wg.Wait()
+	close(results)
+
+	uploaded := make(map[string]string, len(files))
+	var err error
+	for result := range results {
+		if result.err != nil {
+			if err == nil {
+				err = result.err
+			}
+			continue
+		}
+		uploaded[result.key] = result.etag
+	}
+	log.Printf("[INFO] Uploaded %d objects to COS bucket (%s)", len(uploaded), bucketName)
+	return uploaded, err
+}
+
+func uploadCOSSyncFile(uploader *s3manager.Uploader, bucketName string, file cosSyncFile, contentType string) (string, error) {
+	body, err := os.Open(file.path)
+	if err != nil {
+		return "", fmt.Errorf("error opening COS object file (%s): %s", file.path, err)
+	}
+	defer body.Close()
+
+	input := &s3manager.UploadInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(file.key),
+		Body:   body,
+	}
+	if contentType != "" {
+		input.ContentType = aws.String(contentType)
+	}
+	out, err := uploader.Upload(input)
+	if err != nil {
+		return "", fmt.Errorf("error putting object (%s) in COS bucket (%s): %s", file.key, bucketName, err)
+	}
+	return strings.Trim(aws.StringValue(out.ETag), `"`), nil
+}
+
+// deleteCOSObjects deletes the objects by batches of 1000, the maximum of a
+// request
+func deleteCOSObjects(s3Client *s3.S3, bucketName string, keys []string) error {
+	for start := 0; start < len(keys); start += 1000 {
+		end := start + 1000
+		if end > len(keys) {
+			end = len(keys)
+		}
+		var objects []*s3.ObjectIdentifier
+		for _, key := range keys[start:end] {
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+		out, err := s3Client.DeleteObjects(&s3.DeleteObjectsInput{
+			Bucket: aws.String(bucketName),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("error deleting objects of COS bucket (%s): %s", bucketName, err)
+		}
+		if len(out.Errors) > 0 {
+			return fmt.Errorf("error deleting object (%s) of COS bucket (%s): %s", aws.StringValue(out.Errors[0].Key), bucketName, aws.StringValue(out.Errors[0].Message))
+		}
+	}
+	return nil
+}
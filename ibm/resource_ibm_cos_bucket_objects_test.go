@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSBucketObjects_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-sync-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := cosCRN
+
+	sourceDir, err := ioutil.TempDir("", "cos-sync")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(sourceDir)
+	writeFile := func(name, content string) func() {
+		return func() {
+			os.MkdirAll(filepath.Dir(filepath.Join(sourceDir, name)), 0755)
+			ioutil.WriteFile(filepath.Join(sourceDir, name), []byte(content), 0644)
+		}
+	}
+	writeFile("index.html", "<html>Acceptance Testing</html>")()
+	writeFile("css/site.css", "body {}")()
+	writeFile("notes.tmp", "excluded")()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheckCOS(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectsConfig(name, instanceCRN, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects.testacc", "id"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects.testacc", "files.%", "2"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects.testacc", "files.site/index.html"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects.testacc", "files.site/css/site.css"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects.testacc", "etags.%", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeFile("index.html", "<html>Updated</html>")()
+					writeFile("about.html", "<html>About</html>")()
+					os.Remove(filepath.Join(sourceDir, "css", "site.css"))
+				},
+				Config: testAccIBMCOSBucketObjectsConfig(name, instanceCRN, sourceDir),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_objects.testacc", "files.%", "2"),
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_objects.testacc", "files.site/about.html"),
+					resource.TestCheckNoResourceAttr("ibm_cos_bucket_objects.testacc", "files.site/css/site.css"),
+					resource.TestCheckNoResourceAttr("ibm_cos_bucket_objects.testacc", "etags.site/css/site.css"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketObjectsConfig(name string, instanceCRN string, sourceDir string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_objects" "testacc" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			source_dir      = "%[3]s"
+			prefix          = "site/"
+			exclude         = ["*.tmp"]
+			prune           = true
+		}`, name, instanceCRN, sourceDir)
+}
@@ -0,0 +1,226 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	// ID of the IAM access group that all users, authenticated or not, belong to
+	cosPublicAccessGroupID = "AccessGroupId-PublicAccess"
+)
+
+var cosPublicAccessRoles = map[string]string{
+	"Object Reader":  "crn:v1:bluemix:public:cloud-object-storage::serviceRole:ObjectReader",
+	"Content Reader": "crn:v1:bluemix:public:cloud-object-storage::serviceRole:ContentReader",
+}
+
+func resourceIBMCOSBucketPublicAccess() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCOSBucketPublicAccessCreate,
+		Read:     resourceIBMCOSBucketPublicAccessRead,
+		Update:   resourceIBMCOSBucketPublicAccessUpdate,
+		Delete:   resourceIBMCOSBucketPublicAccessDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "CRN of the COS bucket to make public",
+			},
+			"role": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Object Reader",
+				ValidateFunc: validateAllowedStringValue([]string{"Object Reader", "Content Reader"}),
+				Description:  "Role granted to the public: Object Reader allows to read the objects, Content Reader also allows to list them",
+			},
+			"access_group_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the Public Access group",
+			},
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+// cosBucketCRNParts returns the account, the service instance GUID and the
+// name of a bucket from its CRN
+func cosBucketCRNParts(bucketCRN string) (account, serviceInstance, bucketName string, err error) {
+	parts := strings.Split(bucketCRN, ":")
+	if len(parts) != 10 || parts[4] != "cloud-object-storage" || parts[8] != "bucket" || !strings.HasPrefix(parts[6], "a/") {
+		err = fmt.Errorf("Incorrect bucket CRN %s: CRN should be in the format crn:v1:bluemix:public:cloud-object-storage:global:a/<account>:<instance>:bucket:<bucket>", bucketCRN)
+		return
+	}
+	return strings.TrimPrefix(parts[6], "a/"), parts[7], parts[9], nil
+}
+
+func cosBucketPublicAccessPolicy(d *schema.ResourceData) ([]iampolicymanagementv1.PolicySubject, []iampolicymanagementv1.PolicyRole, []iampolicymanagementv1.PolicyResource, error) {
+	account, serviceInstance, bucketName, err := cosBucketCRNParts(d.Get("bucket_crn").(string))
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	subjects := []iampolicymanagementv1.PolicySubject{
+		{
+			Attributes: []iampolicymanagementv1.SubjectAttribute{
+				{
+					Name:  core.StringPtr("access_group_id"),
+					Value: core.StringPtr(cosPublicAccessGroupID),
+				},
+			},
+		},
+	}
+	roles := []iampolicymanagementv1.PolicyRole{
+		{
+			RoleID: core.StringPtr(cosPublicAccessRoles[d.Get("role").(string)]),
+		},
+	}
+	resources := []iampolicymanagementv1.PolicyResource{
+		{
+			Attributes: []iampolicymanagementv1.ResourceAttribute{
+				{Name: core.StringPtr("accountId"), Value: core.StringPtr(account)},
+				{Name: core.StringPtr("serviceName"), Value: core.StringPtr("cloud-object-storage")},
+				{Name: core.StringPtr("serviceInstance"), Value: core.StringPtr(serviceInstance)},
+				{Name: core.StringPtr("resourceType"), Value: core.StringPtr("bucket")},
+				{Name: core.StringPtr("resource"), Value: core.StringPtr(bucketName)},
+			},
+		},
+	}
+	return subjects, roles, resources, nil
+}
+
+func resourceIBMCOSBucketPublicAccessCreate(d *schema.ResourceData, meta interface{}) error {
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	subjects, roles, resources, err := cosBucketPublicAccessPolicy(d)
+	if err != nil {
+		return err
+	}
+
+	createPolicyOptions := iamPolicyManagementClient.NewCreatePolicyOptions("access", subjects, roles, resources)
+	policy, res, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	if err != nil || policy == nil {
+		return fmt.Errorf("Error enabling public access on COS bucket %s: %s\n%s", d.Get("bucket_crn").(string), err, res)
+	}
+	d.SetId(*policy.ID)
+
+	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(*policy.ID)
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		if err != nil {
+			if res != nil && res.StatusCode == 404 {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+	if isResourceTimeoutError(err) {
+		_, res, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	}
+	if err != nil {
+		return fmt.Errorf("Error fetching public access policy %s: %s\n%s", d.Id(), err, res)
+	}
+
+	return resourceIBMCOSBucketPublicAccessRead(d, meta)
+}
+
+func resourceIBMCOSBucketPublicAccessRead(d *schema.ResourceData, meta interface{}) error {
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(d.Id())
+	policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	if err != nil {
+		if res != nil && res.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving public access policy %s: %s\n%s", d.Id(), err, res)
+	}
+	// A deleted policy is still returned for a while
+	if policy.State != nil && *policy.State == "deleted" {
+		d.SetId("")
+		return nil
+	}
+
+	if len(policy.Subjects) == 0 || *getSubjectAttribute("access_group_id", policy.Subjects[0]) != cosPublicAccessGroupID {
+		return fmt.Errorf("Policy %s does not belong to the Public Access group", d.Id())
+	}
+	if len(policy.Resources) > 0 {
+		bucketCRN := fmt.Sprintf("crn:v1:bluemix:public:cloud-object-storage:global:a/%s:%s:bucket:%s",
+			*getResourceAttribute("accountId", policy.Resources[0]),
+			*getResourceAttribute("serviceInstance", policy.Resources[0]),
+			*getResourceAttribute("resource", policy.Resources[0]))
+		d.Set("bucket_crn", bucketCRN)
+	}
+	for _, role := range policy.Roles {
+		for name, roleID := range cosPublicAccessRoles {
+			if role.RoleID != nil && *role.RoleID == roleID {
+				d.Set("role", name)
+			}
+		}
+	}
+	d.Set("access_group_id", cosPublicAccessGroupID)
+	d.Set("version", res.Headers.Get("ETag"))
+	return nil
+}
+
+func resourceIBMCOSBucketPublicAccessUpdate(d *schema.ResourceData, meta interface{}) error {
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("role") {
+		subjects, roles, resources, err := cosBucketPublicAccessPolicy(d)
+		if err != nil {
+			return err
+		}
+
+		updatePolicyOptions := iamPolicyManagementClient.NewUpdatePolicyOptions(d.Id(), d.Get("version").(string), "access", subjects, roles, resources)
+		_, res, err := iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		if err != nil {
+			return fmt.Errorf("Error updating public access policy %s: %s\n%s", d.Id(), err, res)
+		}
+	}
+
+	return resourceIBMCOSBucketPublicAccessRead(d, meta)
+}
+
+func resourceIBMCOSBucketPublicAccessDelete(d *schema.ResourceData, meta interface{}) error {
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	deletePolicyOptions := iamPolicyManagementClient.NewDeletePolicyOptions(d.Id())
+	res, err := iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	if err != nil && (res == nil || res.StatusCode != 404) {
+		return fmt.Errorf("Error disabling public access with policy %s: %s\n%s", d.Id(), err, res)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMCosBucketPublicAccess_Basic(t *testing.T) {
+	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketPublicAccessDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCosBucketPublicAccessConfig(cosServiceName, bucketName, "Object Reader"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("ibm_cos_bucket_public_access.public", "bucket_crn", "ibm_cos_bucket.bucket", "crn"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_public_access.public", "role", "Object Reader"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_public_access.public", "access_group_id", "AccessGroupId-PublicAccess"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCosBucketPublicAccessConfig(cosServiceName, bucketName, "Content Reader"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_public_access.public", "role", "Content Reader"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_cos_bucket_public_access.public",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCosBucketPublicAccessDestroy(s *terraform.State) error {
+	iamPolicyManagementClient, err := testAccProvider.Meta().(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cos_bucket_public_access" {
+			continue
+		}
+
+		getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(rs.Primary.ID)
+		policy, res, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		if err == nil && (policy.State == nil || *policy.State != "deleted") {
+			return fmt.Errorf("Public access policy still exists: %s", rs.Primary.ID)
+		} else if err != nil && res.StatusCode != 404 {
+			return fmt.Errorf("Error waiting for public access policy (%s) to be destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMCosBucketPublicAccessConfig(cosServiceName string, bucketName string, role string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "us-south"
+		storage_class        = "standard"
+	}
+
+	resource "ibm_cos_bucket_public_access" "public" {
+		bucket_crn = ibm_cos_bucket.bucket.crn
+		role       = "%s"
+	}
+	`, cosServiceName, bucketName, role)
+}
@@ -316,6 +316,168 @@ func TestAccIBMCosBucket_Object_Versioning(t *testing.T) {
 	})
 }
 
+func TestAccIBMCosBucket_Cors_Website(t *testing.T) {
+
+	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-south"
+	bucketClass := "standard"
+	bucketRegionType := "region_location"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCosBucket_cors_website(cosServiceName, bucketName, bucketRegion, bucketClass, "https://www.example.com", "error.html"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "cors_rule.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "cors_rule.0.allowed_origins.0", "https://www.example.com"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "cors_rule.0.allowed_methods.#", "2"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "website.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "website.0.index_document", "index.html"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "website.0.error_document", "error.html"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "website.0.routing_rule.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCosBucket_cors_website(cosServiceName, bucketName, bucketRegion, bucketClass, "*", "404.html"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "cors_rule.0.allowed_origins.0", "*"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "website.0.error_document", "404.html"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCosBucket_cors_website_removed(cosServiceName, bucketName, bucketRegion, bucketClass),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "cors_rule.#", "0"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "website.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMCosBucket_Lifecycle_Rules(t *testing.T) {
+
+	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-south"
+	bucketClass := "standard"
+	bucketRegionType := "region_location"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCosBucket_lifecycle_rules(cosServiceName, bucketName, bucketRegion, bucketClass, 30, 7),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "lifecycle_rule.#", "3"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "lifecycle_rule.0.rule_id", "expire-logs"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "lifecycle_rule.0.prefix", "logs/"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "lifecycle_rule.0.expiration.0.days", "30"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "lifecycle_rule.0.noncurrent_version_expiration.0.noncurrent_days", "7"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "lifecycle_rule.0.abort_incomplete_multipart_upload_days", "3"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "lifecycle_rule.1.expiration.0.expired_object_delete_marker", "true"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "lifecycle_rule.2.transition.0.days", "60"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "lifecycle_rule.2.transition.0.storage_class", "GLACIER"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCosBucket_lifecycle_rules(cosServiceName, bucketName, bucketRegion, bucketClass, 45, 14),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "lifecycle_rule.0.expiration.0.days", "45"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "lifecycle_rule.0.noncurrent_version_expiration.0.noncurrent_days", "14"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCosBucket_object_versioning(cosServiceName, bucketName, bucketRegionType, bucketRegion, bucketClass, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "lifecycle_rule.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMCosBucket_Lifecycle_Rules_With_Archive_Expire(t *testing.T) {
+
+	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-south"
+	bucketClass := "standard"
+	bucketRegionType := "region_location"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCosBucket_lifecycle_rules_archive_expire(cosServiceName, bucketName, bucketRegion, bucketClass),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "archive_rule.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "archive_rule.0.rule_id", "archive-all"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "expire_rule.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "expire_rule.0.rule_id", "expire-tmp"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "lifecycle_rule.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "lifecycle_rule.0.rule_id", "abort-uploads"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_cos_bucket.bucket",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"wait_time_minutes", "parameters", "force_delete"},
+			},
+		},
+	})
+}
+
+func TestAccIBMCosBucket_Replication_ObjectLock(t *testing.T) {
+
+	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-south"
+	bucketClass := "standard"
+	bucketRegionType := "region_location"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCosBucket_replication_object_lock(cosServiceName, bucketName, bucketRegion, bucketClass, "logs/", 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "replication_rule.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "replication_rule.0.prefix", "logs/"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "replication_rule.0.delete_marker_replication", "true"),
+					resource.TestCheckResourceAttrPair("ibm_cos_bucket.bucket", "replication_rule.0.destination_bucket_crn", "ibm_cos_bucket.destination", "crn"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "object_lock_configuration.0.enable", "true"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "object_lock_configuration.0.default_retention.0.mode", "COMPLIANCE"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "object_lock_configuration.0.default_retention.0.days", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCosBucket_replication_object_lock(cosServiceName, bucketName, bucketRegion, bucketClass, "data/", 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "replication_rule.0.prefix", "data/"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "object_lock_configuration.0.default_retention.0.days", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMCosBucket_Smart_Type(t *testing.T) {
 	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
 	bucketName := fmt.Sprintf("terraform%d", acctest.RandIntRange(10, 100))
@@ -961,3 +1123,214 @@ func testAccCheckIBMCosBucket_object_versioning(cosServiceName string, bucketNam
 	}
 	`, cosServiceName, bucketName, region, storageClass)
 }
+
+func testAccCheckIBMCosBucket_cors_website(cosServiceName string, bucketName string, region string, storageClass string, origin string, errorDocument string) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "%s"
+		storage_class        = "%s"
+		cors_rule {
+			allowed_origins = ["%s"]
+			allowed_methods = ["GET", "HEAD"]
+			allowed_headers = ["*"]
+			max_age_seconds = 3000
+		}
+		website {
+			index_document = "index.html"
+			error_document = "%s"
+			routing_rule {
+				condition {
+					key_prefix_equals = "docs/"
+				}
+				redirect {
+					replace_key_prefix_with = "documents/"
+				}
+			}
+		}
+	}
+	`, cosServiceName, bucketName, region, storageClass, origin, errorDocument)
+}
+
+func testAccCheckIBMCosBucket_cors_website_removed(cosServiceName string, bucketName string, region string, storageClass string) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "%s"
+		storage_class        = "%s"
+	}
+	`, cosServiceName, bucketName, region, storageClass)
+}
+
+func testAccCheckIBMCosBucket_lifecycle_rules(cosServiceName string, bucketName string, region string, storageClass string, expireDays int, noncurrentDays int) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "%s"
+		storage_class        = "%s"
+		object_versioning {
+			enable = true
+		}
+		lifecycle_rule {
+			rule_id = "expire-logs"
+			prefix  = "logs/"
+			expiration {
+				days = %d
+			}
+			noncurrent_version_expiration {
+				noncurrent_days = %d
+			}
+			abort_incomplete_multipart_upload_days = 3
+		}
+		lifecycle_rule {
+			rule_id = "clean-delete-markers"
+			expiration {
+				expired_object_delete_marker = true
+			}
+		}
+		lifecycle_rule {
+			rule_id = "archive-backups"
+			prefix  = "backups/"
+			transition {
+				days          = 60
+				storage_class = "GLACIER"
+			}
+		}
+	}
+	`, cosServiceName, bucketName, region, storageClass, expireDays, noncurrentDays)
+}
+
+func testAccCheckIBMCosBucket_lifecycle_rules_archive_expire(cosServiceName string, bucketName string, region string, storageClass string) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "%s"
+		storage_class        = "%s"
+		archive_rule {
+			rule_id = "archive-all"
+			enable  = true
+			days    = 30
+			type    = "GLACIER"
+		}
+		expire_rule {
+			rule_id = "expire-tmp"
+			enable  = true
+			days    = 7
+			prefix  = "tmp/"
+		}
+		lifecycle_rule {
+			rule_id                                = "abort-uploads"
+			abort_incomplete_multipart_upload_days = 3
+		}
+	}
+	`, cosServiceName, bucketName, region, storageClass)
+}
+
+func testAccCheckIBMCosBucket_replication_object_lock(cosServiceName string, bucketName string, region string, storageClass string, prefix string, retentionDays int) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%[1]s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+	resource "ibm_iam_authorization_policy" "replication" {
+		source_service_name         = "cloud-object-storage"
+		source_resource_instance_id = ibm_resource_instance.instance.guid
+		target_service_name         = "cloud-object-storage"
+		target_resource_instance_id = ibm_resource_instance.instance.guid
+		roles                       = ["Writer"]
+	}
+	resource "ibm_cos_bucket" "destination" {
+		bucket_name          = "%[2]s-replica"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "%[3]s"
+		storage_class        = "%[4]s"
+		object_versioning {
+			enable = true
+		}
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%[2]s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "%[3]s"
+		storage_class        = "%[4]s"
+		object_versioning {
+			enable = true
+		}
+		replication_rule {
+			rule_id                   = "replicate"
+			priority                  = 1
+			prefix                    = "%[5]s"
+			destination_bucket_crn    = ibm_cos_bucket.destination.crn
+			delete_marker_replication = true
+		}
+		object_lock_configuration {
+			enable = true
+			default_retention {
+				mode = "COMPLIANCE"
+				days = %[6]d
+			}
+		}
+		depends_on = [ibm_iam_authorization_policy.replication]
+	}
+	`, cosServiceName, bucketName, region, storageClass, prefix, retentionDays)
+}
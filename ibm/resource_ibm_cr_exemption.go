@@ -0,0 +1,195 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/container-registry-go-sdk/vulnerabilityadvisorv3"
+)
+
+func resourceIBMCrExemption() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCrExemptionCreate,
+		ReadContext:   resourceIBMCrExemptionRead,
+		DeleteContext: resourceIBMCrExemptionDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"resource": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The scope of the exemption, as a namespace (<REGISTRY>/<NAMESPACE>), repository (<REGISTRY>/<NAMESPACE>/<REPOSITORY>) or image (<REGISTRY>/<NAMESPACE>/<REPOSITORY>:<TAG>). If not set, the exemption applies to the whole account.",
+			},
+			"issue_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"cve", "sn", "configuration"}),
+				Description:  "The type of the exempted issue: cve, sn or configuration.",
+			},
+			"issue_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the exempted issue, such as CVE-2021-12345.",
+			},
+			"account_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IBM Cloud account that owns the exemption.",
+			},
+			"scope_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of scope the exemption applies to: account, namespace, repository or image.",
+			},
+		},
+	}
+}
+
+func resourceIBMCrExemptionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vulnerabilityAdvisorClient, err := meta.(ClientSession).VulnerabilityAdvisorV3()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	issueType := d.Get("issue_type").(string)
+	issueID := d.Get("issue_id").(string)
+	resource := d.Get("resource").(string)
+
+	if resource == "" {
+		createExemptionAccountOptions := vulnerabilityAdvisorClient.NewCreateExemptionAccountOptions(issueType, issueID)
+		_, response, err := vulnerabilityAdvisorClient.CreateExemptionAccountWithContext(context, createExemptionAccountOptions)
+		if err != nil {
+			log.Printf("[DEBUG] CreateExemptionAccountWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+		d.SetId(fmt.Sprintf("%s/%s", issueType, issueID))
+	} else {
+		createExemptionResourceOptions := vulnerabilityAdvisorClient.NewCreateExemptionResourceOptions(resource, issueType, issueID)
+		_, response, err := vulnerabilityAdvisorClient.CreateExemptionResourceWithContext(context, createExemptionResourceOptions)
+		if err != nil {
+			log.Printf("[DEBUG] CreateExemptionResourceWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+		d.SetId(fmt.Sprintf("%s/%s/%s", issueType, issueID, resource))
+	}
+
+	return resourceIBMCrExemptionRead(context, d, meta)
+}
+
+func resourceIBMCrExemptionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vulnerabilityAdvisorClient, err := meta.(ClientSession).VulnerabilityAdvisorV3()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	issueType, issueID, resource, err := crExemptionIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var exemption *vulnerabilityadvisorv3.Exemption
+	if resource == "" {
+		getExemptionAccountOptions := vulnerabilityAdvisorClient.NewGetExemptionAccountOptions(issueType, issueID)
+		result, response, err := vulnerabilityAdvisorClient.GetExemptionAccountWithContext(context, getExemptionAccountOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+			log.Printf("[DEBUG] GetExemptionAccountWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+		exemption = result
+	} else {
+		getExemptionResourceOptions := vulnerabilityAdvisorClient.NewGetExemptionResourceOptions(resource, issueType, issueID)
+		result, response, err := vulnerabilityAdvisorClient.GetExemptionResourceWithContext(context, getExemptionResourceOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				d.SetId("")
+				return nil
+			}
+			log.Printf("[DEBUG] GetExemptionResourceWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+		exemption = result
+	}
+
+	if err = d.Set("resource", resource); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting resource: %s", err))
+	}
+	if err = d.Set("issue_type", exemption.IssueType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting issue_type: %s", err))
+	}
+	if err = d.Set("issue_id", exemption.IssueID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting issue_id: %s", err))
+	}
+	if err = d.Set("account_id", exemption.AccountID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting account_id: %s", err))
+	}
+	if exemption.Scope != nil {
+		if err = d.Set("scope_type", exemption.Scope.ScopeType); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting scope_type: %s", err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMCrExemptionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vulnerabilityAdvisorClient, err := meta.(ClientSession).VulnerabilityAdvisorV3()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	issueType, issueID, resource, err := crExemptionIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if resource == "" {
+		deleteExemptionAccountOptions := vulnerabilityAdvisorClient.NewDeleteExemptionAccountOptions(issueType, issueID)
+		response, err := vulnerabilityAdvisorClient.DeleteExemptionAccountWithContext(context, deleteExemptionAccountOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("[DEBUG] DeleteExemptionAccountWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+	} else {
+		deleteExemptionResourceOptions := vulnerabilityAdvisorClient.NewDeleteExemptionResourceOptions(resource, issueType, issueID)
+		response, err := vulnerabilityAdvisorClient.DeleteExemptionResourceWithContext(context, deleteExemptionResourceOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("[DEBUG] DeleteExemptionResourceWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// crExemptionIDParts splits the ID issueType/issueID[/resource] of an exemption.
+// The resource is last because it contains slashes itself.
+func crExemptionIDParts(id string) (issueType, issueID, resource string, err error) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) < 2 {
+		err = fmt.Errorf("Incorrect ID %s: ID should be a combination of issueType/issueID or issueType/issueID/resource", id)
+		return
+	}
+	issueType = parts[0]
+	issueID = parts[1]
+	if len(parts) == 3 {
+		resource = parts[2]
+	}
+	return
+}
@@ -0,0 +1,84 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMCrExemptionBasic(t *testing.T) {
+	namespace := fmt.Sprintf("tf_namespace_%d", acctest.RandIntRange(10, 100))
+	issueID := fmt.Sprintf("CVE-2021-%d", acctest.RandIntRange(1000, 9999))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCrExemptionDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCrExemptionConfig(namespace, issueID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cr_exemption.cr_exemption", "issue_type", "cve"),
+					resource.TestCheckResourceAttr("ibm_cr_exemption.cr_exemption", "issue_id", issueID),
+					resource.TestCheckResourceAttr("ibm_cr_exemption.cr_exemption", "scope_type", "namespace"),
+					resource.TestCheckResourceAttrSet("ibm_cr_exemption.cr_exemption", "account_id"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_cr_exemption.cr_exemption",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCrExemptionConfig(namespace string, issueID string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_cr_namespace" "cr_namespace" {
+			name = "%s"
+		}
+
+		resource "ibm_cr_exemption" "cr_exemption" {
+			resource   = "us.icr.io/${ibm_cr_namespace.cr_namespace.name}"
+			issue_type = "cve"
+			issue_id   = "%s"
+		}
+	`, namespace, issueID)
+}
+
+func testAccCheckIBMCrExemptionDestroy(s *terraform.State) error {
+	vulnerabilityAdvisorClient, err := testAccProvider.Meta().(ClientSession).VulnerabilityAdvisorV3()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cr_exemption" {
+			continue
+		}
+
+		issueType, issueID, resource, err := crExemptionIDParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		getExemptionResourceOptions := vulnerabilityAdvisorClient.NewGetExemptionResourceOptions(resource, issueType, issueID)
+
+		_, response, err := vulnerabilityAdvisorClient.GetExemptionResource(getExemptionResourceOptions)
+
+		if err == nil {
+			return fmt.Errorf("Exemption %s still exists", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("Error checking for exemption (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,133 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+)
+
+func resourceIBMCrImageTag() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCrImageTagCreate,
+		ReadContext:   resourceIBMCrImageTagRead,
+		DeleteContext: resourceIBMCrImageTagDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"from_image": &schema.Schema{
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				// The source image is not returned by the API, it's unknown after an import
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return old == "" && d.Id() != ""
+				},
+				Description: "The name of the image that is to be tagged, in the format <REGISTRY>/<NAMESPACE>/<REPOSITORY>:<TAG> or <REGISTRY>/<NAMESPACE>/<REPOSITORY>@<DIGEST>.",
+			},
+			"to_image": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The new tag for the image, in the format <REGISTRY>/<NAMESPACE>/<REPOSITORY>:<TAG>. The namespace can be different from the namespace of from_image.",
+			},
+			"image_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the image configuration that the tag references.",
+			},
+			"manifest_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the image manifest.",
+			},
+		},
+	}
+}
+
+func resourceIBMCrImageTagCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tagImageOptions := &containerregistryv1.TagImageOptions{}
+
+	tagImageOptions.SetFromimage(d.Get("from_image").(string))
+	tagImageOptions.SetToimage(d.Get("to_image").(string))
+
+	response, err := containerRegistryClient.TagImageWithContext(context, tagImageOptions)
+	if err != nil {
+		log.Printf("[DEBUG] TagImageWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(d.Get("to_image").(string))
+
+	return resourceIBMCrImageTagRead(context, d, meta)
+}
+
+func resourceIBMCrImageTagRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	inspectImageOptions := &containerregistryv1.InspectImageOptions{}
+
+	inspectImageOptions.SetImage(d.Id())
+
+	imageInspection, response, err := containerRegistryClient.InspectImageWithContext(context, inspectImageOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] InspectImageWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("to_image", d.Id()); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting to_image: %s", err))
+	}
+	if err = d.Set("image_id", imageInspection.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting image_id: %s", err))
+	}
+	if err = d.Set("manifest_type", imageInspection.ManifestType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting manifest_type: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMCrImageTagDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteImageTagOptions := &containerregistryv1.DeleteImageTagOptions{}
+
+	deleteImageTagOptions.SetImage(d.Id())
+
+	_, response, err := containerRegistryClient.DeleteImageTagWithContext(context, deleteImageTagOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] DeleteImageTagWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
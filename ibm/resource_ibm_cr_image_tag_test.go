@@ -0,0 +1,85 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+)
+
+func TestAccIBMCrImageTagBasic(t *testing.T) {
+	namespace := fmt.Sprintf("tf_namespace_%d", acctest.RandIntRange(10, 100))
+	registry := strings.SplitN(crImage, "/", 2)[0]
+	toImage := fmt.Sprintf("%s/%s/promoted:%d", registry, namespace, acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMCrImageTagDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCrImageTagConfig(namespace, crImage, toImage),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cr_image_tag.cr_image_tag", "from_image", crImage),
+					resource.TestCheckResourceAttr("ibm_cr_image_tag.cr_image_tag", "to_image", toImage),
+					resource.TestCheckResourceAttrSet("ibm_cr_image_tag.cr_image_tag", "image_id"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "ibm_cr_image_tag.cr_image_tag",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"from_image"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCrImageTagConfig(namespace string, fromImage string, toImage string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_cr_namespace" "cr_namespace" {
+			name = "%s"
+		}
+
+		resource "ibm_cr_image_tag" "cr_image_tag" {
+			from_image = "%s"
+			to_image   = "%s"
+			depends_on = [ibm_cr_namespace.cr_namespace]
+		}
+	`, namespace, fromImage, toImage)
+}
+
+func testAccCheckIBMCrImageTagDestroy(s *terraform.State) error {
+	containerRegistryClient, err := testAccProvider.Meta().(ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cr_image_tag" {
+			continue
+		}
+
+		inspectImageOptions := &containerregistryv1.InspectImageOptions{}
+
+		inspectImageOptions.SetImage(rs.Primary.ID)
+
+		_, response, err := containerRegistryClient.InspectImage(inspectImageOptions)
+
+		if err == nil {
+			return fmt.Errorf("Image tag %s still exists", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("Error checking for image tag (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
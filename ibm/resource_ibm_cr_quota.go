@@ -0,0 +1,217 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/container-registry-go-sdk/containerregistryv1"
+)
+
+func resourceIBMCrQuota() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCrQuotaCreate,
+		ReadContext:   resourceIBMCrQuotaRead,
+		UpdateContext: resourceIBMCrQuotaUpdate,
+		DeleteContext: resourceIBMCrQuotaDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"plan": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"free", "standard"}),
+				Description:  "The registry service plan of the account: free or standard.",
+			},
+			"storage_megabytes": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     -1,
+				Description: "Storage quota of the account in megabytes. The value -1 denotes 'Unlimited'.",
+			},
+			"traffic_megabytes": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     -1,
+				Description: "Monthly pull traffic quota of the account in megabytes. The value -1 denotes 'Unlimited'.",
+			},
+			"storage_limit_bytes": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The effective storage limit of the account in bytes.",
+			},
+			"traffic_limit_bytes": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The effective monthly pull traffic limit of the account in bytes.",
+			},
+			"storage_usage_bytes": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The storage used by the account in bytes.",
+			},
+			"traffic_usage_bytes": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The pull traffic used by the account in the current month in bytes.",
+			},
+		},
+	}
+}
+
+func resourceIBMCrQuotaCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	userDetails, err := meta.(ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The plan is upgraded first, the free plan does not allow quotas above its limits
+	if v, ok := d.GetOk("plan"); ok {
+		updatePlansOptions := &containerregistryv1.UpdatePlansOptions{}
+		updatePlansOptions.SetPlan(v.(string))
+
+		response, err := containerRegistryClient.UpdatePlansWithContext(context, updatePlansOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdatePlansWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+	}
+
+	updateQuotaOptions := &containerregistryv1.UpdateQuotaOptions{}
+
+	updateQuotaOptions.SetStorageMegabytes(int64(d.Get("storage_megabytes").(int)))
+	updateQuotaOptions.SetTrafficMegabytes(int64(d.Get("traffic_megabytes").(int)))
+
+	response, err := containerRegistryClient.UpdateQuotaWithContext(context, updateQuotaOptions)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateQuotaWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(userDetails.userAccount)
+
+	return resourceIBMCrQuotaRead(context, d, meta)
+}
+
+func resourceIBMCrQuotaRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	plan, response, err := containerRegistryClient.GetPlansWithContext(context, &containerregistryv1.GetPlansOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] GetPlansWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	quota, response, err := containerRegistryClient.GetQuotaWithContext(context, &containerregistryv1.GetQuotaOptions{})
+	if err != nil {
+		log.Printf("[DEBUG] GetQuotaWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	if err = d.Set("plan", plan.Plan); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting plan: %s", err))
+	}
+	if quota.Limit != nil {
+		if err = d.Set("storage_megabytes", crQuotaMegabytes(quota.Limit.StorageBytes)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting storage_megabytes: %s", err))
+		}
+		if err = d.Set("traffic_megabytes", crQuotaMegabytes(quota.Limit.TrafficBytes)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting traffic_megabytes: %s", err))
+		}
+		if err = d.Set("storage_limit_bytes", intValue(quota.Limit.StorageBytes)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting storage_limit_bytes: %s", err))
+		}
+		if err = d.Set("traffic_limit_bytes", intValue(quota.Limit.TrafficBytes)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting traffic_limit_bytes: %s", err))
+		}
+	}
+	if quota.Usage != nil {
+		if err = d.Set("storage_usage_bytes", intValue(quota.Usage.StorageBytes)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting storage_usage_bytes: %s", err))
+		}
+		if err = d.Set("traffic_usage_bytes", intValue(quota.Usage.TrafficBytes)); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting traffic_usage_bytes: %s", err))
+		}
+	}
+
+	return nil
+}
+
+// crQuotaMegabytes returns the quota in megabytes of a quota in bytes, the
+// value -1 denotes 'Unlimited'
+func crQuotaMegabytes(bytes *int64) int {
+	if bytes == nil || *bytes < 0 {
+		return -1
+	}
+	return int(*bytes / (1024 * 1024))
+}
+
+func resourceIBMCrQuotaUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("plan") {
+		updatePlansOptions := &containerregistryv1.UpdatePlansOptions{}
+		updatePlansOptions.SetPlan(d.Get("plan").(string))
+
+		response, err := containerRegistryClient.UpdatePlansWithContext(context, updatePlansOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdatePlansWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChanges("storage_megabytes", "traffic_megabytes") {
+		updateQuotaOptions := &containerregistryv1.UpdateQuotaOptions{}
+
+		updateQuotaOptions.SetStorageMegabytes(int64(d.Get("storage_megabytes").(int)))
+		updateQuotaOptions.SetTrafficMegabytes(int64(d.Get("traffic_megabytes").(int)))
+
+		response, err := containerRegistryClient.UpdateQuotaWithContext(context, updateQuotaOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateQuotaWithContext failed %s\n%s", err, response)
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMCrQuotaRead(context, d, meta)
+}
+
+func resourceIBMCrQuotaDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	containerRegistryClient, err := meta.(ClientSession).ContainerRegistryV1()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The plan is left as it is, only the quotas are reset to 'Unlimited'
+	updateQuotaOptions := &containerregistryv1.UpdateQuotaOptions{}
+
+	updateQuotaOptions.SetStorageMegabytes(-1)
+	updateQuotaOptions.SetTrafficMegabytes(-1)
+
+	response, err := containerRegistryClient.UpdateQuotaWithContext(context, updateQuotaOptions)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateQuotaWithContext failed %s\n%s", err, response)
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
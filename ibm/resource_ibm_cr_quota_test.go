@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCrQuotaBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCrQuotaConfig(500, 5000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cr_quota.cr_quota", "plan"),
+					resource.TestCheckResourceAttr("ibm_cr_quota.cr_quota", "storage_megabytes", "500"),
+					resource.TestCheckResourceAttr("ibm_cr_quota.cr_quota", "traffic_megabytes", "5000"),
+					resource.TestCheckResourceAttrSet("ibm_cr_quota.cr_quota", "storage_usage_bytes"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCrQuotaConfig(-1, 1000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cr_quota.cr_quota", "storage_megabytes", "-1"),
+					resource.TestCheckResourceAttr("ibm_cr_quota.cr_quota", "traffic_megabytes", "1000"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCrQuotaConfig(storageMegabytes int, trafficMegabytes int) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_cr_quota" "cr_quota" {
+			storage_megabytes = %d
+			traffic_megabytes = %d
+		}
+	`, storageMegabytes, trafficMegabytes)
+}
@@ -0,0 +1,572 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"strings"
+	"time"
+
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const hpcsServiceName = "hs-crypto"
+
+func resourceIBMHPCS() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMHPCSCreate,
+		Read:     resourceIBMHPCSRead,
+		Update:   resourceIBMHPCSUpdate,
+		Delete:   resourceIBMHPCSDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the instance",
+			},
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The location of the instance",
+			},
+			"plan": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "standard",
+				Description: "The pricing plan of the instance",
+			},
+			"units": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_hpcs", "units"),
+				Description:  "The number of operational crypto units of the instance",
+			},
+			"failover_units": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_hpcs", "failover_units"),
+				Description:  "The number of failover crypto units of the instance",
+			},
+			"service_endpoints": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_hpcs", "service_endpoints"),
+				Description:  "Types of the service endpoints. Possible values are 'public-and-private', 'private-only'.",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "The resource group id",
+			},
+			"tags": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString, ValidateFunc: InvokeValidator("ibm_hpcs", "tag")},
+				Set:      resourceIBMVPCHash,
+			},
+			"signature_threshold": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: InvokeValidator("ibm_hpcs", "signature_threshold"),
+				Description:  "The number of administrator signatures that are required to execute administrative commands of the crypto units",
+			},
+			"revocation_threshold": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: InvokeValidator("ibm_hpcs", "revocation_threshold"),
+				Description:  "The number of administrator signatures that are required to remove an administrator from the crypto units",
+			},
+			"admins": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				MaxItems:    hpcsMaxAdmins,
+				Description: "The administrators of the crypto units",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the administrator",
+						},
+						"key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The path of the signature key file of the administrator",
+						},
+						"token": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Sensitive:   true,
+							Description: "The password of the encrypted signature key file",
+						},
+					},
+				},
+			},
+			"master_key_parts": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    2,
+				MaxItems:    3,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The paths of the files of the base64 encoded master key parts",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CRN of the instance",
+			},
+			"guid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The GUID of the instance",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the instance",
+			},
+			"hsm_info": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The status of the crypto units of the instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hsm_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the crypto unit",
+						},
+						"hsm_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the crypto unit, operational or recovery",
+						},
+						"hsm_location": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The location of the crypto unit",
+						},
+						"signature_threshold": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The signature threshold of the crypto unit",
+						},
+						"revocation_threshold": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The revocation threshold of the crypto unit",
+						},
+						"admins": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The administrators of the crypto unit",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the administrator",
+									},
+									"ski": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The subject key identifier of the signature key of the administrator",
+									},
+								},
+							},
+						},
+						"current_mk_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the current master key register",
+						},
+						"current_mkvp": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The verification pattern of the current master key",
+						},
+						"new_mk_status": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The status of the new master key register",
+						},
+						"new_mkvp": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The verification pattern of the new master key",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMHPCSValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "units",
+			ValidateFunctionIdentifier: IntBetween,
+			Type:                       TypeInt,
+			Required:                   true,
+			MinValue:                   "2",
+			MaxValue:                   "3",
+		},
+		ValidateSchema{
+			Identifier:                 "failover_units",
+			ValidateFunctionIdentifier: IntBetween,
+			Type:                       TypeInt,
+			Optional:                   true,
+			MinValue:                   "0",
+			MaxValue:                   "3",
+		},
+		ValidateSchema{
+			Identifier:                 "service_endpoints",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "public-and-private, private-only",
+		},
+		ValidateSchema{
+			Identifier:                 "signature_threshold",
+			ValidateFunctionIdentifier: IntBetween,
+			Type:                       TypeInt,
+			Required:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "8",
+		},
+		ValidateSchema{
+			Identifier:                 "revocation_threshold",
+			ValidateFunctionIdentifier: IntBetween,
+			Type:                       TypeInt,
+			Required:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "8",
+		},
+		ValidateSchema{
+			Identifier:                 "tag",
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Optional:                   true,
+			Regexp:                     `^[A-Za-z0-9:_ .-]+$`,
+			MinValueLength:             1,
+			MaxValueLength:             128,
+		},
+	)
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_hpcs", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMHPCSCreate(d *schema.ResourceData, meta interface{}) error {
+	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	// The signature keys and the master key parts are read before the
+	// instance is provisioned
+	config, err := expandHPCSConfig(d)
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("name").(string)
+	plan := d.Get("plan").(string)
+	location := d.Get("location").(string)
+	rsInst := rc.CreateResourceInstanceOptions{
+		Name: &name,
+	}
+
+	rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
+	if err != nil {
+		return err
+	}
+	rsCatRepo := rsCatClient.ResourceCatalog()
+
+	serviceOff, err := rsCatRepo.FindByName(hpcsServiceName, true)
+	if err != nil {
+		return fmt.Errorf("Error retrieving service offering: %s", err)
+	}
+	servicePlan, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan)
+	if err != nil {
+		return fmt.Errorf("Error retrieving plan: %s", err)
+	}
+	rsInst.ResourcePlanID = &servicePlan
+
+	deployments, err := rsCatRepo.ListDeployments(servicePlan)
+	if err != nil {
+		return fmt.Errorf("Error retrieving deployment for plan %s : %s", plan, err)
+	}
+	deployments, _ = filterDeployments(deployments, location)
+	if len(deployments) == 0 {
+		return fmt.Errorf("No deployment found for service plan %s at location %s", plan, location)
+	}
+	rsInst.Target = &deployments[0].CatalogCRN
+
+	if rsGrpID, ok := d.GetOk("resource_group_id"); ok {
+		rg := rsGrpID.(string)
+		rsInst.ResourceGroup = &rg
+	} else {
+		defaultRg, err := defaultResourceGroup(meta)
+		if err != nil {
+			return err
+		}
+		rsInst.ResourceGroup = &defaultRg
+	}
+
+	params := map[string]interface{}{
+		"units": d.Get("units").(int),
+	}
+	if failoverUnits, ok := d.GetOk("failover_units"); ok {
+		params["failover_units"] = failoverUnits.(int)
+	}
+	if serviceEndpoints, ok := d.GetOk("service_endpoints"); ok {
+		params["allowed_network"] = serviceEndpoints.(string)
+	}
+	rsInst.Parameters = params
+
+	instance, resp, err := rsConClient.CreateResourceInstance(&rsInst)
+	if err != nil {
+		return fmt.Errorf("Error when creating hpcs instance: %s with resp code: %s", err, resp)
+	}
+	d.SetId(*instance.ID)
+
+	_, err = waitForResourceInstanceCreate(d, meta)
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for create hpcs instance (%s) to be succeeded: %s", d.Id(), err)
+	}
+
+	v := os.Getenv("IC_ENV_TAGS")
+	if _, ok := d.GetOk("tags"); ok || v != "" {
+		oldList, newList := d.GetChange("tags")
+		err = UpdateTagsUsingCRN(oldList, newList, meta, *instance.CRN)
+		if err != nil {
+			log.Printf(
+				"Error on create of hpcs instance (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	tkeAPI, err := hpcsTkeClient(meta, location)
+	if err != nil {
+		return err
+	}
+	if err := hpcsInitCryptoUnits(tkeAPI, *instance.GUID, config); err != nil {
+		return err
+	}
+
+	return resourceIBMHPCSRead(d, meta)
+}
+
+func resourceIBMHPCSRead(d *schema.ResourceData, meta interface{}) error {
+	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+
+	instanceID := d.Id()
+	instance, resp, err := rsConClient.GetResourceInstance(&rc.GetResourceInstanceOptions{
+		ID: &instanceID,
+	})
+	if err != nil {
+		if resp != nil && resp.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving hpcs instance: %s with resp code: %s", err, resp)
+	}
+	if strings.Contains(*instance.State, rsInstanceRemovedStatus) || strings.Contains(*instance.State, rsInstanceReclamation) {
+		log.Printf("[WARN] Removing hpcs instance %s from state because it's in removed or pending_reclamation state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	tags, err := GetTagsUsingCRN(meta, *instance.CRN)
+	if err != nil {
+		log.Printf(
+			"Error on get of hpcs instance tags (%s) tags: %s", d.Id(), err)
+	}
+	d.Set("tags", tags)
+	d.Set("name", instance.Name)
+	d.Set("status", instance.State)
+	d.Set("resource_group_id", instance.ResourceGroupID)
+	d.Set("crn", instance.CRN)
+	d.Set("guid", instance.GUID)
+	location := ""
+	if crnData := strings.Split(*instance.CRN, ":"); len(crnData) > 5 {
+		location = crnData[5]
+		d.Set("location", location)
+	}
+	if instance.Parameters != nil {
+		if units, ok := instance.Parameters["units"].(float64); ok {
+			d.Set("units", int(units))
+		}
+		if failoverUnits, ok := instance.Parameters["failover_units"].(float64); ok {
+			d.Set("failover_units", int(failoverUnits))
+		}
+		if allowedNetwork, ok := instance.Parameters["allowed_network"]; ok {
+			d.Set("service_endpoints", allowedNetwork)
+		}
+	}
+
+	rsCatClient, err := meta.(ClientSession).ResourceCatalogAPI()
+	if err != nil {
+		return err
+	}
+	servicePlan, err := rsCatClient.ResourceCatalog().GetServicePlanName(*instance.ResourcePlanID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving plan: %s", err)
+	}
+	d.Set("plan", servicePlan)
+
+	tkeAPI, err := hpcsTkeClient(meta, location)
+	if err != nil {
+		return err
+	}
+	units, err := tkeAPI.cryptoUnits(*instance.GUID)
+	if err != nil {
+		return fmt.Errorf("Error retrieving the crypto units of hpcs instance %s: %s", d.Id(), err)
+	}
+	d.Set("hsm_info", flattenHpcsCryptoUnits(units))
+
+	return nil
+}
+
+func resourceIBMHPCSUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("name") {
+		rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
+		if err != nil {
+			return err
+		}
+		instanceID := d.Id()
+		name := d.Get("name").(string)
+		_, resp, err := rsConClient.UpdateResourceInstance(&rc.UpdateResourceInstanceOptions{
+			ID:   &instanceID,
+			Name: &name,
+		})
+		if err != nil {
+			return fmt.Errorf("Error updating hpcs instance: %s with resp code: %s", err, resp)
+		}
+		_, err = waitForResourceInstanceUpdate(d, meta)
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for update hpcs instance (%s) to be succeeded: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("tags") {
+		oldList, newList := d.GetChange("tags")
+		err := UpdateTagsUsingCRN(oldList, newList, meta, d.Get("crn").(string))
+		if err != nil {
+			log.Printf(
+				"Error on update of hpcs instance (%s) tags: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChanges("signature_threshold", "revocation_threshold", "admins", "master_key_parts") {
+		config, err := expandHPCSConfig(d)
+		if err != nil {
+			return err
+		}
+		tkeAPI, err := hpcsTkeClient(meta, d.Get("location").(string))
+		if err != nil {
+			return err
+		}
+		if err := hpcsInitCryptoUnits(tkeAPI, d.Get("guid").(string), config); err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMHPCSRead(d, meta)
+}
+
+func resourceIBMHPCSDelete(d *schema.ResourceData, meta interface{}) error {
+	// The crypto units are zeroized before the instance is deleted, the
+	// master key and the keys that it protects are not recoverable
+	config, err := expandHPCSConfig(d)
+	if err != nil {
+		return err
+	}
+	tkeAPI, err := hpcsTkeClient(meta, d.Get("location").(string))
+	if err != nil {
+		return err
+	}
+	if err := hpcsZeroizeCryptoUnits(tkeAPI, d.Get("guid").(string), config.Admins); err != nil {
+		return err
+	}
+
+	rsConClient, err := meta.(ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return err
+	}
+	id := d.Id()
+	recursive := true
+	resp, err := rsConClient.DeleteResourceInstance(&rc.DeleteResourceInstanceOptions{
+		ID:        &id,
+		Recursive: &recursive,
+	})
+	if err != nil {
+		return fmt.Errorf("Error deleting hpcs instance: %s with resp code: %s", err, resp)
+	}
+
+	_, err = waitForResourceInstanceDelete(d, meta)
+	if err != nil {
+		return fmt.Errorf(
+			"Error waiting for hpcs instance (%s) to be deleted: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// expandHPCSConfig reads the signature keys of the administrators and the
+// master key parts of the local files
+func expandHPCSConfig(d *schema.ResourceData) (hpcsConfig, error) {
+	config := hpcsConfig{
+		SignatureThreshold:  d.Get("signature_threshold").(int),
+		RevocationThreshold: d.Get("revocation_threshold").(int),
+	}
+	for _, a := range d.Get("admins").([]interface{}) {
+		admin := a.(map[string]interface{})
+		key, err := readHpcsSignatureKey(admin["name"].(string), admin["key"].(string), admin["token"].(string))
+		if err != nil {
+			return config, err
+		}
+		config.Admins = append(config.Admins, key)
+	}
+	if config.SignatureThreshold > len(config.Admins) || config.RevocationThreshold > len(config.Admins) {
+		return config, fmt.Errorf("The signature and revocation thresholds cannot exceed the number of administrators (%d)", len(config.Admins))
+	}
+	for _, path := range d.Get("master_key_parts").([]interface{}) {
+		part, err := readHpcsMasterKeyPart(path.(string))
+		if err != nil {
+			return config, err
+		}
+		config.MasterKeyParts = append(config.MasterKeyParts, part)
+	}
+	return config, nil
+}
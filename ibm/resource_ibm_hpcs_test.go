@@ -0,0 +1,80 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMHPCS_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-hpcs-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_hpcs.hpcs"
+	dir, err := ioutil.TempDir("", "hpcs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	admin1 := testHpcsSignatureKeyFile(t, dir, "admin1", "secret")
+	admin2 := testHpcsSignatureKeyFile(t, dir, "admin2", "")
+	part1 := testHpcsMasterKeyPartFile(t, dir, "part1")
+	part2 := testHpcsMasterKeyPartFile(t, dir, "part2")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMHPCSConfig(name, admin1, admin2, part1, part2, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "units", "2"),
+					resource.TestCheckResourceAttr(resourceName, "hsm_info.0.signature_threshold", "1"),
+					resource.TestCheckResourceAttr(resourceName, "hsm_info.0.admins.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "hsm_info.0.current_mk_status", "valid"),
+				),
+			},
+			{
+				Config: testAccCheckIBMHPCSConfig(name, admin1, admin2, part1, part2, 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "hsm_info.0.signature_threshold", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"admins", "master_key_parts", "signature_threshold", "revocation_threshold"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMHPCSConfig(name, admin1, admin2, part1, part2 string, signatureThreshold int) string {
+	return fmt.Sprintf(`
+	resource "ibm_hpcs" "hpcs" {
+		name                 = "%s"
+		location             = "us-south"
+		plan                 = "standard"
+		units                = 2
+		signature_threshold  = %d
+		revocation_threshold = 1
+		admins {
+			name  = "admin1"
+			key   = "%s"
+			token = "secret"
+		}
+		admins {
+			name = "admin2"
+			key  = "%s"
+		}
+		master_key_parts = ["%s", "%s"]
+	}
+`, name, signatureThreshold, admin1, admin2, part1, part2)
+}
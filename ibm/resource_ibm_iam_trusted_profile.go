@@ -0,0 +1,167 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMIAMTrustedProfile() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMTrustedProfileCreate,
+		ReadContext:   resourceIBMIAMTrustedProfileRead,
+		UpdateContext: resourceIBMIAMTrustedProfileUpdate,
+		DeleteContext: resourceIBMIAMTrustedProfileDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the trusted profile. The name is checked for uniqueness within the account.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The description of the trusted profile.",
+			},
+			"profile_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the trusted profile.",
+			},
+			"iam_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The iam_id of the trusted profile, the subject of the access policies of the profile.",
+			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The account ID of the trusted profile.",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Cloud Resource Name of the trusted profile.",
+			},
+			"entity_tag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the trusted profile details object.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "If set contains a date time string of the creation date in ISO format.",
+			},
+			"modified_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "If set contains a date time string of the last modification date in ISO format.",
+			},
+		},
+	}
+}
+
+func resourceIBMIAMTrustedProfileCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	userDetails, err := meta.(ClientSession).BluemixUserDetails()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	request := iamTrustedProfile{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		AccountID:   userDetails.userAccount,
+	}
+	profile := &iamTrustedProfile{}
+	response, err := iamTrustedProfileRequest(context, iamIdentityClient, core.POST, `/v1/profiles`, nil, "", request, profile)
+	if err != nil {
+		log.Printf("[DEBUG] CreateProfile failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error creating trusted profile: %s", err))
+	}
+
+	d.SetId(profile.ID)
+
+	return resourceIBMIAMTrustedProfileRead(context, d, meta)
+}
+
+func resourceIBMIAMTrustedProfileRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profile, response, err := getIAMTrustedProfile(context, iamIdentityClient, d.Id())
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetProfile failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error retrieving trusted profile: %s", err))
+	}
+
+	d.Set("name", profile.Name)
+	d.Set("description", profile.Description)
+	d.Set("profile_id", profile.ID)
+	d.Set("iam_id", profile.IamID)
+	d.Set("account_id", profile.AccountID)
+	d.Set("crn", profile.CRN)
+	d.Set("entity_tag", profile.EntityTag)
+	d.Set("created_at", profile.CreatedAt)
+	d.Set("modified_at", profile.ModifiedAt)
+
+	return nil
+}
+
+func resourceIBMIAMTrustedProfileUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("name", "description") {
+		request := iamTrustedProfile{
+			Name:        d.Get("name").(string),
+			Description: d.Get("description").(string),
+		}
+		response, err := iamTrustedProfileRequest(context, iamIdentityClient, core.PUT, `/v1/profiles/{profile-id}`, map[string]string{"profile-id": d.Id()}, d.Get("entity_tag").(string), request, nil)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateProfile failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error updating trusted profile: %s", err))
+		}
+	}
+
+	return resourceIBMIAMTrustedProfileRead(context, d, meta)
+}
+
+func resourceIBMIAMTrustedProfileDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := iamTrustedProfileRequest(context, iamIdentityClient, core.DELETE, `/v1/profiles/{profile-id}`, map[string]string{"profile-id": d.Id()}, "", nil, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteProfile failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting trusted profile: %s", err))
+	}
+
+	d.SetId("")
+
+	return nil
+}
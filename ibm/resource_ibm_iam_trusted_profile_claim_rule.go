@@ -0,0 +1,310 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	iamTrustedProfileRuleTypeSAML = "Profile-SAML"
+	iamTrustedProfileRuleTypeCR   = "Profile-CR"
+)
+
+func resourceIBMIAMTrustedProfileClaimRule() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMTrustedProfileClaimRuleCreate,
+		ReadContext:   resourceIBMIAMTrustedProfileClaimRuleRead,
+		UpdateContext: resourceIBMIAMTrustedProfileClaimRuleUpdate,
+		DeleteContext: resourceIBMIAMTrustedProfileClaimRuleDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"profile_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the trusted profile",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_iam_trusted_profile_claim_rule", "type"),
+				Description:  "Type of the claim rule, Profile-SAML for federated users or Profile-CR for compute resources",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the claim rule",
+			},
+			"realm_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The realm name of the identity provider, required for Profile-SAML rules",
+			},
+			"cr_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: InvokeValidator("ibm_iam_trusted_profile_claim_rule", "cr_type"),
+				Description:  "The compute resource type, required for Profile-CR rules",
+			},
+			"expiration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: InvokeValidator("ibm_iam_trusted_profile_claim_rule", "expiration"),
+				Description:  "The session expiration in seconds of Profile-SAML rules",
+			},
+			"conditions": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "Conditions of the claims that apply the trusted profile",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"claim": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The claim to evaluate against",
+						},
+						"operator": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: InvokeValidator("ibm_iam_trusted_profile_claim_rule", "operator"),
+							Description:  "The operation to perform on the claim",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The value that the claim is compared to",
+						},
+					},
+				},
+			},
+			"rule_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the claim rule",
+			},
+			"entity_tag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the claim rule",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "If set contains a date time string of the creation date in ISO format.",
+			},
+			"modified_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "If set contains a date time string of the last modification date in ISO format.",
+			},
+		},
+	}
+}
+
+func resourceIBMIAMTrustedProfileClaimRuleValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "Profile-SAML, Profile-CR",
+		},
+		ValidateSchema{
+			Identifier:                 "cr_type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "VSI, IKS_SA, ROKS_SA",
+		},
+		ValidateSchema{
+			Identifier:                 "expiration",
+			ValidateFunctionIdentifier: IntBetween,
+			Type:                       TypeInt,
+			Optional:                   true,
+			MinValue:                   "900",
+			MaxValue:                   "43200",
+		},
+		ValidateSchema{
+			Identifier:                 "operator",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "EQUALS, NOT_EQUALS, EQUALS_IGNORE_CASE, NOT_EQUALS_IGNORE_CASE, CONTAINS, IN",
+		},
+	)
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_iam_trusted_profile_claim_rule", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func expandIAMTrustedProfileClaimRule(d *schema.ResourceData) (iamTrustedProfileClaimRule, error) {
+	rule := iamTrustedProfileClaimRule{
+		Type:       d.Get("type").(string),
+		Name:       d.Get("name").(string),
+		RealmName:  d.Get("realm_name").(string),
+		CrType:     d.Get("cr_type").(string),
+		Expiration: d.Get("expiration").(int),
+		Conditions: []iamTrustedProfileClaimRuleCondition{},
+	}
+	if rule.Type == iamTrustedProfileRuleTypeSAML && rule.RealmName == "" {
+		return rule, fmt.Errorf("realm_name is required for %s claim rules", iamTrustedProfileRuleTypeSAML)
+	}
+	if rule.Type == iamTrustedProfileRuleTypeCR {
+		if rule.CrType == "" {
+			return rule, fmt.Errorf("cr_type is required for %s claim rules", iamTrustedProfileRuleTypeCR)
+		}
+		// The session expiration only applies to federated users
+		rule.Expiration = 0
+	}
+
+	for _, e := range d.Get("conditions").([]interface{}) {
+		r, _ := e.(map[string]interface{})
+		rule.Conditions = append(rule.Conditions, iamTrustedProfileClaimRuleCondition{
+			Claim:    r["claim"].(string),
+			Operator: r["operator"].(string),
+			Value:    fmt.Sprintf("\"%s\"", r["value"].(string)),
+		})
+	}
+	return rule, nil
+}
+
+func flattenIAMTrustedProfileClaimRuleConditions(list []iamTrustedProfileClaimRuleCondition) []map[string]interface{} {
+	conditions := make([]map[string]interface{}, len(list))
+	for i, cond := range list {
+		conditions[i] = map[string]interface{}{
+			"claim":    cond.Claim,
+			"operator": cond.Operator,
+			"value":    strings.ReplaceAll(cond.Value, "\"", ""),
+		}
+	}
+	return conditions
+}
+
+func resourceIBMIAMTrustedProfileClaimRuleCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profileID := d.Get("profile_id").(string)
+	request, err := expandIAMTrustedProfileClaimRule(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	rule := &iamTrustedProfileClaimRule{}
+	response, err := iamTrustedProfileRequest(context, iamIdentityClient, core.POST, `/v1/profiles/{profile-id}/rules`, map[string]string{"profile-id": profileID}, "", request, rule)
+	if err != nil {
+		log.Printf("[DEBUG] CreateClaimRule failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error creating trusted profile claim rule: %s", err))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", profileID, rule.ID))
+
+	return resourceIBMIAMTrustedProfileClaimRuleRead(context, d, meta)
+}
+
+func resourceIBMIAMTrustedProfileClaimRuleRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	profileID := parts[0]
+	ruleID := parts[1]
+
+	rule := &iamTrustedProfileClaimRule{}
+	response, err := iamTrustedProfileRequest(context, iamIdentityClient, core.GET, `/v1/profiles/{profile-id}/rules/{rule-id}`, map[string]string{"profile-id": profileID, "rule-id": ruleID}, "", nil, rule)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetClaimRule failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error retrieving trusted profile claim rule: %s", err))
+	}
+
+	d.Set("profile_id", profileID)
+	d.Set("type", rule.Type)
+	d.Set("name", rule.Name)
+	d.Set("realm_name", rule.RealmName)
+	d.Set("cr_type", rule.CrType)
+	d.Set("expiration", rule.Expiration)
+	d.Set("conditions", flattenIAMTrustedProfileClaimRuleConditions(rule.Conditions))
+	d.Set("rule_id", rule.ID)
+	d.Set("entity_tag", rule.EntityTag)
+	d.Set("created_at", rule.CreatedAt)
+	d.Set("modified_at", rule.ModifiedAt)
+
+	return nil
+}
+
+func resourceIBMIAMTrustedProfileClaimRuleUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	profileID := parts[0]
+	ruleID := parts[1]
+
+	if d.HasChanges("name", "realm_name", "cr_type", "expiration", "conditions") {
+		request, err := expandIAMTrustedProfileClaimRule(d)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		response, err := iamTrustedProfileRequest(context, iamIdentityClient, core.PUT, `/v1/profiles/{profile-id}/rules/{rule-id}`, map[string]string{"profile-id": profileID, "rule-id": ruleID}, d.Get("entity_tag").(string), request, nil)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateClaimRule failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error updating trusted profile claim rule: %s", err))
+		}
+	}
+
+	return resourceIBMIAMTrustedProfileClaimRuleRead(context, d, meta)
+}
+
+func resourceIBMIAMTrustedProfileClaimRuleDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	profileID := parts[0]
+	ruleID := parts[1]
+
+	response, err := iamTrustedProfileRequest(context, iamIdentityClient, core.DELETE, `/v1/profiles/{profile-id}/rules/{rule-id}`, map[string]string{"profile-id": profileID, "rule-id": ruleID}, "", nil, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteClaimRule failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting trusted profile claim rule: %s", err))
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,108 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMTrustedProfileClaimRule_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMIAMTrustedProfileClaimRuleDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMIAMTrustedProfileClaimRuleBasic(name, "EQUALS", "default"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_claim_rule.saml", "type", "Profile-SAML"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_claim_rule.saml", "expiration", "43200"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_claim_rule.saml", "conditions.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_claim_rule.cr", "type", "Profile-CR"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_claim_rule.cr", "cr_type", "IKS_SA"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_claim_rule.cr", "conditions.0.value", "default"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMIAMTrustedProfileClaimRuleBasic(name, "NOT_EQUALS", "kube-system"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_claim_rule.cr", "conditions.0.operator", "NOT_EQUALS"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_claim_rule.cr", "conditions.0.value", "kube-system"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_iam_trusted_profile_claim_rule.cr",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMTrustedProfileClaimRuleDestroy(s *terraform.State) error {
+	iamIdentityClient, err := testAccProvider.Meta().(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_trusted_profile_claim_rule" {
+			continue
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		response, err := iamTrustedProfileRequest(context.Background(), iamIdentityClient, core.GET, `/v1/profiles/{profile-id}/rules/{rule-id}`, map[string]string{"profile-id": parts[0], "rule-id": parts[1]}, "", nil, nil)
+		if err == nil {
+			return fmt.Errorf("Trusted profile claim rule still exists: %s", rs.Primary.ID)
+		} else if response == nil || response.StatusCode != 404 {
+			return fmt.Errorf("Error waiting for trusted profile claim rule (%s) to be destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMIAMTrustedProfileClaimRuleBasic(name, operator, namespace string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_trusted_profile" "profile" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_trusted_profile_claim_rule" "saml" {
+			profile_id = ibm_iam_trusted_profile.profile.id
+			type       = "Profile-SAML"
+			name       = "saml-rule"
+			realm_name = "https://sdk.test.realm/1234"
+			expiration = 43200
+			conditions {
+				claim    = "blueGroups"
+				operator = "CONTAINS"
+				value    = "test-group"
+			}
+		}
+
+		resource "ibm_iam_trusted_profile_claim_rule" "cr" {
+			profile_id = ibm_iam_trusted_profile.profile.id
+			type       = "Profile-CR"
+			name       = "cr-rule"
+			cr_type    = "IKS_SA"
+			conditions {
+				claim    = "namespace"
+				operator = "%s"
+				value    = "%s"
+			}
+		}
+	`, name, operator, namespace)
+}
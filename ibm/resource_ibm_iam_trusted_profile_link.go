@@ -0,0 +1,209 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMIAMTrustedProfileLink() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMIAMTrustedProfileLinkCreate,
+		ReadContext:   resourceIBMIAMTrustedProfileLinkRead,
+		DeleteContext: resourceIBMIAMTrustedProfileLinkDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"profile_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The unique identifier of the trusted profile",
+			},
+			"cr_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_iam_trusted_profile_link", "cr_type"),
+				Description:  "The compute resource type: VSI, IKS_SA or ROKS_SA",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of the link",
+			},
+			"link": {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "The compute resource to link to the trusted profile",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"crn": {
+							Type:        schema.TypeString,
+							Required:    true,
+							ForceNew:    true,
+							Description: "The CRN of the compute resource, the VSI or the cluster of the service account",
+						},
+						"namespace": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The Kubernetes namespace of the service account, required for IKS_SA and ROKS_SA links",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							ForceNew:    true,
+							Description: "The name of the Kubernetes service account, required for IKS_SA and ROKS_SA links",
+						},
+					},
+				},
+			},
+			"link_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the link",
+			},
+			"entity_tag": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Version of the link",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "If set contains a date time string of the creation date in ISO format.",
+			},
+			"modified_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "If set contains a date time string of the last modification date in ISO format.",
+			},
+		},
+	}
+}
+
+func resourceIBMIAMTrustedProfileLinkValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "cr_type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "VSI, IKS_SA, ROKS_SA",
+		},
+	)
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_iam_trusted_profile_link", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMIAMTrustedProfileLinkCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profileID := d.Get("profile_id").(string)
+	target := d.Get("link").([]interface{})[0].(map[string]interface{})
+	request := iamTrustedProfileLink{
+		Name:   d.Get("name").(string),
+		CrType: d.Get("cr_type").(string),
+		Link: iamTrustedProfileLinkTarget{
+			CRN:       target["crn"].(string),
+			Namespace: target["namespace"].(string),
+			Name:      target["name"].(string),
+		},
+	}
+	if request.CrType != "VSI" && (request.Link.Namespace == "" || request.Link.Name == "") {
+		return diag.FromErr(fmt.Errorf("link.namespace and link.name are required for %s links", request.CrType))
+	}
+
+	link := &iamTrustedProfileLink{}
+	response, err := iamTrustedProfileRequest(context, iamIdentityClient, core.POST, `/v1/profiles/{profile-id}/links`, map[string]string{"profile-id": profileID}, "", request, link)
+	if err != nil {
+		log.Printf("[DEBUG] CreateLink failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error creating trusted profile link: %s", err))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", profileID, link.ID))
+
+	return resourceIBMIAMTrustedProfileLinkRead(context, d, meta)
+}
+
+func resourceIBMIAMTrustedProfileLinkRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	profileID := parts[0]
+	linkID := parts[1]
+
+	link := &iamTrustedProfileLink{}
+	response, err := iamTrustedProfileRequest(context, iamIdentityClient, core.GET, `/v1/profiles/{profile-id}/links/{link-id}`, map[string]string{"profile-id": profileID, "link-id": linkID}, "", nil, link)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetLink failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error retrieving trusted profile link: %s", err))
+	}
+
+	d.Set("profile_id", profileID)
+	d.Set("cr_type", link.CrType)
+	d.Set("name", link.Name)
+	d.Set("link", []map[string]interface{}{
+		{
+			"crn":       link.Link.CRN,
+			"namespace": link.Link.Namespace,
+			"name":      link.Link.Name,
+		},
+	})
+	d.Set("link_id", link.ID)
+	d.Set("entity_tag", link.EntityTag)
+	d.Set("created_at", link.CreatedAt)
+	d.Set("modified_at", link.ModifiedAt)
+
+	return nil
+}
+
+func resourceIBMIAMTrustedProfileLinkDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	profileID := parts[0]
+	linkID := parts[1]
+
+	response, err := iamTrustedProfileRequest(context, iamIdentityClient, core.DELETE, `/v1/profiles/{profile-id}/links/{link-id}`, map[string]string{"profile-id": profileID, "link-id": linkID}, "", nil, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteLink failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting trusted profile link: %s", err))
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMTrustedProfileLink_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMIAMTrustedProfileLinkDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMIAMTrustedProfileLinkBasic(name, ingressClusterName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_link.link", "cr_type", "IKS_SA"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_link.link", "link.0.namespace", "default"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_link.link", "link.0.name", "default"),
+					resource.TestCheckResourceAttrSet("ibm_iam_trusted_profile_link.link", "link_id"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_iam_trusted_profile_link.link",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMTrustedProfileLinkDestroy(s *terraform.State) error {
+	iamIdentityClient, err := testAccProvider.Meta().(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_trusted_profile_link" {
+			continue
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		response, err := iamTrustedProfileRequest(context.Background(), iamIdentityClient, core.GET, `/v1/profiles/{profile-id}/links/{link-id}`, map[string]string{"profile-id": parts[0], "link-id": parts[1]}, "", nil, nil)
+		if err == nil {
+			return fmt.Errorf("Trusted profile link still exists: %s", rs.Primary.ID)
+		} else if response == nil || response.StatusCode != 404 {
+			return fmt.Errorf("Error waiting for trusted profile link (%s) to be destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMIAMTrustedProfileLinkBasic(name, clusterName string) string {
+	return fmt.Sprintf(`
+		data "ibm_container_cluster" "cluster" {
+			cluster_name_id = "%s"
+		}
+
+		resource "ibm_iam_trusted_profile" "profile" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_trusted_profile_link" "link" {
+			profile_id = ibm_iam_trusted_profile.profile.id
+			cr_type    = "IKS_SA"
+			name       = "default-service-account"
+			link {
+				crn       = data.ibm_container_cluster.cluster.crn
+				namespace = "default"
+				name      = "default"
+			}
+		}
+	`, clusterName, name)
+}
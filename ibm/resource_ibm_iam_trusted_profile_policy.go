@@ -0,0 +1,382 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMIAMTrustedProfilePolicy() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMIAMTrustedProfilePolicyCreate,
+		Read:   resourceIBMIAMTrustedProfilePolicyRead,
+		Update: resourceIBMIAMTrustedProfilePolicyUpdate,
+		Delete: resourceIBMIAMTrustedProfilePolicyDelete,
+		Importer: &schema.ResourceImporter{
+			State: func(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+				resources, resourceAttributes, err := importServicePolicy(d, meta)
+				if err != nil {
+					return nil, fmt.Errorf("Error reading resource ID: %s", err)
+				}
+				d.Set("resources", resources)
+				d.Set("resource_attributes", resourceAttributes)
+				return []*schema.ResourceData{d}, nil
+			},
+		},
+
+		Schema: map[string]*schema.Schema{
+			"profile_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"profile_id", "iam_id"},
+				Description:  "UUID of the trusted profile",
+				ForceNew:     true,
+			},
+			"iam_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"profile_id", "iam_id"},
+				Description:  "IAM ID of the trusted profile",
+				ForceNew:     true,
+			},
+			"roles": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Role names of the policy definition",
+			},
+
+			"resources": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"account_management", "resource_attributes"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"service": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Service name of the policy definition",
+						},
+
+						"resource_instance_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of resource instance of the policy definition",
+						},
+
+						"region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Region of the policy definition",
+						},
+
+						"resource_type": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Resource type of the policy definition",
+						},
+
+						"resource": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Resource of the policy definition",
+						},
+
+						"resource_group_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of the resource group.",
+						},
+
+						"attributes": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Description: "Set resource attributes in the form of 'name=value,name=value....",
+							Elem:        schema.TypeString,
+						},
+					},
+				},
+			},
+
+			"resource_attributes": {
+				Type:          schema.TypeSet,
+				Optional:      true,
+				Description:   "Set resource attributes.",
+				ConflictsWith: []string{"resources", "account_management"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of attribute.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Value of attribute.",
+						},
+						"operator": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "stringEquals",
+							Description: "Operator of attribute.",
+						},
+					},
+				},
+			},
+			"account_management": {
+				Type:          schema.TypeBool,
+				Default:       false,
+				Optional:      true,
+				Description:   "Give access to all account management services",
+				ConflictsWith: []string{"resources", "resource_attributes"},
+			},
+		},
+	}
+}
+
+// getIAMTrustedProfilePolicySubject returns the iam_id of the trusted profile
+// of the policy
+func getIAMTrustedProfilePolicySubject(d *schema.ResourceData, meta interface{}) (string, error) {
+	if v, ok := d.GetOk("iam_id"); ok && v != nil {
+		return v.(string), nil
+	}
+	iamIdentityClient, err := meta.(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return "", err
+	}
+	profile, _, err := getIAMTrustedProfile(context.Background(), iamIdentityClient, d.Get("profile_id").(string))
+	if err != nil {
+		return "", fmt.Errorf("Error retrieving trusted profile: %s", err)
+	}
+	return profile.IamID, nil
+}
+
+func expandIAMTrustedProfilePolicy(d *schema.ResourceData, meta interface{}) ([]iampolicymanagementv1.PolicySubject, []iampolicymanagementv1.PolicyRole, []iampolicymanagementv1.PolicyResource, error) {
+	iamID, err := getIAMTrustedProfilePolicySubject(d, meta)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	userDetails, err := meta.(ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	policyOptions, err := generatePolicyOptions(d, meta)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	subjectAttribute := &iampolicymanagementv1.SubjectAttribute{
+		Name:  core.StringPtr("iam_id"),
+		Value: &iamID,
+	}
+	policySubjects := &iampolicymanagementv1.PolicySubject{
+		Attributes: []iampolicymanagementv1.SubjectAttribute{*subjectAttribute},
+	}
+
+	accountIDResourceAttribute := &iampolicymanagementv1.ResourceAttribute{
+		Name:     core.StringPtr("accountId"),
+		Value:    core.StringPtr(userDetails.userAccount),
+		Operator: core.StringPtr("stringEquals"),
+	}
+	policyResources := iampolicymanagementv1.PolicyResource{
+		Attributes: append(policyOptions.Resources[0].Attributes, *accountIDResourceAttribute),
+	}
+
+	return []iampolicymanagementv1.PolicySubject{*policySubjects}, policyOptions.Roles, []iampolicymanagementv1.PolicyResource{policyResources}, nil
+}
+
+func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta interface{}) error {
+	policySubjects, policyRoles, policyResources, err := expandIAMTrustedProfilePolicy(d, meta)
+	if err != nil {
+		return err
+	}
+
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	createPolicyOptions := iamPolicyManagementClient.NewCreatePolicyOptions(
+		"access",
+		policySubjects,
+		policyRoles,
+		policyResources,
+	)
+
+	profilePolicy, _, err := iamPolicyManagementClient.CreatePolicy(createPolicyOptions)
+	if err != nil {
+		return fmt.Errorf("Error creating trusted profile policy: %s", err)
+	}
+	if v, ok := d.GetOk("profile_id"); ok && v != nil {
+		d.SetId(fmt.Sprintf("%s/%s", v.(string), *profilePolicy.ID))
+	} else {
+		d.SetId(fmt.Sprintf("%s/%s", d.Get("iam_id").(string), *profilePolicy.ID))
+	}
+
+	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
+		*profilePolicy.ID,
+	)
+
+	err = resource.Retry(5*time.Minute, func() *resource.RetryError {
+		_, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				return resource.RetryableError(err)
+			}
+			return resource.NonRetryableError(err)
+		}
+		return nil
+	})
+
+	if isResourceTimeoutError(err) {
+		_, _, err = iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	}
+	if err != nil {
+		return fmt.Errorf("error fetching trusted profile policy: %w", err)
+	}
+
+	return resourceIBMIAMTrustedProfilePolicyRead(d, meta)
+}
+
+func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interface{}) error {
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	profileID := parts[0]
+	profilePolicyID := parts[1]
+
+	getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
+		profilePolicyID,
+	)
+	profilePolicy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving trusted profile policy: %s", err)
+	}
+	if strings.HasPrefix(profileID, "iam-") {
+		d.Set("iam_id", profileID)
+	} else {
+		d.Set("profile_id", profileID)
+	}
+
+	roles := make([]string, len(profilePolicy.Roles))
+	for i, role := range profilePolicy.Roles {
+		roles[i] = *role.DisplayName
+	}
+	d.Set("roles", roles)
+
+	if _, ok := d.GetOk("resources"); ok {
+		d.Set("resources", flattenPolicyResource(profilePolicy.Resources))
+	}
+	if _, ok := d.GetOk("resource_attributes"); ok {
+		d.Set("resource_attributes", flattenPolicyResourceAttributes(profilePolicy.Resources))
+	}
+	if len(profilePolicy.Resources) > 0 {
+		if *getResourceAttribute("serviceType", profilePolicy.Resources[0]) == "service" {
+			d.Set("account_management", false)
+		}
+		if *getResourceAttribute("serviceType", profilePolicy.Resources[0]) == "platform_service" {
+			d.Set("account_management", true)
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
+
+	if d.HasChanges("roles", "resources", "resource_attributes", "account_management") {
+
+		parts, err := idParts(d.Id())
+		if err != nil {
+			return err
+		}
+		profilePolicyID := parts[1]
+
+		policySubjects, policyRoles, policyResources, err := expandIAMTrustedProfilePolicy(d, meta)
+		if err != nil {
+			return err
+		}
+
+		iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+		if err != nil {
+			return err
+		}
+
+		getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
+			profilePolicyID,
+		)
+		policy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		if err != nil || policy == nil {
+			if response != nil && response.StatusCode == 404 {
+				return nil
+			}
+			return fmt.Errorf("Error retrieving Policy: %s\n%s", err, response)
+		}
+
+		profilePolicyETag := response.Headers.Get("ETag")
+		updatePolicyOptions := iamPolicyManagementClient.NewUpdatePolicyOptions(
+			profilePolicyID,
+			profilePolicyETag,
+			"access",
+			policySubjects,
+			policyRoles,
+			policyResources,
+		)
+
+		_, _, err = iamPolicyManagementClient.UpdatePolicy(updatePolicyOptions)
+		if err != nil {
+			return fmt.Errorf("Error updating trusted profile policy: %s", err)
+		}
+	}
+
+	return resourceIBMIAMTrustedProfilePolicyRead(d, meta)
+}
+
+func resourceIBMIAMTrustedProfilePolicyDelete(d *schema.ResourceData, meta interface{}) error {
+	iamPolicyManagementClient, err := meta.(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	profilePolicyID := parts[1]
+
+	deletePolicyOptions := iamPolicyManagementClient.NewDeletePolicyOptions(
+		profilePolicyID,
+	)
+
+	response, err := iamPolicyManagementClient.DeletePolicy(deletePolicyOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return fmt.Errorf("Error deleting trusted profile policy: %s", err)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMTrustedProfilePolicy_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMIAMTrustedProfilePolicyDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMIAMTrustedProfilePolicyBasic(name, `["Viewer"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_policy.policy", "resources.0.service", "cloudantnosqldb"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_policy.policy", "roles.#", "1"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_policy.policy_iam_id", "account_management", "true"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMIAMTrustedProfilePolicyBasic(name, `["Viewer", "Manager"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile_policy.policy", "roles.#", "2"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "ibm_iam_trusted_profile_policy.policy",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resources", "resource_attributes"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMTrustedProfilePolicyDestroy(s *terraform.State) error {
+	iamPolicyManagementClient, err := testAccProvider.Meta().(ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_trusted_profile_policy" {
+			continue
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
+			parts[1],
+		)
+		destroyedPolicy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		if err == nil && *destroyedPolicy.State != "deleted" {
+			return fmt.Errorf("Trusted profile policy still exists: %s\n", rs.Primary.ID)
+		} else if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("Error waiting for trusted profile policy (%s) to be destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMIAMTrustedProfilePolicyBasic(name, roles string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_trusted_profile" "profile" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_trusted_profile_policy" "policy" {
+			profile_id = ibm_iam_trusted_profile.profile.id
+			roles      = %s
+
+			resources {
+				service = "cloudantnosqldb"
+			}
+		}
+
+		resource "ibm_iam_trusted_profile_policy" "policy_iam_id" {
+			iam_id             = ibm_iam_trusted_profile.profile.iam_id
+			roles              = ["Viewer"]
+			account_management = true
+		}
+	`, name, roles)
+}
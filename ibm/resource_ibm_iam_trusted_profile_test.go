@@ -0,0 +1,105 @@
+// Copyright IBM Corp. 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMTrustedProfile_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	updateName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMIAMTrustedProfileDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMIAMTrustedProfileBasic(name, "Trusted profile for test scenario1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMTrustedProfileExists("ibm_iam_trusted_profile.profile"),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile.profile", "name", name),
+					resource.TestCheckResourceAttrSet("ibm_iam_trusted_profile.profile", "iam_id"),
+					resource.TestCheckResourceAttrSet("ibm_iam_trusted_profile.profile", "crn"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMIAMTrustedProfileBasic(updateName, "Trusted profile for test scenario2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile.profile", "name", updateName),
+					resource.TestCheckResourceAttr("ibm_iam_trusted_profile.profile", "description", "Trusted profile for test scenario2"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_iam_trusted_profile.profile",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMTrustedProfileDestroy(s *terraform.State) error {
+	iamIdentityClient, err := testAccProvider.Meta().(ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_trusted_profile" {
+			continue
+		}
+
+		_, response, err := getIAMTrustedProfile(context.Background(), iamIdentityClient, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Trusted profile still exists: %s", rs.Primary.ID)
+		} else if response == nil || response.StatusCode != 404 {
+			return fmt.Errorf("Error waiting for trusted profile (%s) to be destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMIAMTrustedProfileExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No Record ID is set")
+		}
+
+		iamIdentityClient, err := testAccProvider.Meta().(ClientSession).IAMIdentityV1API()
+		if err != nil {
+			return err
+		}
+
+		profile, _, err := getIAMTrustedProfile(context.Background(), iamIdentityClient, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if profile.ID != rs.Primary.ID {
+			return fmt.Errorf("Trusted profile %s not found", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMIAMTrustedProfileBasic(name, description string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_trusted_profile" "profile" {
+			name        = "%s"
+			description = "%s"
+		}
+	`, name, description)
+}
@@ -0,0 +1,230 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"strings"
+	"time"
+
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMKmsImportToken() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMKmsImportTokenCreate,
+		Read:   resourceIBMKmsImportTokenRead,
+		Delete: resourceIBMKmsImportTokenDelete,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Key protect or hpcs instance GUID",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: InvokeValidator("ibm_kms_import_token", "endpoint_type"),
+				Description:  "public or private",
+				ForceNew:     true,
+				Default:      "public",
+			},
+			"expiration": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      600,
+				ValidateFunc: InvokeValidator("ibm_kms_import_token", "expiration"),
+				Description:  "The time in seconds from the creation of the import token that determines how long its associated public key remains valid",
+			},
+			"max_allowed_retrievals": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: InvokeValidator("ibm_kms_import_token", "max_allowed_retrievals"),
+				Description:  "The number of times that the public key of the import token can be retrieved",
+			},
+			"key_material_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The path of a local file with the base64 encoded key material to wrap with the public key of the import token",
+			},
+			"creation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the import token was created. The date format follows RFC 3339.",
+			},
+			"expiration_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the import token expires. The date format follows RFC 3339.",
+			},
+			"public_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The base64 encoded public key of the import token to wrap the key material",
+			},
+			"nonce": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The base64 encoded nonce of the import token to encrypt with the key material",
+			},
+			"encrypted_payload": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The key material of the key material file wrapped with the public key of the import token",
+			},
+			"encrypted_nonce": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The nonce of the import token encrypted with the key material of the key material file",
+			},
+			"iv_value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The initialization vector of the encryption of the nonce",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsImportTokenValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "endpoint_type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "public, private",
+		},
+		ValidateSchema{
+			Identifier:                 "expiration",
+			ValidateFunctionIdentifier: IntBetween,
+			Type:                       TypeInt,
+			Optional:                   true,
+			MinValue:                   "300",
+			MaxValue:                   "86400",
+		},
+		ValidateSchema{
+			Identifier:                 "max_allowed_retrievals",
+			ValidateFunctionIdentifier: IntBetween,
+			Type:                       TypeInt,
+			Optional:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "500",
+		},
+	)
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_kms_import_token", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMKmsImportTokenCreate(d *schema.ResourceData, meta interface{}) error {
+	instanceID := d.Get("instance_id").(string)
+	kpAPI, instanceType, err := kmsInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return err
+	}
+
+	token, err := kpAPI.CreateImportToken(context.Background(), d.Get("expiration").(int), d.Get("max_allowed_retrievals").(int))
+	if err != nil {
+		return fmt.Errorf("Error while creating import token: %s", err)
+	}
+	d.SetId(fmt.Sprintf("%s:importToken:%s", token.ID, instanceID))
+
+	// Retrieving the public key uses one of the allowed retrievals of the
+	// token, it's kept in state
+	transportKey, err := kpAPI.GetImportTokenTransportKey(context.Background())
+	if err != nil {
+		return fmt.Errorf("Error while retrieving the public key of the import token: %s", err)
+	}
+
+	if token.CreationDate != nil {
+		d.Set("creation_date", token.CreationDate.Format(time.RFC3339))
+	}
+	if token.ExpirationDate != nil {
+		d.Set("expiration_date", token.ExpirationDate.Format(time.RFC3339))
+	}
+	d.Set("public_key", transportKey.Payload)
+	d.Set("nonce", transportKey.Nonce)
+
+	if path, ok := d.GetOk("key_material_file"); ok {
+		keyMaterial, err := readKmsKeyMaterialFile(path.(string))
+		if err != nil {
+			return err
+		}
+		encryptedPayload, encryptedNonce, iv, err := wrapKmsKeyMaterial(keyMaterial, transportKey, instanceType)
+		if err != nil {
+			return err
+		}
+		d.Set("encrypted_payload", encryptedPayload)
+		d.Set("encrypted_nonce", encryptedNonce)
+		d.Set("iv_value", iv)
+	}
+
+	return resourceIBMKmsImportTokenRead(d, meta)
+}
+
+func resourceIBMKmsImportTokenRead(d *schema.ResourceData, meta interface{}) error {
+	// The public key of the import token can only be retrieved a limited
+	// number of times and the token expires, the token is kept as created so
+	// that the keys imported with it aren't replaced
+	return nil
+}
+
+func resourceIBMKmsImportTokenDelete(d *schema.ResourceData, meta interface{}) error {
+	// Import tokens cannot be deleted, they expire
+	d.SetId("")
+	return nil
+}
+
+// readKmsKeyMaterialFile returns the base64 encoded key material of a local
+// file, the key material is verified to be a 128, 192 or 256 bit AES key
+func readKmsKeyMaterialFile(path string) (string, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("Error while reading the key material file %s: %s", path, err)
+	}
+	keyMaterial := strings.TrimSpace(string(content))
+	decoded, err := base64.StdEncoding.DecodeString(keyMaterial)
+	if err != nil {
+		return "", fmt.Errorf("The key material of the file %s must be base64 encoded: %s", path, err)
+	}
+	if l := len(decoded); l != 16 && l != 24 && l != 32 {
+		return "", fmt.Errorf("The key material of the file %s must be a 128, 192 or 256 bit key, got %d bits", path, l*8)
+	}
+	return keyMaterial, nil
+}
+
+// wrapKmsKeyMaterial encrypts the key material with the public key of the
+// import token (RSA-OAEP with SHA-256) and the nonce of the import token with
+// the key material, AES-GCM for key protect and AES-CBC for hs-crypto
+// instances
+func wrapKmsKeyMaterial(keyMaterial string, transportKey *kp.ImportTokenKeyResponse, instanceType string) (string, string, string, error) {
+	encryptedPayload, err := kp.EncryptKey(keyMaterial, transportKey.Payload)
+	if err != nil {
+		return "", "", "", fmt.Errorf("Error while wrapping the key material: %s", err)
+	}
+	var encryptedNonce, iv string
+	if instanceType == "hs-crypto" {
+		encryptedNonce, iv, err = kp.EncryptNonceWithCBCPAD(keyMaterial, transportKey.Nonce, "")
+	} else {
+		encryptedNonce, iv, err = kp.EncryptNonce(keyMaterial, transportKey.Nonce, "")
+	}
+	if err != nil {
+		return "", "", "", fmt.Errorf("Error while encrypting the nonce of the import token: %s", err)
+	}
+	return encryptedPayload, encryptedNonce, iv, nil
+}
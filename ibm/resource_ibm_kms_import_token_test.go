@@ -0,0 +1,144 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSImportToken_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	keyMaterialFile := testAccIBMKmsKeyMaterialFile(t)
+	defer os.Remove(keyMaterialFile)
+	resourceName := "ibm_kms_import_token.token"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsImportTokenConfig(instanceName, keyName, keyMaterialFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "public_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "encrypted_nonce"),
+					resource.TestCheckResourceAttrSet(resourceName, "iv_value"),
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "key_name", keyName),
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "key_state", "active"),
+				),
+			},
+		},
+	})
+}
+
+func TestKmsWrapKeyMaterial(t *testing.T) {
+	privateKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(&privateKey.PublicKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	transportKey := &kp.ImportTokenKeyResponse{
+		Payload: base64.StdEncoding.EncodeToString(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicKey})),
+		Nonce:   base64.StdEncoding.EncodeToString([]byte("nonce-123456")),
+	}
+
+	keyMaterialFile := testAccIBMKmsKeyMaterialFile(t)
+	defer os.Remove(keyMaterialFile)
+	keyMaterial, err := readKmsKeyMaterialFile(keyMaterialFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, instanceType := range []string{"kms", "hs-crypto"} {
+		encryptedPayload, encryptedNonce, iv, err := wrapKmsKeyMaterial(keyMaterial, transportKey, instanceType)
+		if err != nil {
+			t.Fatalf("%s: %s", instanceType, err)
+		}
+		if encryptedNonce == "" || iv == "" {
+			t.Errorf("%s: expected an encrypted nonce and its initialization vector", instanceType)
+		}
+		wrapped, err := base64.StdEncoding.DecodeString(encryptedPayload)
+		if err != nil {
+			t.Fatalf("%s: %s", instanceType, err)
+		}
+		unwrapped, err := privateKey.Decrypt(nil, wrapped, &rsa.OAEPOptions{Hash: crypto.SHA256})
+		if err != nil {
+			t.Fatalf("%s: %s", instanceType, err)
+		}
+		if base64.StdEncoding.EncodeToString(unwrapped) != keyMaterial {
+			t.Errorf("%s: the unwrapped key material doesn't match the key material", instanceType)
+		}
+	}
+
+	invalidFile, err := ioutil.TempFile("", "kms-key-material")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(invalidFile.Name())
+	invalidFile.WriteString(base64.StdEncoding.EncodeToString([]byte("too-short")))
+	invalidFile.Close()
+	if _, err := readKmsKeyMaterialFile(invalidFile.Name()); err == nil {
+		t.Error("expected an error for key material that isn't an AES key")
+	}
+}
+
+// testAccIBMKmsKeyMaterialFile returns the path of a temporary file with a
+// random base64 encoded 256 bit key
+func testAccIBMKmsKeyMaterialFile(t *testing.T) string {
+	keyMaterial := make([]byte, 32)
+	if _, err := rand.Read(keyMaterial); err != nil {
+		t.Fatal(err)
+	}
+	f, err := ioutil.TempFile("", "kms-key-material")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(base64.StdEncoding.EncodeToString(keyMaterial) + "\n"); err != nil {
+		t.Fatal(err)
+	}
+	return f.Name()
+}
+
+func testAccCheckIBMKmsImportTokenConfig(instanceName, keyName, keyMaterialFile string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+	resource "ibm_kms_import_token" "token" {
+		instance_id       = ibm_resource_instance.kms_instance.guid
+		expiration        = 1200
+		key_material_file = "%s"
+	}
+	resource "ibm_kms_key" "test" {
+		instance_id     = ibm_resource_instance.kms_instance.guid
+		key_name        = "%s"
+		standard_key    = false
+		force_delete    = true
+		payload         = ibm_kms_import_token.token.encrypted_payload
+		encrypted_nonce = ibm_kms_import_token.token.encrypted_nonce
+		iv_value        = ibm_kms_import_token.token.iv_value
+	}
+`, instanceName, keyMaterialFile, keyName)
+}
@@ -0,0 +1,312 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMKmsInstancePolicies() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMKmsInstancePoliciesCreate,
+		Read:     resourceIBMKmsInstancePoliciesRead,
+		Update:   resourceIBMKmsInstancePoliciesUpdate,
+		Delete:   resourceIBMKmsInstancePoliciesDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Key protect or hpcs instance GUID",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: InvokeValidator("ibm_kms_instance_policies", "endpoint_type"),
+				Description:  "public or private",
+				ForceNew:     true,
+				Default:      "public",
+			},
+			"dual_auth_delete": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"dual_auth_delete", "allowed_network", "metrics", "key_create_import_access"},
+				Description:  "Data associated with the dual authorization delete policy of the instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, the deletion of any key of the instance requires the authorization of two users.",
+						},
+					},
+				},
+			},
+			"allowed_network": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"dual_auth_delete", "allowed_network", "metrics", "key_create_import_access"},
+				Description:  "Data associated with the allowed network policy of the instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, the network access to the instance is restricted to the network type.",
+						},
+						"network": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "public-and-private",
+							ValidateFunc: InvokeValidator("ibm_kms_instance_policies", "network"),
+							Description:  "The type of the allowed network: public-and-private or private-only",
+						},
+					},
+				},
+			},
+			"metrics": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"dual_auth_delete", "allowed_network", "metrics", "key_create_import_access"},
+				Description:  "Data associated with the metrics policy of the instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, the operational metrics of the instance are sent to the monitoring instance of the region.",
+						},
+					},
+				},
+			},
+			"key_create_import_access": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"dual_auth_delete", "allowed_network", "metrics", "key_create_import_access"},
+				Description:  "Data associated with the key create import access policy of the instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, the creation and the import of keys is restricted to the allowed actions.",
+						},
+						"create_root_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set to true, root keys can be created in the instance.",
+						},
+						"create_standard_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set to true, standard keys can be created in the instance.",
+						},
+						"import_root_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set to true, root keys can be imported in the instance.",
+						},
+						"import_standard_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set to true, standard keys can be imported in the instance.",
+						},
+						"enforce_token": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "If set to true, keys can only be imported with an import token.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMKmsInstancePoliciesValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "endpoint_type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "public, private",
+		},
+		ValidateSchema{
+			Identifier:                 "network",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "public-and-private, private-only",
+		},
+	)
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_kms_instance_policies", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMKmsInstancePoliciesCreate(d *schema.ResourceData, meta interface{}) error {
+	instanceID := d.Get("instance_id").(string)
+	kpAPI, _, err := kmsInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return err
+	}
+
+	err = kpAPI.SetInstancePolicies(context.Background(), expandKmsInstancePolicies(d, false))
+	if err != nil {
+		return fmt.Errorf("Error while setting instance policies: %s", err)
+	}
+	d.SetId(instanceID)
+
+	return resourceIBMKmsInstancePoliciesRead(d, meta)
+}
+
+func resourceIBMKmsInstancePoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	instanceID := d.Id()
+	kpAPI, _, err := kmsInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return err
+	}
+
+	policies, err := kpAPI.GetInstancePolicies(context.Background())
+	if err != nil {
+		if kpError, ok := err.(*kp.Error); ok && kpError.StatusCode == 404 {
+			log.Printf("[WARN] Instance %s is not found, removing its policies from state", instanceID)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Failed to read instance policies: %s", err)
+	}
+
+	// Only the policies of the configuration are managed, all of them are
+	// read on import
+	importing := d.Get("instance_id").(string) == ""
+	managed := func(policy string) bool {
+		return importing || len(d.Get(policy).([]interface{})) > 0
+	}
+
+	d.Set("instance_id", instanceID)
+	for _, policy := range policies {
+		enabled := policy.PolicyData.Enabled != nil && *policy.PolicyData.Enabled
+		attributes := policy.PolicyData.Attributes
+		switch {
+		case policy.PolicyType == kp.DualAuthDelete && managed("dual_auth_delete"):
+			d.Set("dual_auth_delete", []interface{}{map[string]interface{}{"enabled": enabled}})
+		case policy.PolicyType == kp.Metrics && managed("metrics"):
+			d.Set("metrics", []interface{}{map[string]interface{}{"enabled": enabled}})
+		case policy.PolicyType == kp.AllowedNetwork && managed("allowed_network"):
+			allowedNetwork := map[string]interface{}{"enabled": enabled}
+			if attributes != nil && attributes.AllowedNetwork != nil {
+				allowedNetwork["network"] = *attributes.AllowedNetwork
+			}
+			d.Set("allowed_network", []interface{}{allowedNetwork})
+		case policy.PolicyType == kp.KeyCreateImportAccess && managed("key_create_import_access"):
+			keyCreateImportAccess := map[string]interface{}{"enabled": enabled}
+			if attributes != nil {
+				keyCreateImportAccess["create_root_key"] = attributes.CreateRootKey == nil || *attributes.CreateRootKey
+				keyCreateImportAccess["create_standard_key"] = attributes.CreateStandardKey == nil || *attributes.CreateStandardKey
+				keyCreateImportAccess["import_root_key"] = attributes.ImportRootKey == nil || *attributes.ImportRootKey
+				keyCreateImportAccess["import_standard_key"] = attributes.ImportStandardKey == nil || *attributes.ImportStandardKey
+				keyCreateImportAccess["enforce_token"] = attributes.EnforceToken != nil && *attributes.EnforceToken
+			}
+			d.Set("key_create_import_access", []interface{}{keyCreateImportAccess})
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMKmsInstancePoliciesUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChanges("dual_auth_delete", "allowed_network", "metrics", "key_create_import_access") {
+		kpAPI, _, err := kmsInstanceClient(meta, d.Id(), d.Get("endpoint_type").(string))
+		if err != nil {
+			return err
+		}
+		err = kpAPI.SetInstancePolicies(context.Background(), expandKmsInstancePolicies(d, false))
+		if err != nil {
+			return fmt.Errorf("Error while updating instance policies: %s", err)
+		}
+	}
+
+	return resourceIBMKmsInstancePoliciesRead(d, meta)
+}
+
+func resourceIBMKmsInstancePoliciesDelete(d *schema.ResourceData, meta interface{}) error {
+	kpAPI, _, err := kmsInstanceClient(meta, d.Id(), d.Get("endpoint_type").(string))
+	if err != nil {
+		return err
+	}
+
+	// Instance policies cannot be removed, they are disabled instead
+	err = kpAPI.SetInstancePolicies(context.Background(), expandKmsInstancePolicies(d, true))
+	if err != nil {
+		if kpError, ok := err.(*kp.Error); !ok || kpError.StatusCode != 404 {
+			return fmt.Errorf("Error while disabling instance policies: %s", err)
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// expandKmsInstancePolicies returns the configured instance policies, all of
+// them disabled when disable is true. The policies removed from the
+// configuration are sent disabled, an instance policy cannot be removed.
+func expandKmsInstancePolicies(d *schema.ResourceData, disable bool) kp.MultiplePolicies {
+	policies := kp.MultiplePolicies{}
+	policy := func(name string) (map[string]interface{}, bool) {
+		o, n := d.GetChange(name)
+		if l := n.([]interface{}); len(l) > 0 && l[0] != nil {
+			return l[0].(map[string]interface{}), !disable
+		}
+		if l := o.([]interface{}); len(l) > 0 && l[0] != nil {
+			return l[0].(map[string]interface{}), false
+		}
+		return nil, false
+	}
+	if p, enable := policy("dual_auth_delete"); p != nil {
+		policies.DualAuthDelete = &kp.BasicPolicyData{
+			Enabled: p["enabled"].(bool) && enable,
+		}
+	}
+	if p, enable := policy("allowed_network"); p != nil {
+		policies.AllowedNetwork = &kp.AllowedNetworkPolicyData{
+			Enabled: p["enabled"].(bool) && enable,
+			Network: p["network"].(string),
+		}
+	}
+	if p, enable := policy("metrics"); p != nil {
+		policies.Metrics = &kp.BasicPolicyData{
+			Enabled: p["enabled"].(bool) && enable,
+		}
+	}
+	if p, enable := policy("key_create_import_access"); p != nil {
+		policies.KeyCreateImportAccess = &kp.KeyCreateImportAccessInstancePolicy{
+			Enabled:           p["enabled"].(bool) && enable,
+			CreateRootKey:     p["create_root_key"].(bool),
+			CreateStandardKey: p["create_standard_key"].(bool),
+			ImportRootKey:     p["import_root_key"].(bool),
+			ImportStandardKey: p["import_standard_key"].(bool),
+			EnforceToken:      p["enforce_token"].(bool),
+		}
+	}
+	return policies
+}
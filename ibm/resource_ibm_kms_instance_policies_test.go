@@ -0,0 +1,127 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMKMSInstancePolicies_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_kms_instance_policies.policies"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsInstancePoliciesConfig(instanceName, true, "public-and-private"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "metrics.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_network.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_network.0.network", "public-and-private"),
+					resource.TestCheckResourceAttr(resourceName, "key_create_import_access.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "key_create_import_access.0.import_standard_key", "false"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsInstancePoliciesConfig(instanceName, false, "public-and-private"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "metrics.0.enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoint_type", "dual_auth_delete"},
+			},
+			{
+				Config: testAccCheckIBMKmsInstancePoliciesWithoutNetworkConfig(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allowed_network.#", "0"),
+					testAccCheckIBMKmsInstancePolicyDisabled(resourceName, kp.AllowedNetwork),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsInstancePoliciesConfig(instanceName string, metrics bool, network string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+	resource "ibm_kms_instance_policies" "policies" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		metrics {
+			enabled = %t
+		}
+		allowed_network {
+			enabled = true
+			network = "%s"
+		}
+		key_create_import_access {
+			enabled             = true
+			import_standard_key = false
+		}
+	}
+`, instanceName, metrics, network)
+}
+
+func testAccCheckIBMKmsInstancePoliciesWithoutNetworkConfig(instanceName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+	resource "ibm_kms_instance_policies" "policies" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		metrics {
+			enabled = false
+		}
+		key_create_import_access {
+			enabled             = true
+			import_standard_key = false
+		}
+	}
+`, instanceName)
+}
+
+// testAccCheckIBMKmsInstancePolicyDisabled checks that a policy removed from
+// the configuration is disabled on the instance
+func testAccCheckIBMKmsInstancePolicyDisabled(n, policyType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		kpAPI, _, err := kmsInstanceClient(testAccProvider.Meta(), rs.Primary.ID, "public")
+		if err != nil {
+			return err
+		}
+		policies, err := kpAPI.GetInstancePolicies(context.Background())
+		if err != nil {
+			return err
+		}
+		for _, policy := range policies {
+			if policy.PolicyType == policyType && policy.PolicyData.Enabled != nil && *policy.PolicyData.Enabled {
+				return fmt.Errorf("The %s policy of instance %s is still enabled", policyType, rs.Primary.ID)
+			}
+		}
+		return nil
+	}
+}
@@ -4,15 +4,21 @@
 package ibm
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"log"
+	"net/http"
 	"net/url"
 	"strconv"
 	"strings"
 	"time"
 
 	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/IBM/keyprotect-go-client/iam"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -29,6 +35,12 @@ func resourceIBMKmskey() *schema.Resource {
 			Update: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMKmsKeyCustomizeDiff(diff)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 			"instance_id": {
 				Type:        schema.TypeString,
@@ -75,10 +87,11 @@ func resourceIBMKmskey() *schema.Resource {
 				Description: "Standard key type",
 			},
 			"payload": {
-				Type:     schema.TypeString,
-				Computed: true,
-				Optional: true,
-				ForceNew: true,
+				Type:      schema.TypeString,
+				Computed:  true,
+				Optional:  true,
+				ForceNew:  true,
+				Sensitive: true,
 			},
 			"encrypted_nonce": {
 				Type:        schema.TypeString,
@@ -110,11 +123,54 @@ func resourceIBMKmskey() *schema.Resource {
 				Description: "The date the key material expires. The date format follows RFC 3339. You can set an expiration date on any key on its creation. A key moves into the Deactivated state within one hour past its expiration date, if one is assigned. If you create a key without specifying an expiration date, the key does not expire",
 				ForceNew:    true,
 			},
+			"key_state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"active", "disabled"}),
+				Description:  "The state of the key, active or disabled. Disabling a root key suspends the access to the data that it protects",
+			},
+			"rotate_trigger": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Any change of the value rotates the root key",
+			},
+			"rotate_payload": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "The base64 encoded key material of the rotation of an imported root key",
+			},
+			"last_rotate_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the key was last rotated. The date format follows RFC 3339.",
+			},
+			"versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The versions of the root key, a new version is created on each rotation",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the key version",
+						},
+						"creation_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the key version was created. The date format follows RFC 3339.",
+						},
+					},
+				},
+			},
 			"policies": {
 				Type:        schema.TypeList,
 				Optional:    true,
 				Computed:    true,
 				Description: "Creates or updates one or more policies for the specified key",
+				Deprecated:  "Use the ibm_kms_key_policies resource to manage the policies of the key",
 				MinItems:    1,
 				MaxItems:    1,
 				Elem: &schema.Resource{
@@ -248,73 +304,11 @@ func resourceIBMKmskey() *schema.Resource {
 }
 
 func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
-	kpAPI, err := meta.(ClientSession).keyManagementAPI()
+	kpAPI, _, err := kmsInstanceClient(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
 	if err != nil {
 		return err
 	}
 
-	rContollerClient, err := meta.(ClientSession).ResourceControllerAPIV2()
-	if err != nil {
-		return err
-	}
-
-	instanceID := d.Get("instance_id").(string)
-	endpointType := d.Get("endpoint_type").(string)
-
-	rContollerApi := rContollerClient.ResourceServiceInstanceV2()
-
-	instanceData, err := rContollerApi.GetInstance(instanceID)
-	if err != nil {
-		return err
-	}
-	instanceCRN := instanceData.Crn.String()
-	crnData := strings.Split(instanceCRN, ":")
-
-	var hpcsEndpointURL string
-
-	if crnData[4] == "hs-crypto" {
-		hpcsEndpointAPI, err := meta.(ClientSession).HpcsEndpointAPI()
-		if err != nil {
-			return err
-		}
-
-		resp, err := hpcsEndpointAPI.Endpoint().GetAPIEndpoint(instanceID)
-		if err != nil {
-			return err
-		}
-
-		if endpointType == "public" {
-			hpcsEndpointURL = "https://" + resp.Kms.Public + "/api/v2/keys"
-		} else {
-			hpcsEndpointURL = "https://" + resp.Kms.Private + "/api/v2/keys"
-		}
-
-		u, err := url.Parse(hpcsEndpointURL)
-		if err != nil {
-			return fmt.Errorf("Error Parsing hpcs EndpointURL")
-		}
-		kpAPI.URL = u
-	} else if crnData[4] == "kms" {
-		if endpointType == "private" {
-			if !strings.Contains(kpAPI.Config.BaseURL, "private") {
-				kmsEndpURL := strings.SplitAfter(kpAPI.Config.BaseURL, "https://")
-				if len(kmsEndpURL) == 2 {
-					kmsEndpointURL := kmsEndpURL[0] + "private." + kmsEndpURL[1]
-					u, err := url.Parse(kmsEndpointURL)
-					if err != nil {
-						return fmt.Errorf("Error Parsing kms EndpointURL")
-					}
-					kpAPI.URL = u
-				} else {
-					return fmt.Errorf("Error in Kms EndPoint URL ")
-				}
-			}
-		}
-	} else {
-		return fmt.Errorf("Invalid or unsupported service Instance")
-	}
-	kpAPI.Config.InstanceID = instanceID
-
 	kpAPI.Config.KeyRing = d.Get("key_ring_id").(string)
 
 	name := d.Get("key_name").(string)
@@ -384,65 +378,20 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
-	kpAPI, err := meta.(ClientSession).keyManagementAPI()
+	crn := d.Id()
+	crnData, err := parseKmsCRN(crn)
 	if err != nil {
 		return err
 	}
-	crn := d.Id()
-	crnData := strings.Split(crn, ":")
 	endpointType := crnData[3]
 	instanceID := crnData[len(crnData)-3]
 	keyid := crnData[len(crnData)-1]
 
-	var instanceType string
-	var hpcsEndpointURL string
-
-	if crnData[4] == "hs-crypto" {
-		instanceType = "hs-crypto"
-		hpcsEndpointAPI, err := meta.(ClientSession).HpcsEndpointAPI()
-		if err != nil {
-			return err
-		}
-
-		resp, err := hpcsEndpointAPI.Endpoint().GetAPIEndpoint(instanceID)
-		if err != nil {
-			return err
-		}
-
-		if endpointType == "public" {
-			hpcsEndpointURL = "https://" + resp.Kms.Public + "/api/v2/keys"
-		} else {
-			hpcsEndpointURL = "https://" + resp.Kms.Private + "/api/v2/keys"
-		}
-
-		u, err := url.Parse(hpcsEndpointURL)
-		if err != nil {
-			return fmt.Errorf("Error Parsing hpcs EndpointURL")
-
-		}
-		kpAPI.URL = u
-	} else if crnData[4] == "kms" {
-		instanceType = "kms"
-		if endpointType == "private" {
-			if !strings.Contains(kpAPI.Config.BaseURL, "private") {
-				kmsEndpURL := strings.SplitAfter(kpAPI.Config.BaseURL, "https://")
-				if len(kmsEndpURL) == 2 {
-					kmsEndpointURL := kmsEndpURL[0] + "private." + kmsEndpURL[1]
-					u, err := url.Parse(kmsEndpointURL)
-					if err != nil {
-						return fmt.Errorf("Error Parsing kms EndpointURL")
-					}
-					kpAPI.URL = u
-				} else {
-					return fmt.Errorf("Error in Kms EndPoint URL ")
-				}
-			}
-		}
-	} else {
-		return fmt.Errorf("Invalid or unsupported service Instance")
+	kpAPI, instanceType, err := kmsCRNClient(meta, crn, endpointType)
+	if err != nil {
+		return err
 	}
 
-	kpAPI.Config.InstanceID = instanceID
 	// keyid := d.Id()
 	key, err := kpAPI.GetKey(context.Background(), keyid)
 	if err != nil {
@@ -461,9 +410,17 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("instance_id", instanceID)
 	d.Set("key_id", keyid)
 	d.Set("standard_key", key.Extractable)
-	d.Set("payload", key.Payload)
-	d.Set("encrypted_nonce", key.EncryptedNonce)
-	d.Set("iv_value", key.IV)
+	// The wrapped key material of an import token isn't returned, the
+	// configured values are kept
+	if key.Payload != "" {
+		d.Set("payload", key.Payload)
+	}
+	if key.EncryptedNonce != "" {
+		d.Set("encrypted_nonce", key.EncryptedNonce)
+	}
+	if key.IV != "" {
+		d.Set("iv_value", key.IV)
+	}
 	d.Set("key_name", key.Name)
 	d.Set("crn", key.CRN)
 	d.Set("endpoint_type", endpointType)
@@ -476,6 +433,18 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		d.Set("expiration_date", "")
 	}
+	d.Set("key_state", kmsKeyState(key.State))
+	if key.LastRotateDate != nil {
+		d.Set("last_rotate_date", key.LastRotateDate.Format(time.RFC3339))
+	}
+	if !key.Extractable {
+		versions, err := getKmsKeyVersions(kpAPI, keyid)
+		if err != nil {
+			log.Printf("[WARN] Failed to read the versions of key %s: %s", keyid, err)
+		} else {
+			d.Set("versions", versions)
+		}
+	}
 	d.Set(ResourceName, key.Name)
 	d.Set(ResourceCRN, key.CRN)
 	state := key.State
@@ -493,83 +462,63 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 
 }
 
+// resourceIBMKmsKeyCustomizeDiff checks the change of the key state, a key can
+// only be disabled or enabled again while it's active or disabled
+func resourceIBMKmsKeyCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Id() == "" || !diff.HasChange("key_state") {
+		return nil
+	}
+	oldState, newState := diff.GetChange("key_state")
+	switch oldState.(string) {
+	case "", "active", "disabled":
+		return nil
+	}
+	return fmt.Errorf("key_state of the key can't be changed from %s to %s", oldState, newState)
+}
+
 func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	if d.HasChange("force_delete") {
 		d.Set("force_delete", d.Get("force_delete").(bool))
 	}
-	if d.HasChange("policies") {
-
-		kpAPI, err := meta.(ClientSession).keyManagementAPI()
+	if d.HasChanges("key_state", "rotate_trigger") {
+		kpAPI, _, err := kmsInstanceClient(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
 		if err != nil {
 			return err
 		}
+		crnData := strings.Split(d.Id(), ":")
+		keyID := crnData[len(crnData)-1]
+		keyState := d.Get("key_state").(string)
 
-		rContollerClient, err := meta.(ClientSession).ResourceControllerAPIV2()
-		if err != nil {
-			return err
+		// A disabled key is enabled before its rotation, it's only rotated by
+		// changes of the trigger after its creation
+		if d.HasChange("key_state") && keyState == "active" && !d.IsNewResource() {
+			if err := kpAPI.EnableKey(context.Background(), keyID); err != nil {
+				return fmt.Errorf("Error while enabling key: %s", err)
+			}
 		}
+		if d.HasChange("rotate_trigger") && !d.IsNewResource() {
+			if err := kpAPI.Rotate(context.Background(), keyID, d.Get("rotate_payload").(string)); err != nil {
+				return fmt.Errorf("Error while rotating key: %s", err)
+			}
+		}
+		if d.HasChange("key_state") && keyState == "disabled" {
+			if err := kpAPI.DisableKey(context.Background(), keyID); err != nil {
+				return fmt.Errorf("Error while disabling key: %s", err)
+			}
+		}
+	}
+	if d.HasChange("policies") {
 
-		instanceID := d.Get("instance_id").(string)
-		endpointType := d.Get("endpoint_type").(string)
-
-		rContollerApi := rContollerClient.ResourceServiceInstanceV2()
-
-		instanceData, err := rContollerApi.GetInstance(instanceID)
+		kpAPI, _, err := kmsInstanceClient(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
 		if err != nil {
 			return err
 		}
-		instanceCRN := instanceData.Crn.String()
-		crnData := strings.Split(instanceCRN, ":")
-
-		var hpcsEndpointURL string
-
-		if crnData[4] == "hs-crypto" {
-			hpcsEndpointAPI, err := meta.(ClientSession).HpcsEndpointAPI()
-			if err != nil {
-				return err
-			}
-
-			resp, err := hpcsEndpointAPI.Endpoint().GetAPIEndpoint(instanceID)
-			if err != nil {
-				return err
-			}
-
-			if endpointType == "public" {
-				hpcsEndpointURL = "https://" + resp.Kms.Public + "/api/v2/keys"
-			} else {
-				hpcsEndpointURL = "https://" + resp.Kms.Private + "/api/v2/keys"
-			}
 
-			u, err := url.Parse(hpcsEndpointURL)
-			if err != nil {
-				return fmt.Errorf("Error Parsing hpcs EndpointURL")
-			}
-			kpAPI.URL = u
-		} else if crnData[4] == "kms" {
-			if endpointType == "private" {
-				if !strings.Contains(kpAPI.Config.BaseURL, "private") {
-					kmsEndpURL := strings.SplitAfter(kpAPI.Config.BaseURL, "https://")
-					if len(kmsEndpURL) == 2 {
-						kmsEndpointURL := kmsEndpURL[0] + "private." + kmsEndpURL[1]
-						u, err := url.Parse(kmsEndpointURL)
-						if err != nil {
-							return fmt.Errorf("Error Parsing kms EndpointURL")
-						}
-						kpAPI.URL = u
-					} else {
-						return fmt.Errorf("Error in Kms EndPoint URL ")
-					}
-				}
-			}
-		} else {
-			return fmt.Errorf("Invalid or unsupported service Instance")
+		crnData, err := parseKmsCRN(d.Id())
+		if err != nil {
+			return err
 		}
-
-		kpAPI.Config.InstanceID = instanceID
-
-		crn := d.Id()
-		crnData = strings.Split(crn, ":")
 		key_id := crnData[len(crnData)-1]
 
 		err = handlePolicies(d, kpAPI, meta, key_id)
@@ -583,59 +532,17 @@ func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceIBMKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
-	kpAPI, err := meta.(ClientSession).keyManagementAPI()
+	crn := d.Id()
+	crnData, err := parseKmsCRN(crn)
 	if err != nil {
 		return err
 	}
-	crn := d.Id()
-	crnData := strings.Split(crn, ":")
 	endpointType := crnData[3]
-	instanceID := crnData[len(crnData)-3]
 	keyid := crnData[len(crnData)-1]
-	kpAPI.Config.InstanceID = instanceID
-
-	var hpcsEndpointURL string
-
-	if crnData[4] == "hs-crypto" {
-		hpcsEndpointAPI, err := meta.(ClientSession).HpcsEndpointAPI()
-		if err != nil {
-			return err
-		}
-
-		resp, err := hpcsEndpointAPI.Endpoint().GetAPIEndpoint(instanceID)
-		if err != nil {
-			return err
-		}
-
-		if endpointType == "public" {
-			hpcsEndpointURL = "https://" + resp.Kms.Public + "/api/v2/keys"
-		} else {
-			hpcsEndpointURL = "https://" + resp.Kms.Private + "/api/v2/keys"
-		}
 
-		u, err := url.Parse(hpcsEndpointURL)
-		if err != nil {
-			return fmt.Errorf("Error Parsing hpcs EndpointURL")
-		}
-		kpAPI.URL = u
-	} else if crnData[4] == "kms" {
-		if endpointType == "private" {
-			if !strings.Contains(kpAPI.Config.BaseURL, "private") {
-				kmsEndpURL := strings.SplitAfter(kpAPI.Config.BaseURL, "https://")
-				if len(kmsEndpURL) == 2 {
-					kmsEndpointURL := kmsEndpURL[0] + "private." + kmsEndpURL[1]
-					u, err := url.Parse(kmsEndpointURL)
-					if err != nil {
-						return fmt.Errorf("Error Parsing kms EndpointURL")
-					}
-					kpAPI.URL = u
-				} else {
-					return fmt.Errorf("Error in Kms EndPoint URL ")
-				}
-			}
-		}
-	} else {
-		return fmt.Errorf("Invalid or unsupported service Instance")
+	kpAPI, _, err := kmsCRNClient(meta, crn, endpointType)
+	if err != nil {
+		return err
 	}
 
 	force := d.Get("force_delete").(bool)
@@ -654,31 +561,114 @@ func resourceIBMKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func resourceIBMKmsKeyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	kpAPI, err := meta.(ClientSession).keyManagementAPI()
+	crn := d.Id()
+	crnData, err := parseKmsCRN(crn)
 	if err != nil {
 		return false, err
 	}
+	endpointType := crnData[3]
+	keyid := crnData[len(crnData)-1]
 
-	crn := d.Id()
+	kpAPI, _, err := kmsCRNClient(meta, crn, endpointType)
+	if err != nil {
+		return false, err
+	}
+
+	_, err = kpAPI.GetKey(context.Background(), keyid)
+	if err != nil {
+		kpError := err.(*kp.Error)
+		if kpError.StatusCode == 404 {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+
+}
+
+func handlePolicies(d *schema.ResourceData, kpAPI *kp.Client, meta interface{}, key_id string) error {
+	var setRotation, setDualAuthDelete, dualAuthEnable bool
+	var rotationInterval int
+
+	if policyInfo, ok := d.GetOk("policies"); ok {
+
+		policyDataList := policyInfo.([]interface{})
+		policyData := policyDataList[0].(map[string]interface{})
+
+		if rpd, ok := policyData["rotation"]; ok {
+			rpdList := rpd.([]interface{})
+			if len(rpdList) != 0 {
+				rotationInterval = rpdList[0].(map[string]interface{})["interval_month"].(int)
+				setRotation = true
+			}
+		}
+		if dadp, ok := policyData["dual_auth_delete"]; ok {
+			dadpList := dadp.([]interface{})
+			if len(dadpList) != 0 {
+				dualAuthEnable = dadpList[0].(map[string]interface{})["enabled"].(bool)
+				setDualAuthDelete = true
+			}
+		}
+
+		_, err := kpAPI.SetPolicies(context.Background(), key_id, setRotation, rotationInterval, setDualAuthDelete, dualAuthEnable)
+		if err != nil {
+			return fmt.Errorf("Error while creating policies: %s", err)
+		}
+	}
+	return nil
+}
+
+// kmsInstanceClient returns the key management client of the Key Protect or
+// Hyper Protect Crypto Services instance and the type of the instance
+func kmsInstanceClient(meta interface{}, instanceID, endpointType string) (*kp.Client, string, error) {
+	rContollerClient, err := meta.(ClientSession).ResourceControllerAPIV2()
+	if err != nil {
+		return nil, "", err
+	}
+	instanceData, err := rContollerClient.ResourceServiceInstanceV2().GetInstance(instanceID)
+	if err != nil {
+		return nil, "", err
+	}
+	return kmsCRNClient(meta, instanceData.Crn.String(), endpointType)
+}
+
+// parseKmsCRN splits the CRN of a Key Protect or Hyper Protect Crypto Services
+// instance or key into its ten segments
+func parseKmsCRN(crn string) ([]string, error) {
 	crnData := strings.Split(crn, ":")
-	endpointType := crnData[3]
+	if len(crnData) != 10 || crnData[0] != "crn" {
+		return nil, fmt.Errorf("Invalid CRN %s", crn)
+	}
+	return crnData, nil
+}
+
+// kmsCRNClient returns the key management client of the instance of the CRN,
+// an instance or a key CRN, and the type of the instance
+func kmsCRNClient(meta interface{}, crn, endpointType string) (*kp.Client, string, error) {
+	crnData, err := parseKmsCRN(crn)
+	if err != nil {
+		return nil, "", err
+	}
+	instanceType := crnData[4]
 	instanceID := crnData[len(crnData)-3]
-	keyid := crnData[len(crnData)-1]
-	kpAPI.Config.InstanceID = instanceID
 
-	var hpcsEndpointURL string
+	kpAPI, err := meta.(ClientSession).keyManagementAPI()
+	if err != nil {
+		return nil, "", err
+	}
 
-	if crnData[4] == "hs-crypto" {
+	if instanceType == "hs-crypto" {
 		hpcsEndpointAPI, err := meta.(ClientSession).HpcsEndpointAPI()
 		if err != nil {
-			return false, err
+			return nil, "", err
 		}
 
 		resp, err := hpcsEndpointAPI.Endpoint().GetAPIEndpoint(instanceID)
 		if err != nil {
-			return false, err
+			return nil, "", err
 		}
 
+		var hpcsEndpointURL string
 		if endpointType == "public" {
 			hpcsEndpointURL = "https://" + resp.Kms.Public + "/api/v2/keys"
 		} else {
@@ -687,11 +677,10 @@ func resourceIBMKmsKeyExists(d *schema.ResourceData, meta interface{}) (bool, er
 
 		u, err := url.Parse(hpcsEndpointURL)
 		if err != nil {
-			return false, fmt.Errorf("Error Parsing hpcs EndpointURL")
-
+			return nil, "", fmt.Errorf("Error Parsing hpcs EndpointURL")
 		}
 		kpAPI.URL = u
-	} else if crnData[4] == "kms" {
+	} else if instanceType == "kms" {
 		if endpointType == "private" {
 			if !strings.Contains(kpAPI.Config.BaseURL, "private") {
 				kmsEndpURL := strings.SplitAfter(kpAPI.Config.BaseURL, "https://")
@@ -699,58 +688,139 @@ func resourceIBMKmsKeyExists(d *schema.ResourceData, meta interface{}) (bool, er
 					kmsEndpointURL := kmsEndpURL[0] + "private." + kmsEndpURL[1]
 					u, err := url.Parse(kmsEndpointURL)
 					if err != nil {
-						return false, fmt.Errorf("Error Parsing kms EndpointURL")
+						return nil, "", fmt.Errorf("Error Parsing kms EndpointURL")
 					}
 					kpAPI.URL = u
 				} else {
-					return false, fmt.Errorf("Error in Kms EndPoint URL ")
+					return nil, "", fmt.Errorf("Error in Kms EndPoint URL ")
 				}
 			}
 		}
 	} else {
-		return false, fmt.Errorf("Invalid or unsupported service Instance")
+		return nil, "", fmt.Errorf("Invalid or unsupported service Instance")
 	}
+	kpAPI.Config.InstanceID = instanceID
 
-	_, err = kpAPI.GetKey(context.Background(), keyid)
+	return kpAPI, instanceType, nil
+}
+
+// kmsKeyRequest sends a request to the key management API of the instance of
+// the client for the operations that are not supported by the client. The
+// errors are returned as kp.Error like the errors of the client.
+func kmsKeyRequest(kpAPI *kp.Client, method, path string, body, result interface{}) error {
+	authorization, err := kmsAuthorization(kpAPI)
 	if err != nil {
-		kpError := err.(*kp.Error)
-		if kpError.StatusCode == 404 {
-			return false, nil
+		return err
+	}
+
+	u, err := kpAPI.URL.Parse(path)
+	if err != nil {
+		return err
+	}
+	var reqBody *bytes.Buffer
+	if body != nil {
+		b, err := json.Marshal(body)
+		if err != nil {
+			return err
 		}
-		return false, err
+		reqBody = bytes.NewBuffer(b)
+	} else {
+		reqBody = &bytes.Buffer{}
+	}
+	req, err := http.NewRequest(method, u.String(), reqBody)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("accept", "application/json")
+	req.Header.Set("content-type", "application/json")
+	req.Header.Set("authorization", authorization)
+	req.Header.Set("bluemix-instance", kpAPI.Config.InstanceID)
+
+	response, err := kpAPI.HttpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+	resBody, err := ioutil.ReadAll(response.Body)
+	if err != nil {
+		return err
 	}
-	return true, nil
 
+	if response.StatusCode < 200 || response.StatusCode > 299 {
+		return &kp.Error{
+			URL:         u.String(),
+			StatusCode:  response.StatusCode,
+			Message:     string(resBody),
+			BodyContent: resBody,
+		}
+	}
+	if result != nil && len(resBody) != 0 {
+		return json.Unmarshal(resBody, result)
+	}
+	return nil
 }
 
-func handlePolicies(d *schema.ResourceData, kpAPI *kp.Client, meta interface{}, key_id string) error {
-	var setRotation, setDualAuthDelete, dualAuthEnable bool
-	var rotationInterval int
+// kmsAuthorization returns the authorization of the requests of the client,
+// the access token is requested with the API key of its config like the
+// client does
+func kmsAuthorization(kpAPI *kp.Client) (string, error) {
+	if kpAPI.Config.Authorization != "" {
+		return kpAPI.Config.Authorization, nil
+	}
+	ts := iam.CredentialFromAPIKey(kpAPI.Config.APIKey)
+	if kpAPI.Config.TokenURL != "" {
+		ts.TokenURL = kpAPI.Config.TokenURL
+	}
+	token, err := ts.Token()
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %s", token.TokenType, token.AccessToken), nil
+}
 
-	if policyInfo, ok := d.GetOk("policies"); ok {
+// kmsKeyState returns the state of the key, the states of the API are integers
+// based on NIST SP 800-57
+func kmsKeyState(state int) string {
+	switch state {
+	case 0:
+		return "pre_activation"
+	case 1:
+		return "active"
+	case 2:
+		return "disabled"
+	case 3:
+		return "deactivated"
+	case 5:
+		return "destroyed"
+	}
+	return strconv.Itoa(state)
+}
 
-		policyDataList := policyInfo.([]interface{})
-		policyData := policyDataList[0].(map[string]interface{})
+type kmsKeyVersion struct {
+	ID           string     `json:"id"`
+	CreationDate *time.Time `json:"creationDate"`
+}
 
-		if rpd, ok := policyData["rotation"]; ok {
-			rpdList := rpd.([]interface{})
-			if len(rpdList) != 0 {
-				rotationInterval = rpdList[0].(map[string]interface{})["interval_month"].(int)
-				setRotation = true
-			}
-		}
-		if dadp, ok := policyData["dual_auth_delete"]; ok {
-			dadpList := dadp.([]interface{})
-			if len(dadpList) != 0 {
-				dualAuthEnable = dadpList[0].(map[string]interface{})["enabled"].(bool)
-				setDualAuthDelete = true
-			}
-		}
+type kmsKeyVersions struct {
+	Versions []kmsKeyVersion `json:"resources"`
+}
 
-		_, err := kpAPI.SetPolicies(context.Background(), key_id, setRotation, rotationInterval, setDualAuthDelete, dualAuthEnable)
-		if err != nil {
-			return fmt.Errorf("Error while creating policies: %s", err)
+// getKmsKeyVersions returns the versions of the root key, the client doesn't
+// support listing them
+func getKmsKeyVersions(kpAPI *kp.Client, keyID string) ([]map[string]interface{}, error) {
+	keyVersions := kmsKeyVersions{}
+	if err := kmsKeyRequest(kpAPI, "GET", fmt.Sprintf("keys/%s/versions", keyID), nil, &keyVersions); err != nil {
+		return nil, err
+	}
+	versions := make([]map[string]interface{}, 0, len(keyVersions.Versions))
+	for _, version := range keyVersions.Versions {
+		v := map[string]interface{}{
+			"id": version.ID,
 		}
+		if version.CreationDate != nil {
+			v["creation_date"] = version.CreationDate.Format(time.RFC3339)
+		}
+		versions = append(versions, v)
 	}
-	return nil
+	return versions, nil
 }
@@ -0,0 +1,278 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMKmsKeyPolicies() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMKmsKeyPoliciesCreate,
+		Read:     resourceIBMKmsKeyPoliciesRead,
+		Update:   resourceIBMKmsKeyPoliciesUpdate,
+		Delete:   resourceIBMKmsKeyPoliciesDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Key protect or hpcs instance GUID",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID or alias of the key",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: InvokeValidator("ibm_kms_key_policies", "endpoint_type"),
+				Description:  "public or private",
+				ForceNew:     true,
+				Default:      "public",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Crn of the key",
+			},
+			"rotation": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"rotation", "dual_auth_delete"},
+				Description:  "Specifies the key rotation time interval in months, with a minimum of 1, and a maximum of 12",
+				Elem: &schema.Resource{
+					Schema: kmsKeyPolicySchema(map[string]*schema.Schema{
+						"interval_month": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: InvokeValidator("ibm_kms_key_policies", "interval_month"),
+							Description:  "Specifies the key rotation time interval in months",
+						},
+					}),
+				},
+			},
+			"dual_auth_delete": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: []string{"rotation", "dual_auth_delete"},
+				Description:  "Data associated with the dual authorization delete policy.",
+				Elem: &schema.Resource{
+					Schema: kmsKeyPolicySchema(map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, Key Protect enables a dual authorization policy on a single key.",
+						},
+					}),
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKeyPoliciesValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "endpoint_type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "public, private",
+		},
+		ValidateSchema{
+			Identifier:                 "interval_month",
+			ValidateFunctionIdentifier: IntBetween,
+			Type:                       TypeInt,
+			Required:                   true,
+			MinValue:                   "1",
+			MaxValue:                   "12",
+		},
+	)
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_kms_key_policies", Schema: validateSchema}
+	return &resourceValidator
+}
+
+// kmsKeyPolicySchema returns the schema of a key policy with its policy
+// specific attributes
+func kmsKeyPolicySchema(policySchema map[string]*schema.Schema) map[string]*schema.Schema {
+	s := map[string]*schema.Schema{
+		"id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The v4 UUID used to uniquely identify the policy resource, as specified by RFC 4122.",
+		},
+		"crn": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Cloud Resource Name (CRN) that uniquely identifies your cloud resources.",
+		},
+		"created_by": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The unique identifier for the resource that created the policy.",
+		},
+		"creation_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date the policy was created. The date format follows RFC 3339.",
+		},
+		"updated_by": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The unique identifier for the resource that updated the policy.",
+		},
+		"last_update_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Updates when the policy is replaced or modified. The date format follows RFC 3339.",
+		},
+	}
+	for k, v := range policySchema {
+		s[k] = v
+	}
+	return s
+}
+
+func resourceIBMKmsKeyPoliciesCreate(d *schema.ResourceData, meta interface{}) error {
+	instanceID := d.Get("instance_id").(string)
+	kpAPI, _, err := kmsInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return err
+	}
+
+	key, err := kpAPI.GetKey(context.Background(), d.Get("key_id").(string))
+	if err != nil {
+		return fmt.Errorf("Get Key failed with error: %s", err)
+	}
+
+	if err := setKmsKeyPolicies(d, kpAPI, key.ID); err != nil {
+		return err
+	}
+	d.SetId(key.CRN)
+
+	return resourceIBMKmsKeyPoliciesRead(d, meta)
+}
+
+func resourceIBMKmsKeyPoliciesRead(d *schema.ResourceData, meta interface{}) error {
+	crnData, err := parseKmsCRN(d.Id())
+	if err != nil {
+		return fmt.Errorf("Incorrect ID %s: ID should be the CRN of the key", d.Id())
+	}
+	instanceID := crnData[len(crnData)-3]
+	keyID := crnData[len(crnData)-1]
+
+	kpAPI, _, err := kmsCRNClient(meta, d.Id(), d.Get("endpoint_type").(string))
+	if err != nil {
+		return err
+	}
+
+	key, err := kpAPI.GetKey(context.Background(), keyID)
+	if err != nil {
+		if kpError, ok := err.(*kp.Error); ok && kpError.StatusCode == 404 {
+			log.Printf("[WARN] Key %s is not found, removing its policies from state", keyID)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Get Key failed with error: %s", err)
+	}
+
+	policies, err := kpAPI.GetPolicies(context.Background(), keyID)
+	if err != nil {
+		return fmt.Errorf("Failed to read policies: %s", err)
+	}
+	flattenedPolicies := flattenKeyPolicies(policies)[0]
+
+	d.Set("instance_id", instanceID)
+	// Keep the alias of the configuration
+	if d.Get("key_id").(string) == "" {
+		d.Set("key_id", keyID)
+	}
+	d.Set("crn", key.CRN)
+	d.Set("rotation", flattenedPolicies["rotation"])
+	d.Set("dual_auth_delete", flattenedPolicies["dual_auth_delete"])
+
+	return nil
+}
+
+func resourceIBMKmsKeyPoliciesUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChanges("rotation", "dual_auth_delete") {
+		crnData, err := parseKmsCRN(d.Id())
+		if err != nil {
+			return err
+		}
+		keyID := crnData[len(crnData)-1]
+
+		kpAPI, _, err := kmsCRNClient(meta, d.Id(), d.Get("endpoint_type").(string))
+		if err != nil {
+			return err
+		}
+		if err := setKmsKeyPolicies(d, kpAPI, keyID); err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMKmsKeyPoliciesRead(d, meta)
+}
+
+func resourceIBMKmsKeyPoliciesDelete(d *schema.ResourceData, meta interface{}) error {
+	crnData, err := parseKmsCRN(d.Id())
+	if err != nil {
+		return err
+	}
+	keyID := crnData[len(crnData)-1]
+
+	// A rotation policy cannot be removed from a key, only the dual
+	// authorization policy is disabled
+	if dualAuth := d.Get("dual_auth_delete").([]interface{}); len(dualAuth) > 0 && dualAuth[0].(map[string]interface{})["enabled"].(bool) {
+		kpAPI, _, err := kmsCRNClient(meta, d.Id(), d.Get("endpoint_type").(string))
+		if err != nil {
+			return err
+		}
+		_, err = kpAPI.SetDualAuthDeletePolicy(context.Background(), keyID, false)
+		if err != nil {
+			if kpError, ok := err.(*kp.Error); !ok || kpError.StatusCode != 404 {
+				return fmt.Errorf("Error while disabling the dual authorization delete policy: %s", err)
+			}
+		}
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func setKmsKeyPolicies(d *schema.ResourceData, kpAPI *kp.Client, keyID string) error {
+	var setRotation, setDualAuthDelete, dualAuthEnable bool
+	var rotationInterval int
+
+	if rotation := d.Get("rotation").([]interface{}); len(rotation) > 0 && rotation[0] != nil {
+		rotationInterval = rotation[0].(map[string]interface{})["interval_month"].(int)
+		setRotation = true
+	}
+	if dualAuth := d.Get("dual_auth_delete").([]interface{}); len(dualAuth) > 0 && dualAuth[0] != nil {
+		dualAuthEnable = dualAuth[0].(map[string]interface{})["enabled"].(bool)
+		setDualAuthDelete = true
+	}
+
+	_, err := kpAPI.SetPolicies(context.Background(), keyID, setRotation, rotationInterval, setDualAuthDelete, dualAuthEnable)
+	if err != nil {
+		return fmt.Errorf("Error while creating policies: %s", err)
+	}
+	return nil
+}
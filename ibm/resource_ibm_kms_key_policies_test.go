@@ -0,0 +1,73 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSKeyPolicies_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_kms_key_policies.policies"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKeyPoliciesConfig(instanceName, keyName, 3, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation.0.interval_month", "3"),
+					resource.TestCheckResourceAttr(resourceName, "dual_auth_delete.0.enabled", "true"),
+					resource.TestCheckResourceAttrPair(resourceName, "crn", "ibm_kms_key.test", "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsKeyPoliciesConfig(instanceName, keyName, 6, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation.0.interval_month", "6"),
+					resource.TestCheckResourceAttr(resourceName, "dual_auth_delete.0.enabled", "false"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoint_type"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsKeyPoliciesConfig(instanceName, keyName string, interval int, dualAuth bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+	resource "ibm_kms_key" "test" {
+		instance_id  = ibm_resource_instance.kms_instance.guid
+		key_name     = "%s"
+		standard_key = false
+		force_delete = true
+	}
+	resource "ibm_kms_key_policies" "policies" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		key_id      = ibm_kms_key.test.key_id
+		rotation {
+			interval_month = %d
+		}
+		dual_auth_delete {
+			enabled = %t
+		}
+	}
+`, instanceName, keyName, interval, dualAuth)
+}
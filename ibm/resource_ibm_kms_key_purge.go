@@ -0,0 +1,130 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMKmsKeyPurge() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMKmsKeyPurgeCreate,
+		Read:     resourceIBMKmsKeyPurgeRead,
+		Delete:   resourceIBMKmsKeyPurgeDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Key protect or hpcs instance GUID",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the deleted key",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: InvokeValidator("ibm_kms_key_purge", "endpoint_type"),
+				Description:  "public or private",
+				ForceNew:     true,
+				Default:      "public",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Crn of the purged key",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKeyPurgeValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "endpoint_type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "public, private",
+		},
+	)
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_kms_key_purge", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMKmsKeyPurgeCreate(d *schema.ResourceData, meta interface{}) error {
+	kpAPI, _, err := kmsInstanceClient(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return err
+	}
+
+	keyID := d.Get("key_id").(string)
+	// The CRN of the key is read first, the key is gone after its purge
+	key, err := kpAPI.GetKey(context.Background(), keyID)
+	if err != nil {
+		return fmt.Errorf("Get Key failed with error: %s", err)
+	}
+	if key.State != 5 {
+		return fmt.Errorf("Key %s is %s, only a deleted key can be purged", keyID, kmsKeyState(key.State))
+	}
+
+	// The client doesn't support the purge of keys
+	err = kmsKeyRequest(kpAPI, "DELETE", fmt.Sprintf("keys/%s/purge", keyID), nil, nil)
+	if err != nil {
+		return fmt.Errorf("Error while purging key %s: %s", keyID, err)
+	}
+	d.SetId(key.CRN)
+
+	return resourceIBMKmsKeyPurgeRead(d, meta)
+}
+
+func resourceIBMKmsKeyPurgeRead(d *schema.ResourceData, meta interface{}) error {
+	crnData, err := parseKmsCRN(d.Id())
+	if err != nil {
+		return fmt.Errorf("Incorrect ID %s: ID should be the CRN of the key", d.Id())
+	}
+	instanceID := crnData[len(crnData)-3]
+	keyID := crnData[len(crnData)-1]
+
+	kpAPI, _, err := kmsCRNClient(meta, d.Id(), d.Get("endpoint_type").(string))
+	if err != nil {
+		return err
+	}
+
+	// A purged key is not found anymore
+	key, err := kpAPI.GetKey(context.Background(), keyID)
+	if err != nil {
+		if kpError, ok := err.(*kp.Error); !ok || (kpError.StatusCode != 404 && kpError.StatusCode != 410) {
+			return fmt.Errorf("Get Key failed with error: %s", err)
+		}
+	} else if key.State != 5 {
+		log.Printf("[WARN] Key %s is %s, removing its purge from state", keyID, kmsKeyState(key.State))
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_id", instanceID)
+	d.Set("key_id", keyID)
+	d.Set("crn", d.Id())
+
+	return nil
+}
+
+func resourceIBMKmsKeyPurgeDelete(d *schema.ResourceData, meta interface{}) error {
+	// The purge of a key cannot be undone
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,47 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// A key can only be purged 4 hours after its deletion, the purge right after
+// the deletion is refused by the service
+func TestAccIBMKMSKeyPurge_recentlyDeleted(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKeyRestoreKeyConfig(instanceName, keyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "key_state", "active"),
+					testAccCheckIBMKmsKeyDeleted("ibm_kms_key.test"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMKmsKeyPurgeConfig(instanceName, keyName),
+				ExpectError: regexp.MustCompile("Error while purging key"),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsKeyPurgeConfig(instanceName, keyName string) string {
+	return testAccCheckIBMKmsKeyRestoreKeyConfig(instanceName, keyName) + `
+	resource "ibm_kms_key_purge" "purge" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		key_id      = ibm_kms_key.test.key_id
+	}
+`
+}
@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMKmsKeyRestore() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMKmsKeyRestoreCreate,
+		Read:     resourceIBMKmsKeyRestoreRead,
+		Delete:   resourceIBMKmsKeyRestoreDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Key protect or hpcs instance GUID",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the deleted key",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: InvokeValidator("ibm_kms_key_restore", "endpoint_type"),
+				Description:  "public or private",
+				ForceNew:     true,
+				Default:      "public",
+			},
+			"payload": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The base64 encoded key material of the imported key, required to restore an imported key",
+			},
+			"encrypted_nonce": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"payload", "iv_value"},
+				Description:  "The encrypted nonce value that verifies your request to restore a key with an import token",
+			},
+			"iv_value": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"payload", "encrypted_nonce"},
+				Description:  "The initialization vector that is generated when the nonce is encrypted",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Crn of the key",
+			},
+			"key_state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the restored key",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKeyRestoreValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "endpoint_type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "public, private",
+		},
+	)
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_kms_key_restore", Schema: validateSchema}
+	return &resourceValidator
+}
+
+type kmsKeyRestoreResource struct {
+	Payload        string `json:"payload"`
+	EncryptedNonce string `json:"encryptedNonce,omitempty"`
+	IV             string `json:"iv,omitempty"`
+}
+
+type kmsKeyRestoreRequest struct {
+	Metadata  kp.KeysMetadata         `json:"metadata"`
+	Resources []kmsKeyRestoreResource `json:"resources"`
+}
+
+func resourceIBMKmsKeyRestoreCreate(d *schema.ResourceData, meta interface{}) error {
+	kpAPI, _, err := kmsInstanceClient(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return err
+	}
+
+	keyID := d.Get("key_id").(string)
+	var key *kp.Key
+	if payload, ok := d.GetOk("payload"); ok {
+		// The client doesn't support the restore of imported keys, their key
+		// material is required
+		request := kmsKeyRestoreRequest{
+			Metadata: kp.KeysMetadata{
+				CollectionType: "application/vnd.ibm.kms.key+json",
+				NumberOfKeys:   1,
+			},
+			Resources: []kmsKeyRestoreResource{
+				{
+					Payload:        payload.(string),
+					EncryptedNonce: d.Get("encrypted_nonce").(string),
+					IV:             d.Get("iv_value").(string),
+				},
+			},
+		}
+		keys := kp.Keys{}
+		err = kmsKeyRequest(kpAPI, "POST", fmt.Sprintf("keys/%s/restore", keyID), request, &keys)
+		if err == nil && len(keys.Keys) > 0 {
+			key = &keys.Keys[0]
+		}
+	} else {
+		key, err = kpAPI.RestoreKey(context.Background(), keyID)
+	}
+	if err != nil {
+		return fmt.Errorf("Error while restoring key %s: %s", keyID, err)
+	}
+	if key == nil || key.CRN == "" {
+		key, err = kpAPI.GetKey(context.Background(), keyID)
+		if err != nil {
+			return fmt.Errorf("Get Key failed with error: %s", err)
+		}
+	}
+	d.SetId(key.CRN)
+
+	return resourceIBMKmsKeyRestoreRead(d, meta)
+}
+
+func resourceIBMKmsKeyRestoreRead(d *schema.ResourceData, meta interface{}) error {
+	crnData, err := parseKmsCRN(d.Id())
+	if err != nil {
+		return fmt.Errorf("Incorrect ID %s: ID should be the CRN of the key", d.Id())
+	}
+	instanceID := crnData[len(crnData)-3]
+	keyID := crnData[len(crnData)-1]
+
+	kpAPI, _, err := kmsCRNClient(meta, d.Id(), d.Get("endpoint_type").(string))
+	if err != nil {
+		return err
+	}
+
+	key, err := kpAPI.GetKey(context.Background(), keyID)
+	if err != nil {
+		if kpError, ok := err.(*kp.Error); ok && (kpError.StatusCode == 404 || kpError.StatusCode == 410) {
+			log.Printf("[WARN] Key %s is not found, removing its restore from state", keyID)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Get Key failed with error: %s", err)
+	}
+	// The key was deleted again after its restore
+	if key.State == 5 {
+		log.Printf("[WARN] Key %s is destroyed, removing its restore from state", keyID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_id", instanceID)
+	d.Set("key_id", keyID)
+	d.Set("crn", key.CRN)
+	d.Set("key_state", kmsKeyState(key.State))
+
+	return nil
+}
+
+func resourceIBMKmsKeyRestoreDelete(d *schema.ResourceData, meta interface{}) error {
+	// The restore of a key cannot be undone, the restored key is deleted
+	// by the ibm_kms_key resource
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMKMSKeyRestore_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_kms_key_restore.restore"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKeyRestoreKeyConfig(instanceName, keyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "key_state", "active"),
+					testAccCheckIBMKmsKeyDeleted("ibm_kms_key.test"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsKeyRestoreConfig(instanceName, keyName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "crn", "ibm_kms_key.test", "crn"),
+					resource.TestCheckResourceAttr(resourceName, "key_state", "active"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckIBMKmsKeyDeleted deletes the key outside of terraform, so that
+// it can be restored
+func testAccCheckIBMKmsKeyDeleted(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		crnData, err := parseKmsCRN(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		kpAPI, _, err := kmsCRNClient(testAccProvider.Meta(), rs.Primary.ID, "public")
+		if err != nil {
+			return err
+		}
+		_, err = kpAPI.DeleteKey(context.Background(), crnData[len(crnData)-1], kp.ReturnRepresentation, kp.ForceOpt{Force: true})
+		return err
+	}
+}
+
+func testAccCheckIBMKmsKeyRestoreKeyConfig(instanceName, keyName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name     = "%s"
+		service  = "kms"
+		plan     = "tiered-pricing"
+		location = "us-south"
+	}
+	resource "ibm_kms_key" "test" {
+		instance_id  = ibm_resource_instance.kms_instance.guid
+		key_name     = "%s"
+		standard_key = false
+		force_delete = true
+	}
+`, instanceName, keyName)
+}
+
+func testAccCheckIBMKmsKeyRestoreConfig(instanceName, keyName string) string {
+	return testAccCheckIBMKmsKeyRestoreKeyConfig(instanceName, keyName) + `
+	resource "ibm_kms_key_restore" "restore" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		key_id      = ibm_kms_key.test.key_id
+	}
+`
+}
@@ -209,6 +209,44 @@ func TestAccIBMKMSKeyPolicy_invalid_interval(t *testing.T) {
 	})
 }
 
+func TestAccIBMKMSResource_lifecycle(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMKmsKeyLifecycleConfig(instanceName, keyName, "active", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "key_state", "active"),
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "versions.#", "1"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMKmsKeyLifecycleConfig(instanceName, keyName, "active", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "versions.#", "2"),
+					resource.TestCheckResourceAttrSet("ibm_kms_key.test", "last_rotate_date"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMKmsKeyLifecycleConfig(instanceName, keyName, "disabled", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "key_state", "disabled"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMKmsKeyLifecycleConfig(instanceName, keyName, "active", "3"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "key_state", "active"),
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "versions.#", "3"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMKmsResourceStandardConfig(instanceName, KeyName string) string {
 	return fmt.Sprintf(`
 	resource "ibm_resource_instance" "kms_instance" {
@@ -407,3 +445,22 @@ func testAccCheckIBMKmsKeyPolicyDualAuth(instanceName, KeyName string, dual_auth
 	  }
 `, instanceName, KeyName, dual_auth_delete)
 }
+
+func testAccCheckIBMKmsKeyLifecycleConfig(instanceName, KeyName, keyState, rotateTrigger string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	}
+	resource "ibm_kms_key" "test" {
+		instance_id    = ibm_resource_instance.kms_instance.guid
+		key_name       = "%s"
+		standard_key   = false
+		force_delete   = true
+		key_state      = "%s"
+		rotate_trigger = "%s"
+	}
+`, instanceName, KeyName, keyState, rotateTrigger)
+}
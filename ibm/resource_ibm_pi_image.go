@@ -14,6 +14,14 @@ import (
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
 	st "github.com/IBM-Cloud/power-go-client/clients/instance"
 	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_images"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+)
+
+const (
+	PIImageStorageType = "pi_image_storage_type"
+	PIImageFailedState = "failed"
 )
 
 func resourceIBMPIImage() *schema.Resource {
@@ -41,11 +49,72 @@ func resourceIBMPIImage() *schema.Resource {
 
 			helpers.PIInstanceImageName: {
 				Type:             schema.TypeString,
-				Required:         true,
+				Optional:         true,
+				ExactlyOneOf:     []string{helpers.PIInstanceImageName, helpers.PIImageBucketName},
 				Description:      "Instance image name",
 				DiffSuppressFunc: applyOnce,
 			},
 
+			helpers.PIImageBucketName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{helpers.PIInstanceImageName, helpers.PIImageBucketName},
+				RequiredWith: []string{helpers.PIImageFileName, helpers.PIImageRegion, helpers.PIImageAccessKey, helpers.PIImageSecretKey},
+				Description:  "Cloud Object Storage bucket name; bucket-name[/optional/folder]",
+			},
+
+			helpers.PIImageFileName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{helpers.PIImageBucketName},
+				Description:  "Cloud Object Storage image filename, for example rhel.ova.gz",
+			},
+
+			helpers.PIImageRegion: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{helpers.PIImageBucketName},
+				Description:  "Cloud Object Storage region of the bucket",
+			},
+
+			helpers.PIImageAccessKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				RequiredWith: []string{helpers.PIImageBucketName},
+				Description:  "Cloud Object Storage HMAC access key",
+			},
+
+			helpers.PIImageSecretKey: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				RequiredWith: []string{helpers.PIImageBucketName},
+				Description:  "Cloud Object Storage HMAC secret key",
+			},
+
+			helpers.PIImageOsType: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{helpers.PIInstanceImageName},
+				ValidateFunc:  validateAllowedStringValue([]string{"aix", "ibmi", "redhat", "sles"}),
+				Description:   "Operating system type of the imported image, required for raw images",
+			},
+
+			PIImageStorageType: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{helpers.PIInstanceImageName},
+				Description:   "Storage type of the imported image, for example tier1 or tier3",
+			},
+
 			helpers.PICloudInstanceId: {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -59,6 +128,18 @@ func resourceIBMPIImage() *schema.Resource {
 				Computed:    true,
 				Description: "Image ID",
 			},
+
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Image state",
+			},
+
+			"storage_type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Storage type of the image",
+			},
 		},
 	}
 }
@@ -72,11 +153,28 @@ func resourceIBMPIImageCreate(d *schema.ResourceData, meta interface{}) error {
 
 	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
 	name := d.Get(helpers.PIImageName).(string)
-	imageid := d.Get(helpers.PIInstanceImageName).(string)
 
 	client := st.NewIBMPIImageClient(sess, powerinstanceid)
 
-	imageResponse, err := client.Create(name, imageid, powerinstanceid)
+	var imageResponse *models.Image
+	if v, ok := d.GetOk(helpers.PIImageBucketName); ok {
+		source := "url"
+		body := &models.CreateImage{
+			ImageName:     name,
+			Source:        &source,
+			BucketName:    v.(string),
+			ImageFilename: d.Get(helpers.PIImageFileName).(string),
+			Region:        d.Get(helpers.PIImageRegion).(string),
+			AccessKey:     d.Get(helpers.PIImageAccessKey).(string),
+			SecretKey:     d.Get(helpers.PIImageSecretKey).(string),
+			OsType:        d.Get(helpers.PIImageOsType).(string),
+			DiskType:      d.Get(PIImageStorageType).(string),
+		}
+		imageResponse, err = importIBMPIImage(sess, powerinstanceid, body)
+	} else {
+		imageid := d.Get(helpers.PIInstanceImageName).(string)
+		imageResponse, err = client.Create(name, imageid, powerinstanceid)
+	}
 	if err != nil {
 		return err
 	}
@@ -113,6 +211,10 @@ func resourceIBMPIImageRead(d *schema.ResourceData, meta interface{}) error {
 
 	imageid := *imagedata.ImageID
 	d.Set("image_id", imageid)
+	d.Set("state", imagedata.State)
+	if imagedata.StorageType != nil {
+		d.Set("storage_type", *imagedata.StorageType)
+	}
 	d.Set(helpers.PICloudInstanceId, powerinstanceid)
 
 	return nil
@@ -199,6 +301,27 @@ func isIBMPIImageRefreshFunc(client *st.IBMPIImageClient, id, powerinstanceid st
 			return image, helpers.PIImageActiveStatus, nil
 		}
 
+		if image.State == PIImageFailedState {
+			return image, image.State, fmt.Errorf("Power Image (%s) went into %s state", id, image.State)
+		}
+
 		return image, helpers.PIImageQueStatus, nil
 	}
 }
+
+// importIBMPIImage imports an image from a Cloud Object Storage bucket. The image
+// client only knows how to copy stock images, so the import goes straight to the API.
+func importIBMPIImage(sess *ibmpisession.IBMPISession, powerinstanceid string, body *models.CreateImage) (*models.Image, error) {
+	params := p_cloud_images.NewPcloudCloudinstancesImagesPostParamsWithTimeout(helpers.PICreateTimeOut).WithCloudInstanceID(powerinstanceid).WithBody(body)
+	ok, created, err := sess.Power.PCloudImages.PcloudCloudinstancesImagesPost(params, ibmpisession.NewAuth(sess, powerinstanceid))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to Import Image %s from bucket %s : %s", body.ImageFilename, body.BucketName, err)
+	}
+	if ok != nil && ok.Payload != nil {
+		return ok.Payload, nil
+	}
+	if created != nil && created.Payload != nil {
+		return created.Payload, nil
+	}
+	return nil, fmt.Errorf("Failed to Import Image %s from bucket %s : empty response", body.ImageFilename, body.BucketName)
+}
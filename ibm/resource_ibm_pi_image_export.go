@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/helpers"
+	"github.com/IBM-Cloud/power-go-client/ibmpisession"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_images"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+)
+
+func resourceIBMPIImageExport() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMPIImageExportCreate,
+		Read:   resourceIBMPIImageExportRead,
+		Delete: resourceIBMPIImageExportDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			helpers.PICloudInstanceId: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "PI cloud instance ID",
+			},
+
+			helpers.PIInstanceImageName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the image to export",
+			},
+
+			helpers.PIImageBucketName: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloud Object Storage bucket name; bucket-name[/optional/folder]",
+			},
+
+			helpers.PIImageRegion: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloud Object Storage region of the bucket",
+			},
+
+			helpers.PIImageAccessKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "Cloud Object Storage HMAC access key",
+			},
+
+			helpers.PIImageSecretKey: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "Cloud Object Storage HMAC secret key",
+			},
+
+			// Computed Attribute
+
+			"image_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the exported image",
+			},
+		},
+	}
+}
+
+func resourceIBMPIImageExportCreate(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+
+	powerinstanceid := d.Get(helpers.PICloudInstanceId).(string)
+	imageid := d.Get(helpers.PIInstanceImageName).(string)
+	bucket := d.Get(helpers.PIImageBucketName).(string)
+	accessKey := d.Get(helpers.PIImageAccessKey).(string)
+
+	body := &models.ExportImage{
+		BucketName: &bucket,
+		AccessKey:  &accessKey,
+		Region:     d.Get(helpers.PIImageRegion).(string),
+		SecretKey:  d.Get(helpers.PIImageSecretKey).(string),
+	}
+	params := p_cloud_images.NewPcloudCloudinstancesImagesExportPostParamsWithTimeout(helpers.PICreateTimeOut).WithCloudInstanceID(powerinstanceid).WithImageID(imageid).WithBody(body)
+	_, err = sess.Power.PCloudImages.PcloudCloudinstancesImagesExportPost(params, ibmpisession.NewAuth(sess, powerinstanceid))
+	if err != nil {
+		return fmt.Errorf("Failed to Export PI Image %s to bucket %s : %s", imageid, bucket, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", powerinstanceid, imageid, bucket))
+
+	// The export runs as a job against the image; it is done once the image is active again
+	client := st.NewIBMPIImageClient(sess, powerinstanceid)
+	_, err = isWaitForIBMPIImageAvailable(client, imageid, d.Timeout(schema.TimeoutCreate), powerinstanceid)
+	if err != nil {
+		log.Printf("[DEBUG]  err %s", err)
+		return err
+	}
+
+	return resourceIBMPIImageExportRead(d, meta)
+}
+
+func resourceIBMPIImageExportRead(d *schema.ResourceData, meta interface{}) error {
+	sess, err := meta.(ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) < 3 {
+		return fmt.Errorf("Incorrect ID %s: ID should be a combination of cloudInstanceID/imageID/bucketName", d.Id())
+	}
+	powerinstanceid := parts[0]
+	client := st.NewIBMPIImageClient(sess, powerinstanceid)
+	image, err := client.Get(parts[1], powerinstanceid)
+	if err != nil {
+		return err
+	}
+
+	d.Set(helpers.PICloudInstanceId, powerinstanceid)
+	d.Set(helpers.PIInstanceImageName, *image.ImageID)
+	d.Set(helpers.PIImageBucketName, strings.Join(parts[2:], "/"))
+	if image.Name != nil {
+		d.Set("image_name", *image.Name)
+	}
+
+	return nil
+}
+
+// The exported image lives in the bucket and is owned by the user from then on
+func resourceIBMPIImageExportDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
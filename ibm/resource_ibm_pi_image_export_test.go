@@ -0,0 +1,44 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMPIImageExportbasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIImageExportConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_pi_image_export.power_image_export", "pi_image_id", pi_image),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_image_export.power_image_export", "pi_image_bucket_name", pi_image_bucket_name),
+					resource.TestCheckResourceAttrSet(
+						"ibm_pi_image_export.power_image_export", "image_name"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIImageExportConfig() string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_image_export" "power_image_export" {
+		pi_cloud_instance_id = "%s"
+		pi_image_id          = "%s"
+		pi_image_bucket_name = "%s"
+		pi_image_region      = "%s"
+		pi_image_access_key  = "%s"
+		pi_image_secret_key  = "%s"
+	  }
+	`, pi_cloud_instance_id, pi_image, pi_image_bucket_name, pi_image_bucket_region, pi_image_bucket_access_key, pi_image_bucket_secret_key)
+}
@@ -34,6 +34,29 @@ func TestAccIBMPIImagebasic(t *testing.T) {
 		},
 	})
 }
+
+func TestAccIBMPIImageCOSImport(t *testing.T) {
+
+	name := fmt.Sprintf("tf-pi-image-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMPIImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIImageCOSImportConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIImageExists("ibm_pi_image.power_image"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_image.power_image", "pi_image_name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_image.power_image", "state", "active"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMPIImageDestroy(s *terraform.State) error {
 
 	sess, err := testAccProvider.Meta().(ClientSession).IBMPISession()
@@ -98,3 +121,18 @@ func testAccCheckIBMPIImageConfig(name string) string {
 	  }
 	`, name, pi_cloud_instance_id)
 }
+
+func testAccCheckIBMPIImageCOSImportConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_image" "power_image" {
+		pi_image_name        = "%s"
+		pi_cloud_instance_id = "%s"
+		pi_image_bucket_name = "%s"
+		pi_image_file_name   = "%s"
+		pi_image_region      = "%s"
+		pi_image_access_key  = "%s"
+		pi_image_secret_key  = "%s"
+		pi_image_os_type     = "redhat"
+	  }
+	`, name, pi_cloud_instance_id, pi_image_bucket_name, pi_image_bucket_file_name, pi_image_bucket_region, pi_image_bucket_access_key, pi_image_bucket_secret_key)
+}
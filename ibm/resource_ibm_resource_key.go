@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -12,6 +13,7 @@ import (
 	"time"
 
 	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
@@ -32,6 +34,12 @@ func resourceIBMResourceKey() *schema.Resource {
 			Delete: schema.DefaultTimeout(10 * time.Minute),
 		},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceKeyHMACCustomizeDiff(diff)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -71,6 +79,14 @@ func resourceIBMResourceKey() *schema.Resource {
 				Description:      "Arbitrary parameters to pass. Must be a JSON object",
 			},
 
+			"hmac": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Create HMAC credentials for Cloud Object Storage along with the key, it's true when the HMAC parameter is true",
+			},
+
 			"credentials": {
 				Description: "Credentials asociated with the key",
 				Type:        schema.TypeMap,
@@ -78,6 +94,20 @@ func resourceIBMResourceKey() *schema.Resource {
 				Computed:    true,
 			},
 
+			"hmac_access_key_id": {
+				Type:        schema.TypeString,
+				Sensitive:   true,
+				Computed:    true,
+				Description: "The HMAC access key ID of the Cloud Object Storage credentials",
+			},
+
+			"hmac_secret_access_key": {
+				Type:        schema.TypeString,
+				Sensitive:   true,
+				Computed:    true,
+				Description: "The HMAC secret access key of the Cloud Object Storage credentials",
+			},
+
 			"status": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -218,6 +248,9 @@ func resourceIBMResourceKeyCreate(d *schema.ResourceData, meta interface{}) erro
 			}
 		}
 	}
+	if d.Get("hmac").(bool) {
+		keyParameters.SetProperty("HMAC", true)
+	}
 
 	resourceInstance, sourceCRN, err := getResourceInstanceAndCRN(d, meta)
 	if err != nil {
@@ -280,6 +313,10 @@ func resourceIBMResourceKeyRead(d *schema.ResourceData, meta interface{}) error
 	cred, _ := json.Marshal(resourceKey.Credentials)
 	json.Unmarshal(cred, &credInterface)
 	d.Set("credentials", Flatten(credInterface))
+	accessKeyID, secretAccessKey, hmac := resourceKeyHMACCredentials(credInterface)
+	d.Set("hmac", hmac)
+	d.Set("hmac_access_key_id", accessKeyID)
+	d.Set("hmac_secret_access_key", secretAccessKey)
 	d.Set("name", *resourceKey.Name)
 	d.Set("status", *resourceKey.State)
 	if resourceKey.Credentials != nil && resourceKey.Credentials.IamRoleCRN != nil {
@@ -399,6 +436,33 @@ func resourceIBMResourceKeyExists(d *schema.ResourceData, meta interface{}) (boo
 	return *resourceKey.ID == resourceKeyID, nil
 }
 
+// resourceKeyHMACCustomizeDiff folds the HMAC parameter into hmac, the key
+// has HMAC credentials when either of them is true
+func resourceKeyHMACCustomizeDiff(diff *schema.ResourceDiff) error {
+	if diff.Get("hmac").(bool) {
+		return nil
+	}
+	parameters := diff.Get("parameters").(map[string]interface{})
+	if v, ok := parameters["HMAC"]; ok {
+		if hmac, err := strconv.ParseBool(fmt.Sprintf("%v", v)); err == nil && hmac {
+			return diff.SetNew("hmac", true)
+		}
+	}
+	return nil
+}
+
+// resourceKeyHMACCredentials returns the HMAC keys of Cloud Object Storage
+// credentials, which are nested in cos_hmac_keys
+func resourceKeyHMACCredentials(credentials map[string]interface{}) (accessKeyID, secretAccessKey string, ok bool) {
+	hmacKeys, ok := credentials["cos_hmac_keys"].(map[string]interface{})
+	if !ok {
+		return "", "", false
+	}
+	accessKeyID, _ = hmacKeys["access_key_id"].(string)
+	secretAccessKey, _ = hmacKeys["secret_access_key"].(string)
+	return accessKeyID, secretAccessKey, true
+}
+
 func getResourceInstanceAndCRN(d *schema.ResourceData, meta interface{}) (*rc.ResourceInstance, *string, error) {
 	rsContClient, err := meta.(ClientSession).ResourceControllerV2API()
 	if err != nil {
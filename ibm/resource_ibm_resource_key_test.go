@@ -94,6 +94,31 @@ func TestAccIBMResourceKey_Parameters(t *testing.T) {
 	})
 }
 
+func TestAccIBMResourceKey_HMAC(t *testing.T) {
+	resourceName := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
+	resourceKey := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("tf-cos-hmac-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMResourceKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMResourceKeyHMAC(resourceName, resourceKey, bucketName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMResourceKeyExists("ibm_resource_key.resourceKey"),
+					resource.TestCheckResourceAttr("ibm_resource_key.resourceKey", "hmac", "true"),
+					resource.TestCheckResourceAttrSet("ibm_resource_key.resourceKey", "hmac_access_key_id"),
+					resource.TestCheckResourceAttrSet("ibm_resource_key.resourceKey", "hmac_secret_access_key"),
+					resource.TestCheckResourceAttrPair("ibm_resource_key.resourceKey", "hmac_access_key_id", "ibm_resource_key.resourceKey", "credentials.cos_hmac_keys.access_key_id"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "bucket_name", bucketName),
+				),
+			},
+		},
+	})
+}
+
 func TestAccIBMResourceKeyWithCustomRole(t *testing.T) {
 	resourceName := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
 	resourceKey := fmt.Sprintf("tf-cos-%d", acctest.RandIntRange(10, 100))
@@ -269,3 +294,28 @@ func testAccCheckIBMResourceKeyParameters(resourceName, resourceKey string) stri
 		}
 	`, resourceName, resourceKey)
 }
+
+func testAccCheckIBMResourceKeyHMAC(resourceName, resourceKey, bucketName string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "resource" {
+			name              = "%s"
+			service           = "cloud-object-storage"
+			plan              = "standard"
+			location          = "global"
+		}
+		resource "ibm_resource_key" "resourceKey" {
+			name                 = "%s"
+			resource_instance_id = ibm_resource_instance.resource.id
+			role                 = "Writer"
+			hmac                 = true
+		}
+		resource "ibm_cos_bucket" "bucket" {
+			bucket_name            = "%s"
+			resource_instance_id   = ibm_resource_instance.resource.id
+			region_location        = "us-south"
+			storage_class          = "standard"
+			hmac_access_key_id     = ibm_resource_key.resourceKey.hmac_access_key_id
+			hmac_secret_access_key = ibm_resource_key.resourceKey.hmac_secret_access_key
+		}
+	`, resourceName, resourceKey, bucketName)
+}
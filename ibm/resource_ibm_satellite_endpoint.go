@@ -0,0 +1,526 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"bytes"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/internal/hashcode"
+)
+
+const (
+	satelliteLinkDefaultURL = "https://api.link.satellite.cloud.ibm.com"
+
+	satelliteEndpointEnabled = "enabled"
+)
+
+type satelliteEndpointCert struct {
+	Filename     string `json:"filename,omitempty"`
+	FileContents string `json:"file_contents,omitempty"`
+}
+
+type satelliteEndpointCertFiles struct {
+	Cert *satelliteEndpointCert `json:"cert,omitempty"`
+	Key  *satelliteEndpointCert `json:"key,omitempty"`
+}
+
+type satelliteEndpointCerts struct {
+	Client    *satelliteEndpointCertFiles `json:"client,omitempty"`
+	Server    *satelliteEndpointCertFiles `json:"server,omitempty"`
+	Connector *satelliteEndpointCertFiles `json:"connector,omitempty"`
+}
+
+type satelliteEndpointSource struct {
+	SourceID   string `json:"source_id"`
+	SourceName string `json:"source_name,omitempty"`
+	Enabled    bool   `json:"enabled"`
+	LastChange string `json:"last_change,omitempty"`
+}
+
+type satelliteEndpoint struct {
+	LocationID       string                    `json:"location_id,omitempty"`
+	EndpointID       string                    `json:"endpoint_id,omitempty"`
+	CRN              string                    `json:"crn,omitempty"`
+	ConnType         string                    `json:"conn_type,omitempty"`
+	DisplayName      string                    `json:"display_name,omitempty"`
+	ServerHost       string                    `json:"server_host,omitempty"`
+	ServerPort       int                       `json:"server_port,omitempty"`
+	SNI              string                    `json:"sni,omitempty"`
+	ClientProtocol   string                    `json:"client_protocol,omitempty"`
+	ClientMutualAuth *bool                     `json:"client_mutual_auth,omitempty"`
+	ServerProtocol   string                    `json:"server_protocol,omitempty"`
+	ServerMutualAuth *bool                     `json:"server_mutual_auth,omitempty"`
+	RejectUnauth     *bool                     `json:"reject_unauth,omitempty"`
+	Timeout          int                       `json:"timeout,omitempty"`
+	CreatedBy        string                    `json:"created_by,omitempty"`
+	Certs            *satelliteEndpointCerts   `json:"certs,omitempty"`
+	Sources          []satelliteEndpointSource `json:"sources,omitempty"`
+	Status           string                    `json:"status,omitempty"`
+	ClientHost       string                    `json:"client_host,omitempty"`
+	ClientPort       int                       `json:"client_port,omitempty"`
+	CreatedAt        string                    `json:"created_at,omitempty"`
+	LastChange       string                    `json:"last_change,omitempty"`
+}
+
+func resourceIBMSatelliteEndpoint() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMSatelliteEndpointCreate,
+		Read:     resourceIBMSatelliteEndpointRead,
+		Update:   resourceIBMSatelliteEndpointUpdate,
+		Delete:   resourceIBMSatelliteEndpointDelete,
+		Importer: &schema.ResourceImporter{},
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the Satellite location",
+			},
+			"connection_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"cloud", "location"}),
+				Description:  "Type of the endpoint. A cloud endpoint connects the location to a server in IBM Cloud, a location endpoint connects IBM Cloud to a server in the location",
+			},
+			"display_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Display name of the endpoint",
+			},
+			"server_host": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Host name or IP address of the destination server",
+			},
+			"server_port": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validateAllowedRangeInt(1, 65535),
+				Description:  "Port of the destination server",
+			},
+			"sni": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Server name indication that is sent to the destination server, for TLS and HTTPS server protocols",
+			},
+			"client_protocol": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"udp", "tcp", "tls", "http", "https", "http-tunnel"}),
+				Description:  "Protocol that the client uses to connect to the endpoint",
+			},
+			"client_mutual_auth": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Require a client certificate for TLS and HTTPS client protocols",
+			},
+			"server_protocol": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedStringValue([]string{"udp", "tcp", "tls"}),
+				Description:  "Protocol that the endpoint uses to connect to the destination server",
+			},
+			"server_mutual_auth": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Send the connector certificate to the destination server for the TLS server protocol",
+			},
+			"reject_unauth": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Reject connections to a destination server with a certificate that is not signed by a trusted CA",
+			},
+			"timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedRangeInt(1, 180),
+				Description:  "Inactivity timeout of the connections in seconds",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Service or person that created the endpoint",
+			},
+			"client_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM encoded certificate that the endpoint presents to the clients",
+			},
+			"server_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM encoded CA certificate that verifies the certificate of the destination server",
+			},
+			"connector_certificate": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM encoded certificate that the connector presents to the destination server, for server mutual authentication",
+			},
+			"connector_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "PEM encoded private key of the connector certificate",
+			},
+			"sources": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Set:         resourceIBMSatelliteEndpointSourceHash,
+				Description: "Sources that are allowed or denied to connect to the endpoint",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"source_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "ID of the source",
+						},
+						"enabled": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Allow the source to connect to the endpoint",
+						},
+					},
+				},
+			},
+			"endpoint_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the endpoint",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "CRN of the endpoint",
+			},
+			"client_host": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Host name that the clients connect to",
+			},
+			"client_port": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Port that the clients connect to",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the endpoint",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the endpoint",
+			},
+			"last_change": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last change time of the endpoint",
+			},
+		},
+	}
+}
+
+func resourceIBMSatelliteEndpointSourceHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["source_id"].(string)))
+	buf.WriteString(fmt.Sprintf("%t-", m["enabled"].(bool)))
+	return hashcode.String(buf.String())
+}
+
+func resourceIBMSatelliteEndpointCreate(d *schema.ResourceData, meta interface{}) error {
+	location := d.Get("location").(string)
+	endpoint := expandSatelliteEndpoint(d)
+	endpoint.ConnType = d.Get("connection_type").(string)
+	endpoint.CreatedBy = d.Get("created_by").(string)
+
+	result := &satelliteEndpoint{}
+	_, err := satelliteLinkRequest(meta, core.POST, fmt.Sprintf("/v1/locations/%s/endpoints", location), endpoint, result)
+	if err != nil {
+		return fmt.Errorf("Error creating Satellite endpoint %s: %s", endpoint.DisplayName, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", location, result.EndpointID))
+
+	if err := waitForSatelliteEndpoint(d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return err
+	}
+
+	if v, ok := d.GetOk("sources"); ok {
+		err = updateSatelliteEndpointSources(meta, location, result.EndpointID, v.(*schema.Set).List())
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMSatelliteEndpointRead(d, meta)
+}
+
+func resourceIBMSatelliteEndpointRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) < 2 {
+		return fmt.Errorf("Incorrect ID %s: ID should be a combination of location/endpointID", d.Id())
+	}
+	location := parts[0]
+	endpointID := parts[1]
+
+	endpoint := &satelliteEndpoint{}
+	response, err := satelliteLinkRequest(meta, core.GET, fmt.Sprintf("/v1/locations/%s/endpoints/%s", location, endpointID), nil, endpoint)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Satellite endpoint %s is not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Satellite endpoint %s: %s", d.Id(), err)
+	}
+
+	d.Set("location", location)
+	d.Set("endpoint_id", endpoint.EndpointID)
+	d.Set("connection_type", endpoint.ConnType)
+	d.Set("display_name", endpoint.DisplayName)
+	d.Set("server_host", endpoint.ServerHost)
+	d.Set("server_port", endpoint.ServerPort)
+	d.Set("sni", endpoint.SNI)
+	d.Set("client_protocol", endpoint.ClientProtocol)
+	d.Set("server_protocol", endpoint.ServerProtocol)
+	if endpoint.ClientMutualAuth != nil {
+		d.Set("client_mutual_auth", *endpoint.ClientMutualAuth)
+	}
+	if endpoint.ServerMutualAuth != nil {
+		d.Set("server_mutual_auth", *endpoint.ServerMutualAuth)
+	}
+	if endpoint.RejectUnauth != nil {
+		d.Set("reject_unauth", *endpoint.RejectUnauth)
+	}
+	d.Set("timeout", endpoint.Timeout)
+	d.Set("created_by", endpoint.CreatedBy)
+	d.Set("crn", endpoint.CRN)
+	d.Set("client_host", endpoint.ClientHost)
+	d.Set("client_port", endpoint.ClientPort)
+	d.Set("status", endpoint.Status)
+	d.Set("created_at", endpoint.CreatedAt)
+	d.Set("last_change", endpoint.LastChange)
+	// The contents of the certificates are not returned
+
+	// The sources of the location that were never configured for the endpoint
+	// are returned as disabled, so only the configured and enabled ones are kept
+	configured := map[string]bool{}
+	for _, s := range d.Get("sources").(*schema.Set).List() {
+		configured[s.(map[string]interface{})["source_id"].(string)] = true
+	}
+	sources := make([]map[string]interface{}, 0, len(endpoint.Sources))
+	for _, s := range endpoint.Sources {
+		if !s.Enabled && !configured[s.SourceID] {
+			continue
+		}
+		sources = append(sources, map[string]interface{}{
+			"source_id": s.SourceID,
+			"enabled":   s.Enabled,
+		})
+	}
+	d.Set("sources", sources)
+	return nil
+}
+
+func resourceIBMSatelliteEndpointUpdate(d *schema.ResourceData, meta interface{}) error {
+	location := d.Get("location").(string)
+	endpointID := d.Get("endpoint_id").(string)
+
+	if d.HasChanges("display_name", "server_host", "server_port", "sni", "client_protocol", "client_mutual_auth",
+		"server_protocol", "server_mutual_auth", "reject_unauth", "timeout",
+		"client_certificate", "server_certificate", "connector_certificate", "connector_key") {
+		endpoint := expandSatelliteEndpoint(d)
+		_, err := satelliteLinkRequest(meta, core.PATCH, fmt.Sprintf("/v1/locations/%s/endpoints/%s", location, endpointID), endpoint, nil)
+		if err != nil {
+			return fmt.Errorf("Error updating Satellite endpoint %s: %s", d.Id(), err)
+		}
+	}
+
+	if d.HasChange("sources") {
+		o, n := d.GetChange("sources")
+		sources := n.(*schema.Set).List()
+		// Sources that are removed from the configuration are disabled
+		current := map[string]bool{}
+		for _, s := range sources {
+			current[s.(map[string]interface{})["source_id"].(string)] = true
+		}
+		for _, s := range o.(*schema.Set).List() {
+			id := s.(map[string]interface{})["source_id"].(string)
+			if !current[id] {
+				current[id] = true
+				sources = append(sources, map[string]interface{}{
+					"source_id": id,
+					"enabled":   false,
+				})
+			}
+		}
+		err := updateSatelliteEndpointSources(meta, location, endpointID, sources)
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMSatelliteEndpointRead(d, meta)
+}
+
+func resourceIBMSatelliteEndpointDelete(d *schema.ResourceData, meta interface{}) error {
+	location := d.Get("location").(string)
+	endpointID := d.Get("endpoint_id").(string)
+
+	response, err := satelliteLinkRequest(meta, core.DELETE, fmt.Sprintf("/v1/locations/%s/endpoints/%s", location, endpointID), nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error deleting Satellite endpoint %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func expandSatelliteEndpoint(d *schema.ResourceData) *satelliteEndpoint {
+	clientMutualAuth := d.Get("client_mutual_auth").(bool)
+	serverMutualAuth := d.Get("server_mutual_auth").(bool)
+	rejectUnauth := d.Get("reject_unauth").(bool)
+	endpoint := &satelliteEndpoint{
+		DisplayName:      d.Get("display_name").(string),
+		ServerHost:       d.Get("server_host").(string),
+		ServerPort:       d.Get("server_port").(int),
+		SNI:              d.Get("sni").(string),
+		ClientProtocol:   d.Get("client_protocol").(string),
+		ClientMutualAuth: &clientMutualAuth,
+		ServerProtocol:   d.Get("server_protocol").(string),
+		ServerMutualAuth: &serverMutualAuth,
+		RejectUnauth:     &rejectUnauth,
+		Timeout:          d.Get("timeout").(int),
+	}
+
+	certs := &satelliteEndpointCerts{}
+	hasCerts := false
+	if v, ok := d.GetOk("client_certificate"); ok {
+		certs.Client = &satelliteEndpointCertFiles{Cert: &satelliteEndpointCert{Filename: "client.pem", FileContents: v.(string)}}
+		hasCerts = true
+	}
+	if v, ok := d.GetOk("server_certificate"); ok {
+		certs.Server = &satelliteEndpointCertFiles{Cert: &satelliteEndpointCert{Filename: "server.pem", FileContents: v.(string)}}
+		hasCerts = true
+	}
+	if v, ok := d.GetOk("connector_certificate"); ok {
+		certs.Connector = &satelliteEndpointCertFiles{
+			Cert: &satelliteEndpointCert{Filename: "connector.pem", FileContents: v.(string)},
+			Key:  &satelliteEndpointCert{Filename: "connector.key", FileContents: d.Get("connector_key").(string)},
+		}
+		hasCerts = true
+	}
+	if hasCerts {
+		endpoint.Certs = certs
+	}
+	return endpoint
+}
+
+func updateSatelliteEndpointSources(meta interface{}, location, endpointID string, sources []interface{}) error {
+	body := struct {
+		Sources []satelliteEndpointSource `json:"sources"`
+	}{}
+	for _, s := range sources {
+		source := s.(map[string]interface{})
+		body.Sources = append(body.Sources, satelliteEndpointSource{
+			SourceID: source["source_id"].(string),
+			Enabled:  source["enabled"].(bool),
+		})
+	}
+	_, err := satelliteLinkRequest(meta, core.PATCH, fmt.Sprintf("/v1/locations/%s/endpoints/%s/sources", location, endpointID), body, nil)
+	if err != nil {
+		return fmt.Errorf("Error updating the sources of Satellite endpoint %s: %s", endpointID, err)
+	}
+	return nil
+}
+
+func waitForSatelliteEndpoint(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	location := d.Get("location").(string)
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	endpointID := parts[1]
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"creating"},
+		Target:  []string{satelliteEndpointEnabled, "disabled"},
+		Refresh: func() (interface{}, string, error) {
+			endpoint := &satelliteEndpoint{}
+			_, err := satelliteLinkRequest(meta, core.GET, fmt.Sprintf("/v1/locations/%s/endpoints/%s", location, endpointID), nil, endpoint)
+			if err != nil {
+				return nil, "", err
+			}
+			if endpoint.Status == "" {
+				return endpoint, "creating", nil
+			}
+			return endpoint, endpoint.Status, nil
+		},
+		Timeout:    timeout,
+		Delay:      5 * time.Second,
+		MinTimeout: 5 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for Satellite endpoint %s to be created: %s", d.Id(), err)
+	}
+	return nil
+}
+
+// satelliteLinkRequest sends a request to the Satellite Link API. The Link API
+// is not part of the Kubernetes Service SDK, so the request is sent with the
+// authenticator of the Satellite client.
+func satelliteLinkRequest(meta interface{}, method, path string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	satClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return nil, err
+	}
+	builder := core.NewRequestBuilder(method)
+	_, err = builder.ResolveRequestURL(envFallBack([]string{"IBMCLOUD_SATELLITE_LINK_API_ENDPOINT"}, satelliteLinkDefaultURL), path, nil)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		_, err = builder.SetBodyContentJSON(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return satClient.Service.Request(request, result)
+}
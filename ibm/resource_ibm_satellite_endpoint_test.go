@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSatelliteEndpointBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-sat-endpoint-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSatelliteEndpointBasic(name, 443, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_satellite_endpoint.endpoint", "display_name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_satellite_endpoint.endpoint", "client_protocol", "https"),
+					resource.TestCheckResourceAttr(
+						"ibm_satellite_endpoint.endpoint", "status", "enabled"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_satellite_endpoint.endpoint", "client_host"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSatelliteEndpointBasic(name, 8443, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_satellite_endpoint.endpoint", "server_port", "8443"),
+					resource.TestCheckResourceAttr(
+						"ibm_satellite_endpoint.endpoint", "sources.#", "1"),
+				),
+			},
+			{
+				ResourceName:      "ibm_satellite_endpoint.endpoint",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSatelliteEndpointBasic(name string, port int, allowlist bool) string {
+	sources := ""
+	if allowlist {
+		sources = `
+	  sources {
+		source_id = ibm_satellite_link_source.source.source_id
+	  }`
+	}
+	return fmt.Sprintf(`
+	resource "ibm_satellite_link_source" "source" {
+	  location  = "%[1]s"
+	  name      = "%[2]s"
+	  addresses = ["192.168.10.0/24"]
+	}
+	resource "ibm_satellite_endpoint" "endpoint" {
+	  location        = "%[1]s"
+	  connection_type = "cloud"
+	  display_name    = "%[2]s"
+	  server_host     = "cloud.ibm.com"
+	  server_port     = %[3]d
+	  sni             = "cloud.ibm.com"
+	  client_protocol = "https"
+	  server_protocol = "tls"
+	  reject_unauth   = true
+	  %[4]s
+	}
+	`, satelliteLocationID, name, port, sources)
+}
@@ -0,0 +1,456 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.ibm.com/ibmcloud/kubernetesservice-go-sdk/kubernetesserviceapiv1"
+)
+
+const (
+	hostPoolWaitingStatus = "waiting"
+	hostPoolFoundStatus   = "found"
+)
+
+func resourceIBMSatelliteHostPool() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceIBMSatelliteHostPoolCreate,
+		Read:   resourceIBMSatelliteHostPoolRead,
+		Update: resourceIBMSatelliteHostPoolUpdate,
+		Delete: resourceIBMSatelliteHostPoolDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(75 * time.Minute),
+			Update: schema.DefaultTimeout(75 * time.Minute),
+			Delete: schema.DefaultTimeout(45 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			hostLocation: {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name or ID of the Satellite location",
+			},
+			hostCluster: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name or ID of the Satellite cluster to assign the hosts to. By default, the hosts are assigned to the control plane of the location",
+			},
+			hostWorkerPool: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{hostCluster},
+				Description:  "The name or ID of the worker pool within the cluster to assign the hosts to",
+			},
+			"host_selector": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Labels that the attached hosts must have to be assigned",
+			},
+			"host_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateAllowedRangeInt(1, 1000),
+				Description:  "Number of hosts to assign. By default, all the attached hosts that match the selector are assigned",
+			},
+			"zones": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Zones to spread the hosts across. By default, the zones of the location or of the worker pool",
+			},
+			"hosts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Assigned hosts",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the host",
+						},
+						"host_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the host",
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Zone the host is assigned to",
+						},
+						"health_state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Health status of the host",
+						},
+						"health_message": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Health message of the host",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMSatelliteHostPoolCreate(d *schema.ResourceData, meta interface{}) error {
+	location := d.Get(hostLocation).(string)
+
+	zones, err := satelliteHostPoolZones(d, meta)
+	if err != nil {
+		return err
+	}
+	d.Set("zones", zones)
+
+	count := 0
+	if v, ok := d.GetOk("host_count"); ok {
+		count = v.(int)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", location, resource.UniqueId()))
+
+	err = assignSatelliteHostPoolHosts(d, meta, []kubernetesserviceapiv1.MultishiftQueueNode{}, count, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
+
+	return resourceIBMSatelliteHostPoolRead(d, meta)
+}
+
+func resourceIBMSatelliteHostPoolRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	location := parts[0]
+
+	members, err := getSatelliteHostPoolMembers(d, meta)
+	if err != nil {
+		return err
+	}
+
+	hosts := make([]map[string]interface{}, 0, len(members))
+	for _, h := range members {
+		host := map[string]interface{}{
+			"host_id":   stringValue(h.ID),
+			"host_name": stringValue(h.Name),
+		}
+		if h.Assignment != nil {
+			host["zone"] = stringValue(h.Assignment.Zone)
+		}
+		if h.Health != nil {
+			host["health_state"] = stringValue(h.Health.Status)
+			host["health_message"] = stringValue(h.Health.Message)
+		}
+		hosts = append(hosts, host)
+	}
+
+	d.Set(hostLocation, location)
+	d.Set("hosts", hosts)
+	// Hosts that were removed outside of Terraform are replaced at the next apply
+	d.Set("host_count", len(members))
+	return nil
+}
+
+func resourceIBMSatelliteHostPoolUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("host_count") {
+		members, err := getSatelliteHostPoolMembers(d, meta)
+		if err != nil {
+			return err
+		}
+		count := d.Get("host_count").(int)
+		if count > len(members) {
+			err = assignSatelliteHostPoolHosts(d, meta, members, count-len(members), d.Timeout(schema.TimeoutUpdate))
+		} else if count < len(members) {
+			err = removeSatelliteHostPoolHosts(d, meta, members, len(members)-count)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceIBMSatelliteHostPoolRead(d, meta)
+}
+
+func resourceIBMSatelliteHostPoolDelete(d *schema.ResourceData, meta interface{}) error {
+	members, err := getSatelliteHostPoolMembers(d, meta)
+	if err != nil {
+		return err
+	}
+	err = removeSatelliteHostPoolHosts(d, meta, members, len(members))
+	if err != nil {
+		return err
+	}
+
+	d.SetId("")
+	return nil
+}
+
+// satelliteHostPoolZones returns the zones to spread the hosts across
+func satelliteHostPoolZones(d *schema.ResourceData, meta interface{}) ([]string, error) {
+	if v, ok := d.GetOk("zones"); ok {
+		return expandStringList(v.([]interface{})), nil
+	}
+
+	satClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return nil, err
+	}
+
+	location := d.Get(hostLocation).(string)
+	if v, ok := d.GetOk(hostWorkerPool); ok {
+		cluster := d.Get(hostCluster).(string)
+		workerPool := v.(string)
+		pool, response, err := satClient.GetWorkerPool(&kubernetesserviceapiv1.GetWorkerPoolOptions{
+			Cluster:    &cluster,
+			Workerpool: &workerPool,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("Error getting worker pool %s of cluster %s: %s\n%s", workerPool, cluster, err, response)
+		}
+		zones := make([]string, 0, len(pool.Zones))
+		for _, z := range pool.Zones {
+			zones = append(zones, stringValue(z.ID))
+		}
+		return zones, nil
+	}
+
+	locData, response, err := satClient.GetSatelliteLocation(&kubernetesserviceapiv1.GetSatelliteLocationOptions{
+		Controller: &location,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error getting Satellite location (%s): %s\n%s", location, err, response)
+	}
+	return locData.WorkerZones, nil
+}
+
+// getSatelliteHostPoolMembers returns the hosts of the location that are
+// recorded in the state, in the order of the state
+func getSatelliteHostPoolMembers(d *schema.ResourceData, meta interface{}) ([]kubernetesserviceapiv1.MultishiftQueueNode, error) {
+	hosts, err := listSatelliteHosts(d.Get(hostLocation).(string), meta)
+	if err != nil {
+		return nil, err
+	}
+	byID := map[string]kubernetesserviceapiv1.MultishiftQueueNode{}
+	for _, h := range hosts {
+		byID[stringValue(h.ID)] = h
+	}
+
+	members := []kubernetesserviceapiv1.MultishiftQueueNode{}
+	for _, v := range d.Get("hosts").([]interface{}) {
+		id := v.(map[string]interface{})["host_id"].(string)
+		if h, ok := byID[id]; ok {
+			members = append(members, h)
+		} else {
+			log.Printf("[WARN] Satellite host %s is not found in location %s", id, d.Get(hostLocation).(string))
+		}
+	}
+	return members, nil
+}
+
+func listSatelliteHosts(location string, meta interface{}) ([]kubernetesserviceapiv1.MultishiftQueueNode, error) {
+	satClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return nil, err
+	}
+	hosts, response, err := satClient.GetSatelliteHosts(&kubernetesserviceapiv1.GetSatelliteHostsOptions{
+		Controller: &location,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error getting the hosts of Satellite location (%s): %s\n%s", location, err, response)
+	}
+	return hosts, nil
+}
+
+// isSatelliteHostPoolCandidate reports if an attached host is not assigned
+// yet and has all the labels of the selector
+func isSatelliteHostPoolCandidate(h kubernetesserviceapiv1.MultishiftQueueNode, selector map[string]string) bool {
+	if h.Health == nil || stringValue(h.Health.Status) != rsHostReadyStatus {
+		return false
+	}
+	if h.Assignment != nil && stringValue(h.Assignment.ClusterID) != "" {
+		return false
+	}
+	for k, v := range selector {
+		if h.Labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// assignSatelliteHostPoolHosts waits until count attached hosts match the
+// selector and assigns them to the zones with the fewest hosts of the pool. If
+// count is 0, all the matching hosts are assigned.
+func assignSatelliteHostPoolHosts(d *schema.ResourceData, meta interface{}, members []kubernetesserviceapiv1.MultishiftQueueNode, count int, timeout time.Duration) error {
+	satClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+	location := d.Get(hostLocation).(string)
+	selector := expandStringMap(d.Get("host_selector").(map[string]interface{}))
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{hostPoolWaitingStatus},
+		Target:  []string{hostPoolFoundStatus},
+		Refresh: func() (interface{}, string, error) {
+			hosts, err := listSatelliteHosts(location, meta)
+			if err != nil {
+				return nil, "", err
+			}
+			candidates := []kubernetesserviceapiv1.MultishiftQueueNode{}
+			for _, h := range hosts {
+				if isSatelliteHostPoolCandidate(h, selector) {
+					candidates = append(candidates, h)
+				}
+			}
+			if len(candidates) == 0 || len(candidates) < count {
+				log.Printf("[DEBUG] %d of %d hosts that match the selector are attached to Satellite location %s", len(candidates), count, location)
+				return candidates, hostPoolWaitingStatus, nil
+			}
+			return candidates, hostPoolFoundStatus, nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 60 * time.Second,
+	}
+	result, err := stateConf.WaitForState()
+	if err != nil {
+		return fmt.Errorf("Error waiting for hosts that match the selector to be attached to Satellite location %s: %s", location, err)
+	}
+	candidates := result.([]kubernetesserviceapiv1.MultishiftQueueNode)
+	// Assign the hosts in a stable order
+	sort.Slice(candidates, func(i, j int) bool {
+		return stringValue(candidates[i].Name) < stringValue(candidates[j].Name)
+	})
+	if count > 0 {
+		candidates = candidates[:count]
+	}
+
+	zones := expandStringList(d.Get("zones").([]interface{}))
+	zoneCount := map[string]int{}
+	for _, h := range members {
+		if h.Assignment != nil {
+			zoneCount[stringValue(h.Assignment.Zone)]++
+		}
+	}
+
+	cluster := location
+	if v, ok := d.GetOk(hostCluster); ok {
+		cluster = v.(string)
+	}
+	assigned := d.Get("hosts").([]interface{})
+	for _, h := range candidates {
+		assignOptions := &kubernetesserviceapiv1.CreateSatelliteAssignmentOptions{
+			Controller: ptrToString(location),
+			Cluster:    ptrToString(cluster),
+			HostID:     h.ID,
+			Labels:     map[string]string{},
+		}
+		if v, ok := d.GetOk(hostWorkerPool); ok {
+			assignOptions.Workerpool = ptrToString(v.(string))
+		}
+		if len(zones) > 0 {
+			zone := zones[0]
+			for _, z := range zones {
+				if zoneCount[z] < zoneCount[zone] {
+					zone = z
+				}
+			}
+			zoneCount[zone]++
+			assignOptions.Zone = ptrToString(zone)
+		}
+
+		_, response, err := satClient.CreateSatelliteAssignment(assignOptions)
+		if err != nil {
+			d.Set("hosts", assigned)
+			return fmt.Errorf("Error Assigning Satellite Host %s: %s\n%s", stringValue(h.Name), err, response)
+		}
+		assigned = append(assigned, map[string]interface{}{
+			"host_id":   stringValue(h.ID),
+			"host_name": stringValue(h.Name),
+			"zone":      stringValue(assignOptions.Zone),
+		})
+	}
+	// The assigned hosts are recorded before waiting, so that they are
+	// tracked even if a host does not become healthy
+	d.Set("hosts", assigned)
+
+	for _, h := range candidates {
+		_, err = waitForHostAttachment(stringValue(h.Name), location, d, meta)
+		if err != nil {
+			return fmt.Errorf(
+				"Error waiting for host (%s) to get normal state: %s", stringValue(h.Name), err)
+		}
+	}
+	return nil
+}
+
+// removeSatelliteHostPoolHosts removes count hosts of the pool from the
+// location, from the zones with the most hosts first
+func removeSatelliteHostPoolHosts(d *schema.ResourceData, meta interface{}, members []kubernetesserviceapiv1.MultishiftQueueNode, count int) error {
+	satClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+	location := d.Get(hostLocation).(string)
+
+	zoneHosts := map[string][]kubernetesserviceapiv1.MultishiftQueueNode{}
+	for _, h := range members {
+		zone := ""
+		if h.Assignment != nil {
+			zone = stringValue(h.Assignment.Zone)
+		}
+		zoneHosts[zone] = append(zoneHosts[zone], h)
+	}
+
+	removed := map[string]bool{}
+	for i := 0; i < count; i++ {
+		zone := ""
+		max := -1
+		for z, hosts := range zoneHosts {
+			if len(hosts) > max || (len(hosts) == max && z < zone) {
+				zone, max = z, len(hosts)
+			}
+		}
+		hosts := zoneHosts[zone]
+		h := hosts[len(hosts)-1]
+		zoneHosts[zone] = hosts[:len(hosts)-1]
+
+		response, err := satClient.RemoveSatelliteHost(&kubernetesserviceapiv1.RemoveSatelliteHostOptions{
+			Controller: &location,
+			HostID:     h.ID,
+		})
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			return fmt.Errorf("Error Deleting Satellite Host %s: %s\n%s", stringValue(h.Name), err, response)
+		}
+		removed[stringValue(h.ID)] = true
+
+		remaining := []interface{}{}
+		for _, v := range d.Get("hosts").([]interface{}) {
+			if !removed[v.(map[string]interface{})["host_id"].(string)] {
+				remaining = append(remaining, v)
+			}
+		}
+		d.Set("hosts", remaining)
+	}
+	return nil
+}
@@ -0,0 +1,52 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSatelliteHostPoolBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSatelliteHostPoolBasic(3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_satellite_host_pool.control_plane", "host_count", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_satellite_host_pool.control_plane", "hosts.#", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_satellite_host_pool.control_plane", "zones.#", "3"),
+					resource.TestCheckResourceAttr(
+						"ibm_satellite_host_pool.control_plane", "hosts.0.health_state", "normal"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSatelliteHostPoolBasic(6),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_satellite_host_pool.control_plane", "hosts.#", "6"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSatelliteHostPoolBasic(count int) string {
+	return fmt.Sprintf(`
+	resource "ibm_satellite_host_pool" "control_plane" {
+	  location      = "%s"
+	  host_selector = {
+		use = "control-plane"
+	  }
+	  host_count = %d
+	}
+	`, satelliteLocationID, count)
+}
@@ -0,0 +1,170 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+type satelliteLinkSource struct {
+	SourceID   string   `json:"source_id,omitempty"`
+	Type       string   `json:"type,omitempty"`
+	Name       string   `json:"name,omitempty"`
+	Addresses  []string `json:"addresses,omitempty"`
+	CreatedAt  string   `json:"created_at,omitempty"`
+	LastChange string   `json:"last_change,omitempty"`
+}
+
+func resourceIBMSatelliteLinkSource() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMSatelliteLinkSourceCreate,
+		Read:     resourceIBMSatelliteLinkSourceRead,
+		Update:   resourceIBMSatelliteLinkSourceUpdate,
+		Delete:   resourceIBMSatelliteLinkSourceDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the Satellite location",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "user",
+				ValidateFunc: validateAllowedStringValue([]string{"user", "service"}),
+				Description:  "Type of the source",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the source",
+			},
+			"addresses": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "IP addresses, IP ranges and CIDR blocks of the source",
+			},
+			"source_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the source",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the source",
+			},
+			"last_change": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last change time of the source",
+			},
+		},
+	}
+}
+
+func resourceIBMSatelliteLinkSourceCreate(d *schema.ResourceData, meta interface{}) error {
+	location := d.Get("location").(string)
+	source := &satelliteLinkSource{
+		Type:      d.Get("type").(string),
+		Name:      d.Get("name").(string),
+		Addresses: expandStringList(d.Get("addresses").([]interface{})),
+	}
+
+	result := &satelliteLinkSource{}
+	_, err := satelliteLinkRequest(meta, core.POST, fmt.Sprintf("/v1/locations/%s/sources", location), source, result)
+	if err != nil {
+		return fmt.Errorf("Error creating Satellite Link source %s: %s", source.Name, err)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", location, result.SourceID))
+
+	return resourceIBMSatelliteLinkSourceRead(d, meta)
+}
+
+func resourceIBMSatelliteLinkSourceRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) < 2 {
+		return fmt.Errorf("Incorrect ID %s: ID should be a combination of location/sourceID", d.Id())
+	}
+	location := parts[0]
+	sourceID := parts[1]
+
+	// The Link API does not return a single source
+	result := struct {
+		Sources []satelliteLinkSource `json:"sources"`
+	}{}
+	response, err := satelliteLinkRequest(meta, core.GET, fmt.Sprintf("/v1/locations/%s/sources", location), nil, &result)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Satellite Link sources of location %s: %s", location, err)
+	}
+
+	var source *satelliteLinkSource
+	for i := range result.Sources {
+		if result.Sources[i].SourceID == sourceID {
+			source = &result.Sources[i]
+			break
+		}
+	}
+	if source == nil {
+		log.Printf("[WARN] Satellite Link source %s is not found, removing it from state", d.Id())
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("location", location)
+	d.Set("source_id", source.SourceID)
+	d.Set("type", source.Type)
+	d.Set("name", source.Name)
+	d.Set("addresses", source.Addresses)
+	d.Set("created_at", source.CreatedAt)
+	d.Set("last_change", source.LastChange)
+	return nil
+}
+
+func resourceIBMSatelliteLinkSourceUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChanges("name", "addresses") {
+		source := &satelliteLinkSource{
+			Name:      d.Get("name").(string),
+			Addresses: expandStringList(d.Get("addresses").([]interface{})),
+		}
+		path := fmt.Sprintf("/v1/locations/%s/sources/%s", d.Get("location").(string), d.Get("source_id").(string))
+		_, err := satelliteLinkRequest(meta, core.PATCH, path, source, nil)
+		if err != nil {
+			return fmt.Errorf("Error updating Satellite Link source %s: %s", d.Id(), err)
+		}
+	}
+	return resourceIBMSatelliteLinkSourceRead(d, meta)
+}
+
+func resourceIBMSatelliteLinkSourceDelete(d *schema.ResourceData, meta interface{}) error {
+	path := fmt.Sprintf("/v1/locations/%s/sources/%s", d.Get("location").(string), d.Get("source_id").(string))
+	response, err := satelliteLinkRequest(meta, core.DELETE, path, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error deleting Satellite Link source %s: %s", d.Id(), err)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSatelliteLinkSourceBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-sat-source-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSatelliteLinkSourceBasic(name, `["192.168.10.0/24"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_satellite_link_source.source", "name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_satellite_link_source.source", "type", "user"),
+					resource.TestCheckResourceAttr(
+						"ibm_satellite_link_source.source", "addresses.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSatelliteLinkSourceBasic(name, `["192.168.10.0/24", "10.10.10.1"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_satellite_link_source.source", "addresses.#", "2"),
+				),
+			},
+			{
+				ResourceName:      "ibm_satellite_link_source.source",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSatelliteLinkSourceBasic(name, addresses string) string {
+	return fmt.Sprintf(`
+	resource "ibm_satellite_link_source" "source" {
+	  location  = "%s"
+	  name      = "%s"
+	  addresses = %s
+	}
+	`, satelliteLocationID, name, addresses)
+}
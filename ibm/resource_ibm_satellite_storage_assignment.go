@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.ibm.com/ibmcloud/kubernetesservice-go-sdk/kubernetesserviceapiv1"
+)
+
+func resourceIBMSatelliteStorageAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMSatelliteStorageAssignmentCreate,
+		Read:     resourceIBMSatelliteStorageAssignmentRead,
+		Update:   resourceIBMSatelliteStorageAssignmentUpdate,
+		Delete:   resourceIBMSatelliteStorageAssignmentDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"assignment_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the assignment",
+			},
+			"config": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the storage configuration to assign",
+			},
+			"config_version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Version of the storage configuration to assign",
+			},
+			"groups": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Cluster groups to assign the storage configuration to",
+			},
+			"config_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "UUID of the storage configuration",
+			},
+			"config_version_uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "UUID of the storage configuration version",
+			},
+			"owner": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Owner of the assignment",
+			},
+			"created": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the assignment",
+			},
+			"updated": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last update time of the assignment",
+			},
+		},
+	}
+}
+
+func resourceIBMSatelliteStorageAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
+	satClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+
+	name := d.Get("assignment_name").(string)
+	createOptions := &kubernetesserviceapiv1.CreateAssignmentOptions{}
+	createOptions.SetName(name)
+	createOptions.SetChannelName(d.Get("config").(string))
+	createOptions.SetVersion(d.Get("config_version").(string))
+	createOptions.SetGroups(expandStringList(d.Get("groups").([]interface{})))
+
+	result, response, err := satClient.CreateAssignment(createOptions)
+	if err != nil {
+		return fmt.Errorf("Error creating Satellite storage assignment %s: %s\n%s", name, err, response)
+	}
+	if result.AddSubscription == nil || result.AddSubscription.UUID == nil {
+		return fmt.Errorf("Error creating Satellite storage assignment %s: no UUID returned", name)
+	}
+	d.SetId(*result.AddSubscription.UUID)
+
+	return resourceIBMSatelliteStorageAssignmentRead(d, meta)
+}
+
+func resourceIBMSatelliteStorageAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	satClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+
+	uuid := d.Id()
+	assignment, response, err := satClient.GetAssignment(&kubernetesserviceapiv1.GetAssignmentOptions{
+		UUID: &uuid,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Satellite storage assignment %s: %s\n%s", uuid, err, response)
+	}
+
+	d.Set("assignment_name", assignment.Name)
+	d.Set("config", assignment.ChannelName)
+	d.Set("config_version", assignment.Version)
+	d.Set("groups", assignment.Groups)
+	d.Set("config_uuid", assignment.ChannelUUID)
+	d.Set("config_version_uuid", assignment.VersionUUID)
+	if assignment.Owner != nil {
+		d.Set("owner", assignment.Owner.Name)
+	}
+	d.Set("created", assignment.Created)
+	d.Set("updated", assignment.Updated)
+	return nil
+}
+
+func resourceIBMSatelliteStorageAssignmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	satClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("assignment_name", "groups") {
+		updateOptions := &kubernetesserviceapiv1.UpdateAssignmentOptions{}
+		updateOptions.SetUUID(d.Id())
+		updateOptions.SetName(d.Get("assignment_name").(string))
+		updateOptions.SetGroups(expandStringList(d.Get("groups").([]interface{})))
+		updateOptions.SetChannelUUID(d.Get("config_uuid").(string))
+		updateOptions.SetVersionUUID(d.Get("config_version_uuid").(string))
+
+		_, response, err := satClient.UpdateAssignment(updateOptions)
+		if err != nil {
+			return fmt.Errorf("Error updating Satellite storage assignment %s: %s\n%s", d.Id(), err, response)
+		}
+	}
+
+	return resourceIBMSatelliteStorageAssignmentRead(d, meta)
+}
+
+func resourceIBMSatelliteStorageAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	satClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+
+	uuid := d.Id()
+	_, response, err := satClient.RemoveAssignment(&kubernetesserviceapiv1.RemoveAssignmentOptions{
+		UUID: &uuid,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error removing Satellite storage assignment %s: %s\n%s", uuid, err, response)
+	}
+
+	d.SetId("")
+	return nil
+}
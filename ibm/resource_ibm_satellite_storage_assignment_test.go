@@ -0,0 +1,68 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSatelliteStorageAssignmentBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-sat-assignment-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSatelliteStorageAssignmentBasic(name, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_satellite_storage_assignment.assignment", "assignment_name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_satellite_storage_assignment.assignment", "groups.#", "1"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_satellite_storage_assignment.assignment", "config_uuid"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSatelliteStorageAssignmentBasic(name, name+"-updated"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_satellite_storage_assignment.assignment", "assignment_name", name+"-updated"),
+				),
+			},
+			{
+				ResourceName:      "ibm_satellite_storage_assignment.assignment",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSatelliteStorageAssignmentBasic(configName, name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_satellite_storage_configuration" "config" {
+	  config_name              = "%s"
+	  config_version           = "1"
+	  storage_template_name    = "local-volume-block"
+	  storage_template_version = "4.7"
+	  user_config_parameters = {
+		label-key   = "storage"
+		label-value = "localvol"
+		devicepath  = "/dev/sdc"
+	  }
+	}
+	resource "ibm_satellite_storage_assignment" "assignment" {
+	  assignment_name = "%s"
+	  config          = ibm_satellite_storage_configuration.config.config_name
+	  config_version  = ibm_satellite_storage_configuration.config.config_version
+	  groups          = ["%s"]
+	}
+	`, configName, name, satelliteClusterGroup)
+}
@@ -0,0 +1,216 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.ibm.com/ibmcloud/kubernetesservice-go-sdk/kubernetesserviceapiv1"
+)
+
+func resourceIBMSatelliteStorageConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMSatelliteStorageConfigurationCreate,
+		Read:     resourceIBMSatelliteStorageConfigurationRead,
+		Update:   resourceIBMSatelliteStorageConfigurationUpdate,
+		Delete:   resourceIBMSatelliteStorageConfigurationDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"config_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the storage configuration",
+			},
+			"config_version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Version of the storage configuration",
+			},
+			"storage_template_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the storage template, such as odf-local or local-volume-block",
+			},
+			"storage_template_version": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Version of the storage template",
+			},
+			"user_config_parameters": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Configuration parameters of the storage template",
+			},
+			"user_secret_parameters": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Secret parameters of the storage template",
+			},
+			"storage_class_parameters": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Parameters of the custom storage classes to create",
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+			"source_org": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "GitHub organization of the storage template source",
+			},
+			"source_branch": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "GitHub branch of the storage template source",
+			},
+			"uuid": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "UUID of the storage configuration",
+			},
+		},
+	}
+}
+
+func resourceIBMSatelliteStorageConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	satClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+
+	configName := d.Get("config_name").(string)
+	configVersion := d.Get("config_version").(string)
+	createOptions := &kubernetesserviceapiv1.CreateStorageConfigurationOptions{}
+	createOptions.SetConfigName(configName)
+	createOptions.SetConfigVersion(configVersion)
+	createOptions.SetStorageTemplateName(d.Get("storage_template_name").(string))
+	createOptions.SetStorageTemplateVersion(d.Get("storage_template_version").(string))
+	createOptions.SetUserConfigParameters(expandStringMap(d.Get("user_config_parameters").(map[string]interface{})))
+	createOptions.SetUserSecretParameters(expandStringMap(d.Get("user_secret_parameters").(map[string]interface{})))
+	createOptions.SetStorageClassParameters(expandStorageClassParameters(d.Get("storage_class_parameters").([]interface{})))
+	if v, ok := d.GetOk("source_org"); ok {
+		createOptions.SetSourceOrg(v.(string))
+	}
+	if v, ok := d.GetOk("source_branch"); ok {
+		createOptions.SetSourceBranch(v.(string))
+	}
+
+	_, response, err := satClient.CreateStorageConfiguration(createOptions)
+	if err != nil {
+		return fmt.Errorf("Error creating Satellite storage configuration %s: %s\n%s", configName, err, response)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", configName, configVersion))
+
+	return resourceIBMSatelliteStorageConfigurationRead(d, meta)
+}
+
+func resourceIBMSatelliteStorageConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return err
+	}
+	if len(parts) < 2 {
+		return fmt.Errorf("Incorrect ID %s: ID should be a combination of configName/configVersion", d.Id())
+	}
+	configName := parts[0]
+	configVersion := parts[1]
+
+	satClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+
+	getOptions := &kubernetesserviceapiv1.GetStorageConfigurationOptions{
+		Name:    &configName,
+		Version: &configVersion,
+	}
+	config, response, err := satClient.GetStorageConfiguration(getOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error retrieving Satellite storage configuration %s: %s\n%s", d.Id(), err, response)
+	}
+
+	d.Set("config_name", configName)
+	d.Set("config_version", configVersion)
+	d.Set("storage_template_name", config.StorageTemplateName)
+	d.Set("storage_template_version", config.StorageTemplateVersion)
+	d.Set("user_config_parameters", config.UserConfigParameters)
+	d.Set("storage_class_parameters", flattenStorageClassParameters(config.StorageClassParameters))
+	d.Set("source_org", config.SourceOrg)
+	d.Set("source_branch", config.SourceBranch)
+	d.Set("uuid", config.UUID)
+	// The values of the secret parameters are not returned
+	return nil
+}
+
+func resourceIBMSatelliteStorageConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	satClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+
+	if d.HasChanges("user_config_parameters", "user_secret_parameters", "storage_class_parameters") {
+		updateOptions := &kubernetesserviceapiv1.UpdateStorageConfigurationOptions{}
+		updateOptions.SetUUID(d.Get("uuid").(string))
+		updateOptions.SetConfigName(d.Get("config_name").(string))
+		updateOptions.SetConfigVersion(d.Get("config_version").(string))
+		updateOptions.SetStorageTemplateName(d.Get("storage_template_name").(string))
+		updateOptions.SetStorageTemplateVersion(d.Get("storage_template_version").(string))
+		updateOptions.SetUserConfigParameters(expandStringMap(d.Get("user_config_parameters").(map[string]interface{})))
+		updateOptions.SetUserSecretParameters(expandStringMap(d.Get("user_secret_parameters").(map[string]interface{})))
+		updateOptions.SetStorageClassParameters(expandStorageClassParameters(d.Get("storage_class_parameters").([]interface{})))
+		if v, ok := d.GetOk("source_org"); ok {
+			updateOptions.SetSourceOrg(v.(string))
+		}
+		if v, ok := d.GetOk("source_branch"); ok {
+			updateOptions.SetSourceBranch(v.(string))
+		}
+
+		_, response, err := satClient.UpdateStorageConfiguration(updateOptions)
+		if err != nil {
+			return fmt.Errorf("Error updating Satellite storage configuration %s: %s\n%s", d.Id(), err, response)
+		}
+	}
+
+	return resourceIBMSatelliteStorageConfigurationRead(d, meta)
+}
+
+func resourceIBMSatelliteStorageConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	satClient, err := meta.(ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+
+	uuid := d.Get("uuid").(string)
+	removeOptions := &kubernetesserviceapiv1.RemoveStorageConfigurationOptions{
+		UUID: &uuid,
+	}
+	_, response, err := satClient.RemoveStorageConfiguration(removeOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Error removing Satellite storage configuration %s: %s\n%s", d.Id(), err, response)
+	}
+
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,62 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSatelliteStorageConfigurationBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-sat-storage-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSatelliteStorageConfigurationBasic(name, "/dev/sdc"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_satellite_storage_configuration.config", "config_name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_satellite_storage_configuration.config", "storage_template_name", "local-volume-block"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_satellite_storage_configuration.config", "uuid"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSatelliteStorageConfigurationBasic(name, "/dev/sdd"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_satellite_storage_configuration.config", "user_config_parameters.devicepath", "/dev/sdd"),
+				),
+			},
+			{
+				ResourceName:      "ibm_satellite_storage_configuration.config",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSatelliteStorageConfigurationBasic(name, devicePath string) string {
+	return fmt.Sprintf(`
+	resource "ibm_satellite_storage_configuration" "config" {
+	  config_name              = "%s"
+	  config_version           = "1"
+	  storage_template_name    = "local-volume-block"
+	  storage_template_version = "4.7"
+	  user_config_parameters = {
+		label-key   = "storage"
+		label-value = "localvol"
+		devicepath  = "%s"
+	  }
+	}
+	`, name, devicePath)
+}
@@ -0,0 +1,91 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const secretsManagerArbitrarySecretType = "arbitrary"
+
+func resourceIBMSecretsManagerArbitrarySecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerArbitrarySecretCreate,
+		ReadContext:   resourceIBMSecretsManagerArbitrarySecretRead,
+		UpdateContext: resourceIBMSecretsManagerArbitrarySecretUpdate,
+		DeleteContext: resourceIBMSecretsManagerArbitrarySecretDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: secretsManagerSecretSchema(map[string]*schema.Schema{
+			"payload": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The secret data. A new version of the secret is created when it changes.",
+			},
+			"expiration_date": secretsManagerExpirationDateSchema(),
+		}),
+	}
+}
+
+func resourceIBMSecretsManagerArbitrarySecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret := expandSecretsManagerSecret(d)
+	secret.Payload = d.Get("payload").(string)
+	secret.ExpirationDate = d.Get("expiration_date").(string)
+
+	if _, err := createSecretsManagerSecret(d, meta, secretsManagerArbitrarySecretType, secret); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerArbitrarySecretRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerArbitrarySecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret, err := readSecretsManagerSecret(d, meta, secretsManagerArbitrarySecretType, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if secret == nil {
+		return nil
+	}
+
+	d.Set("expiration_date", secret.ExpirationDate)
+	if payload, ok := secret.SecretData["payload"].(string); ok {
+		d.Set("payload", payload)
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerArbitrarySecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	metadata := secretsManagerSecret{
+		ExpirationDate: d.Get("expiration_date").(string),
+	}
+	if err := updateSecretsManagerSecretMetadata(d, meta, secretsManagerArbitrarySecretType, metadata, "expiration_date"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("payload") {
+		body := map[string]interface{}{
+			"payload": d.Get("payload").(string),
+		}
+		if err := rotateSecretsManagerSecret(d, meta, secretsManagerArbitrarySecretType, body); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSecretsManagerArbitrarySecretRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerArbitrarySecretDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := deleteSecretsManagerSecret(d, meta, secretsManagerArbitrarySecretType); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMSecretsManagerArbitrarySecret_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-arbitrary-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_secrets_manager_arbitrary_secret.secret"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMSecretsManagerSecretDestroy("ibm_secrets_manager_arbitrary_secret", secretsManagerArbitrarySecretType),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerArbitrarySecretConfig(name, "secret-v1", "v1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "payload", "secret-v1"),
+					resource.TestCheckResourceAttr(resourceName, "labels.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.owner", "v1"),
+					resource.TestCheckResourceAttr(resourceName, "versions_total", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "secret_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "crn"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSecretsManagerArbitrarySecretConfig(name, "secret-v2", "v2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "payload", "secret-v2"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.owner", "v2"),
+					resource.TestCheckResourceAttr(resourceName, "versions_total", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoint_type", "version_custom_metadata"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerArbitrarySecretConfig(name, payload, owner string) string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_arbitrary_secret" "secret" {
+			instance_id     = "%s"
+			name            = "%s"
+			description     = "Arbitrary secret created by Terraform"
+			labels          = ["terraform"]
+			payload         = "%s"
+			expiration_date = "2030-01-01T00:00:00Z"
+			custom_metadata = {
+				owner = "%s"
+			}
+		}
+	`, secretsManagerInstanceID, name, payload, owner)
+}
+
+func testAccCheckIBMSecretsManagerSecretDestroy(resourceType, secretType string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			parts, err := idParts(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			client, err := secretsManagerInstanceClient(testAccProvider.Meta(), parts[0], "public")
+			if err != nil {
+				return err
+			}
+			response, err := secretsManagerRequest(client, core.GET, fmt.Sprintf("/api/v1/secrets/%s/%s/metadata", secretType, parts[1]), nil, nil, &secretsManagerSecretCollection{})
+			if err == nil {
+				return fmt.Errorf("%s secret still exists: %s", secretType, rs.Primary.ID)
+			}
+			if response == nil || response.StatusCode != 404 {
+				return fmt.Errorf("Error checking if %s secret (%s) has been destroyed: %s", secretType, rs.Primary.ID, err)
+			}
+		}
+		return nil
+	}
+}
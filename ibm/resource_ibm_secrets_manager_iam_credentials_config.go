@@ -0,0 +1,135 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMSecretsManagerIAMCredentialsConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerIAMCredentialsConfigCreate,
+		ReadContext:   resourceIBMSecretsManagerIAMCredentialsConfigRead,
+		UpdateContext: resourceIBMSecretsManagerIAMCredentialsConfigCreate,
+		DeleteContext: resourceIBMSecretsManagerIAMCredentialsConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Secrets Manager instance GUID",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public",
+				ValidateFunc: InvokeValidator("ibm_secrets_manager_iam_credentials_config", "endpoint_type"),
+				Description:  "Endpoint Type. 'public' or 'private'",
+			},
+			"api_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "An IBM Cloud API key that has the capability to create and manage service IDs.",
+			},
+			"api_key_hash": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The hash value of the IBM Cloud API key that is used to create and manage service IDs.",
+			},
+		},
+	}
+}
+
+func resourceIBMSecretsManagerIAMCredentialsConfigValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "endpoint_type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "public, private",
+		},
+	)
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_secrets_manager_iam_credentials_config", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMSecretsManagerIAMCredentialsConfigCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	secretsManagerClient, err := secretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	putConfigOptions := &secretsmanagerv1.PutConfigOptions{
+		SecretType: core.StringPtr(secretsmanagerv1.PutConfigOptionsSecretTypeIamCredentialsConst),
+		EngineConfigOneOf: &secretsmanagerv1.EngineConfigOneOfIamSecretEngineRootConfig{
+			APIKey: core.StringPtr(d.Get("api_key").(string)),
+		},
+	}
+	response, err := secretsManagerClient.PutConfigWithContext(context, putConfigOptions)
+	if err != nil {
+		log.Printf("[DEBUG] PutConfigWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error configuring the IAM credentials engine of %s: %s", instanceID, err))
+	}
+
+	d.SetId(instanceID)
+
+	return resourceIBMSecretsManagerIAMCredentialsConfigRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerIAMCredentialsConfigRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := secretsManagerInstanceClient(meta, d.Id(), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	engineConfig, response, err := secretsManagerClient.GetConfigWithContext(context, &secretsmanagerv1.GetConfigOptions{
+		SecretType: core.StringPtr(secretsmanagerv1.GetConfigOptionsSecretTypeIamCredentialsConst),
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] IAM credentials engine configuration of %s is not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetConfigWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error retrieving the IAM credentials engine configuration of %s: %s", d.Id(), err))
+	}
+
+	d.Set("instance_id", d.Id())
+	if config, ok := engineConfig.(*secretsmanagerv1.EngineConfigOneOf); ok {
+		// The API key is only returned as a hash
+		if config.APIKey != nil {
+			d.Set("api_key", *config.APIKey)
+		}
+		if config.APIKeyHash != nil {
+			d.Set("api_key_hash", *config.APIKeyHash)
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerIAMCredentialsConfigDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The engine configuration cannot be removed from the instance, it stays
+	// until the instance is deleted
+	log.Printf("[WARN] The IAM credentials engine configuration of %s is only removed from state", d.Id())
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,45 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerIAMCredentialsConfig_basic(t *testing.T) {
+	resourceName := "ibm_secrets_manager_iam_credentials_config.config"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerIAMCredentialsConfigConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "instance_id", secretsManagerInstanceID),
+					resource.TestCheckResourceAttrSet(resourceName, "api_key_hash"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoint_type", "api_key"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerIAMCredentialsConfigConfig() string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_iam_credentials_config" "config" {
+			instance_id = "%s"
+			api_key     = "%s"
+		}
+	`, secretsManagerInstanceID, os.Getenv("IC_API_KEY"))
+}
@@ -0,0 +1,158 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const secretsManagerIAMCredentialsSecretType = "iam_credentials"
+
+func resourceIBMSecretsManagerIAMCredentialsSecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerIAMCredentialsSecretCreate,
+		ReadContext:   resourceIBMSecretsManagerIAMCredentialsSecretRead,
+		UpdateContext: resourceIBMSecretsManagerIAMCredentialsSecretUpdate,
+		DeleteContext: resourceIBMSecretsManagerIAMCredentialsSecretDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: secretsManagerSecretSchema(map[string]*schema.Schema{
+			"ttl": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The time-to-live (TTL) or lease duration of the generated API keys. The value is either a number of seconds or a duration, such as `120m` or `24h`.",
+			},
+			"access_groups": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				ForceNew:     true,
+				Elem:         &schema.Schema{Type: schema.TypeString},
+				ExactlyOneOf: []string{"access_groups", "service_id"},
+				Description:  "The access groups that define the capabilities of the service ID and API key that are generated for the secret.",
+			},
+			"service_id": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{"access_groups", "service_id"},
+				Description:  "The service ID under which the API keys are created. A service ID is created in the access groups when it is not set.",
+			},
+			"reuse_api_key": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+				Description: "Reuse the service ID and API key for future read operations.",
+			},
+			"api_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The API key that is generated for this secret. Only set when `reuse_api_key` is true, otherwise each read generates a new API key.",
+			},
+		}),
+	}
+}
+
+func resourceIBMSecretsManagerIAMCredentialsSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	reuseAPIKey := d.Get("reuse_api_key").(bool)
+	secret := expandSecretsManagerSecret(d)
+	secret.TTL = d.Get("ttl").(string)
+	secret.AccessGroups = expandStringList(d.Get("access_groups").([]interface{}))
+	secret.ServiceID = d.Get("service_id").(string)
+	secret.ReuseAPIKey = &reuseAPIKey
+
+	if _, err := createSecretsManagerSecret(d, meta, secretsManagerIAMCredentialsSecretType, secret); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The API key is generated by the first read of the secret, it's the same
+	// on each read only when it is reused
+	if reuseAPIKey {
+		secret, err := readSecretsManagerSecret(d, meta, secretsManagerIAMCredentialsSecretType, false)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if secret != nil {
+			d.Set("api_key", secret.APIKey)
+		}
+	}
+
+	return resourceIBMSecretsManagerIAMCredentialsSecretRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerIAMCredentialsSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// Only the metadata is read, reading the secret would generate credentials
+	secret, err := readSecretsManagerSecret(d, meta, secretsManagerIAMCredentialsSecretType, true)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if secret == nil {
+		return nil
+	}
+
+	if ttl := flattenSecretsManagerTTL(secret.TTL); ttl != "" {
+		// The TTL is returned in seconds, keep the duration of the configuration
+		if ttlSeconds, err := secretsManagerTTLSeconds(d.Get("ttl").(string)); err != nil || strconv.FormatInt(ttlSeconds, 10) != ttl {
+			d.Set("ttl", ttl)
+		}
+	}
+	d.Set("access_groups", flattenStringList(secret.AccessGroups))
+	d.Set("service_id", secret.ServiceID)
+	if secret.ReuseAPIKey != nil {
+		d.Set("reuse_api_key", *secret.ReuseAPIKey)
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerIAMCredentialsSecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	metadata := secretsManagerSecret{
+		TTL: d.Get("ttl").(string),
+	}
+	if err := updateSecretsManagerSecretMetadata(d, meta, secretsManagerIAMCredentialsSecretType, metadata, "ttl"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerIAMCredentialsSecretRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerIAMCredentialsSecretDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := deleteSecretsManagerSecret(d, meta, secretsManagerIAMCredentialsSecretType); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// secretsManagerTTLSeconds converts a TTL of seconds or of a duration to seconds
+func secretsManagerTTLSeconds(ttl string) (int64, error) {
+	if seconds, err := strconv.ParseInt(ttl, 10, 64); err == nil {
+		return seconds, nil
+	}
+	duration, err := time.ParseDuration(ttl)
+	if err != nil {
+		return 0, err
+	}
+	return int64(duration.Seconds()), nil
+}
+
+// flattenSecretsManagerTTL returns the TTL of the API, a number of seconds or
+// a string, as a string
+func flattenSecretsManagerTTL(ttl interface{}) string {
+	switch v := ttl.(type) {
+	case float64:
+		return strconv.FormatInt(int64(v), 10)
+	case string:
+		return v
+	}
+	return ""
+}
@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerIAMCredentialsSecret_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-iam-creds-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_secrets_manager_iam_credentials_secret.secret"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMSecretsManagerSecretDestroy("ibm_secrets_manager_iam_credentials_secret", secretsManagerIAMCredentialsSecretType),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerIAMCredentialsSecretConfig(name, "24h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ttl", "24h"),
+					resource.TestCheckResourceAttr(resourceName, "access_groups.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "reuse_api_key", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "api_key"),
+					resource.TestCheckResourceAttrSet(resourceName, "service_id"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSecretsManagerIAMCredentialsSecretConfig(name, "7200"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ttl", "7200"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerIAMCredentialsSecretConfig(name, ttl string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_access_group" "group" {
+			name = "%[2]s"
+		}
+		resource "ibm_secrets_manager_iam_credentials_secret" "secret" {
+			instance_id   = "%[1]s"
+			name          = "%[2]s"
+			ttl           = "%[3]s"
+			access_groups = [ibm_iam_access_group.group.id]
+			reuse_api_key = true
+		}
+	`, secretsManagerInstanceID, name, ttl)
+}
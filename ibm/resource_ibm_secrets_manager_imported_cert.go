@@ -0,0 +1,185 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const secretsManagerImportedCertSecretType = "imported_cert"
+
+func resourceIBMSecretsManagerImportedCert() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerImportedCertCreate,
+		ReadContext:   resourceIBMSecretsManagerImportedCertRead,
+		UpdateContext: resourceIBMSecretsManagerImportedCertUpdate,
+		DeleteContext: resourceIBMSecretsManagerImportedCertDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: secretsManagerSecretSchema(map[string]*schema.Schema{
+			"certificate": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: suppressSecretsManagerPEMWhitespace,
+				Description:      "The PEM encoded certificate. A new version of the secret is created when the certificate, the private key or the intermediate certificate changes.",
+			},
+			"private_key": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressSecretsManagerPEMWhitespace,
+				Description:      "The PEM encoded private key of the certificate.",
+			},
+			"intermediate": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				DiffSuppressFunc: suppressSecretsManagerPEMWhitespace,
+				Description:      "The PEM encoded intermediate certificate of the certificate.",
+			},
+			"common_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The fully qualified domain name of the certificate.",
+			},
+			"alt_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The alternative names of the certificate.",
+			},
+			"algorithm": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier for the cryptographic algorithm used to sign the certificate.",
+			},
+			"key_algorithm": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier for the cryptographic algorithm used to generate the public key of the certificate.",
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The distinguished name of the issuer of the certificate.",
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique serial number of the certificate.",
+			},
+			"expiration_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the certificate expires. The date format follows RFC 3339.",
+			},
+			"intermediate_included": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the secret includes an intermediate certificate.",
+			},
+			"private_key_included": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the secret includes a private key.",
+			},
+		}),
+	}
+}
+
+func resourceIBMSecretsManagerImportedCertCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret := expandSecretsManagerSecret(d)
+	secret.Certificate = d.Get("certificate").(string)
+	secret.PrivateKey = d.Get("private_key").(string)
+	secret.Intermediate = d.Get("intermediate").(string)
+
+	if _, err := createSecretsManagerSecret(d, meta, secretsManagerImportedCertSecretType, secret); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerImportedCertRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerImportedCertRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret, err := readSecretsManagerSecret(d, meta, secretsManagerImportedCertSecretType, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if secret == nil {
+		return nil
+	}
+
+	if certificate, ok := secret.SecretData["certificate"].(string); ok {
+		d.Set("certificate", certificate)
+	}
+	if privateKey, ok := secret.SecretData["private_key"].(string); ok {
+		d.Set("private_key", privateKey)
+	}
+	if intermediate, ok := secret.SecretData["intermediate"].(string); ok {
+		d.Set("intermediate", intermediate)
+	}
+	flattenSecretsManagerCertificate(d, secret)
+
+	return nil
+}
+
+func resourceIBMSecretsManagerImportedCertUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := updateSecretsManagerSecretMetadata(d, meta, secretsManagerImportedCertSecretType, secretsManagerSecret{}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Reimporting the certificate creates a new version of the secret
+	if d.HasChanges("certificate", "private_key", "intermediate") {
+		body := map[string]interface{}{
+			"certificate": d.Get("certificate").(string),
+		}
+		if v, ok := d.GetOk("private_key"); ok {
+			body["private_key"] = v.(string)
+		}
+		if v, ok := d.GetOk("intermediate"); ok {
+			body["intermediate"] = v.(string)
+		}
+		if err := rotateSecretsManagerSecret(d, meta, secretsManagerImportedCertSecretType, body); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSecretsManagerImportedCertRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerImportedCertDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := deleteSecretsManagerSecret(d, meta, secretsManagerImportedCertSecretType); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// flattenSecretsManagerCertificate sets the attributes of the certificate
+// secret types
+func flattenSecretsManagerCertificate(d *schema.ResourceData, secret *secretsManagerSecret) {
+	d.Set("common_name", secret.CommonName)
+	d.Set("alt_names", flattenStringList(secret.AltNames))
+	d.Set("algorithm", secret.Algorithm)
+	d.Set("key_algorithm", secret.KeyAlgorithm)
+	d.Set("issuer", secret.Issuer)
+	d.Set("serial_number", secret.SerialNumber)
+	d.Set("expiration_date", secret.ExpirationDate)
+	if secret.IntermediateIncluded != nil {
+		d.Set("intermediate_included", *secret.IntermediateIncluded)
+	}
+	if secret.PrivateKeyIncluded != nil {
+		d.Set("private_key_included", *secret.PrivateKeyIncluded)
+	}
+}
+
+// suppressSecretsManagerPEMWhitespace suppresses the diff of PEM contents that
+// only differ in their leading and trailing whitespace
+func suppressSecretsManagerPEMWhitespace(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerImportedCert_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-imported-cert-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_secrets_manager_imported_cert.cert"
+	cert1, key1 := testAccSecretsManagerSelfSignedCert(t, "v1.example.com")
+	cert2, key2 := testAccSecretsManagerSelfSignedCert(t, "v2.example.com")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMSecretsManagerSecretDestroy("ibm_secrets_manager_imported_cert", secretsManagerImportedCertSecretType),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerImportedCertConfig(name, cert1, key1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "common_name", "v1.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "private_key_included", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "serial_number"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration_date"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSecretsManagerImportedCertConfig(name, cert2, key2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "common_name", "v2.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "versions_total", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerImportedCertConfig(name, cert, key string) string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_imported_cert" "cert" {
+			instance_id = "%s"
+			name        = "%s"
+			certificate = <<EOT
+%sEOT
+			private_key = <<EOT
+%sEOT
+		}
+	`, secretsManagerInstanceID, name, cert, key)
+}
+
+// testAccSecretsManagerSelfSignedCert returns a PEM encoded self-signed
+// certificate of the common name and its private key
+func testAccSecretsManagerSelfSignedCert(t *testing.T, commonName string) (string, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames:     []string{commonName},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(30 * 24 * time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	privateKey := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return string(cert), string(privateKey)
+}
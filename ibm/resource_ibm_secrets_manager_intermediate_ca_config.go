@@ -0,0 +1,110 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMSecretsManagerIntermediateCAConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerIntermediateCAConfigCreate,
+		ReadContext:   resourceIBMSecretsManagerIntermediateCAConfigRead,
+		UpdateContext: resourceIBMSecretsManagerIntermediateCAConfigUpdate,
+		DeleteContext: resourceIBMSecretsManagerIntermediateCAConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: secretsManagerPrivateCAConfigSchema("ibm_secrets_manager_intermediate_ca_config", map[string]*schema.Schema{
+			"signing_method": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_secrets_manager_intermediate_ca_config", "signing_method"),
+				Description:  "The signing method of the certificate signing request: internal, signed by a certificate authority of the instance, or external.",
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of the root or intermediate certificate authority that signs the intermediate certificate authority. Required when the signing method is internal.",
+			},
+			"csr": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM encoded certificate signing request to sign when the signing method is external.",
+			},
+		}),
+	}
+}
+
+func resourceIBMSecretsManagerIntermediateCAConfigValidator() *ResourceValidator {
+	return secretsManagerPrivateCAConfigValidator("ibm_secrets_manager_intermediate_ca_config")
+}
+
+func resourceIBMSecretsManagerIntermediateCAConfigCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := expandSecretsManagerPrivateCAConfig(d)
+	config["signing_method"] = d.Get("signing_method").(string)
+	if v, ok := d.GetOk("issuer"); ok {
+		config["issuer"] = v.(string)
+	}
+	element := secretsManagerConfigElement{
+		Name:   d.Get("name").(string),
+		Type:   secretsManagerIntermediateCertificateAuthorities,
+		Config: config,
+	}
+	if _, err := createSecretsManagerConfigElement(d, meta, secretsManagerPrivateCertSecretType, secretsManagerIntermediateCertificateAuthorities, element); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerIntermediateCAConfigRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerIntermediateCAConfigRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	element, err := getSecretsManagerConfigElement(d, meta, secretsManagerPrivateCertSecretType, secretsManagerIntermediateCertificateAuthorities)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if element == nil {
+		return nil
+	}
+
+	flattenSecretsManagerPrivateCAConfig(d, element.Config)
+	if signingMethod, ok := element.Config["signing_method"].(string); ok {
+		d.Set("signing_method", signingMethod)
+	}
+	if issuer, ok := element.Config["issuer"].(string); ok {
+		d.Set("issuer", issuer)
+	}
+	if csr, ok := element.Config["csr"].(string); ok {
+		d.Set("csr", csr)
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerIntermediateCAConfigUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChanges("max_ttl", "crl_expiry", "crl_disable") {
+		element := secretsManagerConfigElement{
+			Type:   secretsManagerIntermediateCertificateAuthorities,
+			Config: expandSecretsManagerPrivateCAConfigUpdate(d),
+		}
+		if err := updateSecretsManagerConfigElement(d, meta, secretsManagerPrivateCertSecretType, secretsManagerIntermediateCertificateAuthorities, element); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSecretsManagerIntermediateCAConfigRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerIntermediateCAConfigDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := deleteSecretsManagerConfigElement(d, meta, secretsManagerPrivateCertSecretType, secretsManagerIntermediateCertificateAuthorities); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerIntermediateCAConfig_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-ca-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_secrets_manager_intermediate_ca_config.intermediate"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMSecretsManagerConfigElementDestroy("ibm_secrets_manager_intermediate_ca_config", secretsManagerPrivateCertSecretType, secretsManagerIntermediateCertificateAuthorities),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerIntermediateCAConfigConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", fmt.Sprintf("%s-intermediate", name)),
+					resource.TestCheckResourceAttr(resourceName, "signing_method", "internal"),
+					resource.TestCheckResourceAttrPair(resourceName, "issuer", "ibm_secrets_manager_root_ca_config.root", "name"),
+					resource.TestCheckResourceAttr(resourceName, "status", "configured"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoint_type", "max_ttl", "ttl", "alt_names", "organization", "ou", "country"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerIntermediateCAConfigConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_root_ca_config" "root" {
+			instance_id = "%[1]s"
+			name        = "%[2]s-root"
+			common_name = "terraform.example.com"
+			max_ttl     = "8760h"
+		}
+
+		resource "ibm_secrets_manager_intermediate_ca_config" "intermediate" {
+			instance_id    = "%[1]s"
+			name           = "%[2]s-intermediate"
+			common_name    = "intermediate.terraform.example.com"
+			max_ttl        = "4380h"
+			signing_method = "internal"
+			issuer         = ibm_secrets_manager_root_ca_config.root.name
+		}
+	`, secretsManagerInstanceID, name)
+}
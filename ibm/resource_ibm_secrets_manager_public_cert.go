@@ -0,0 +1,289 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const secretsManagerPublicCertSecretType = "public_cert"
+
+func resourceIBMSecretsManagerPublicCert() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerPublicCertCreate,
+		ReadContext:   resourceIBMSecretsManagerPublicCertRead,
+		UpdateContext: resourceIBMSecretsManagerPublicCertUpdate,
+		DeleteContext: resourceIBMSecretsManagerPublicCertDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: secretsManagerSecretSchema(map[string]*schema.Schema{
+			"common_name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The fully qualified domain name of the certificate.",
+			},
+			"alt_names": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The alternative names of the certificate.",
+			},
+			"key_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "RSA2048",
+				ValidateFunc: validateAllowedStringValue([]string{"RSA2048", "RSA4096", "EC256", "EC384"}),
+				Description:  "The identifier for the cryptographic algorithm used to generate the public key of the certificate: RSA2048, RSA4096, EC256, EC384.",
+			},
+			"ca": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the certificate authority configuration that issues the certificate.",
+			},
+			"dns": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the DNS provider configuration that validates the domains of the certificate.",
+			},
+			"bundle_certs": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Whether the issued certificate is bundled with its intermediate certificate.",
+			},
+			"rotation": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The policy that renews the certificate before it expires.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_rotate": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether the certificate is renewed automatically.",
+						},
+						"rotate_keys": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "Whether a new private key is generated on each renewal.",
+						},
+					},
+				},
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM encoded issued certificate.",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded private key of the certificate.",
+			},
+			"intermediate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM encoded intermediate certificate of the certificate.",
+			},
+			"algorithm": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The identifier for the cryptographic algorithm used to sign the certificate.",
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The distinguished name of the issuer of the certificate.",
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique serial number of the certificate.",
+			},
+			"expiration_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the certificate expires. The date format follows RFC 3339.",
+			},
+			"intermediate_included": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the secret includes an intermediate certificate.",
+			},
+			"private_key_included": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the secret includes a private key.",
+			},
+		}),
+	}
+}
+
+func resourceIBMSecretsManagerPublicCertCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	bundleCerts := d.Get("bundle_certs").(bool)
+	secret := expandSecretsManagerSecret(d)
+	secret.CommonName = d.Get("common_name").(string)
+	secret.AltNames = expandStringList(d.Get("alt_names").([]interface{}))
+	secret.KeyAlgorithm = d.Get("key_algorithm").(string)
+	secret.CA = d.Get("ca").(string)
+	secret.DNS = d.Get("dns").(string)
+	secret.BundleCerts = &bundleCerts
+	secret.Rotation = expandSecretsManagerPublicCertRotation(d.Get("rotation").([]interface{}))
+
+	if _, err := createSecretsManagerSecret(d, meta, secretsManagerPublicCertSecretType, secret); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := waitForSecretsManagerPublicCertIssued(d, meta, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerPublicCertRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerPublicCertRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret, err := readSecretsManagerSecret(d, meta, secretsManagerPublicCertSecretType, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if secret == nil {
+		return nil
+	}
+
+	if secret.CA != "" {
+		d.Set("ca", secret.CA)
+	}
+	if secret.DNS != "" {
+		d.Set("dns", secret.DNS)
+	}
+	if secret.BundleCerts != nil {
+		d.Set("bundle_certs", *secret.BundleCerts)
+	}
+	if secret.Rotation != nil {
+		d.Set("rotation", flattenSecretsManagerPublicCertRotation(d, secret.Rotation))
+	}
+	if certificate, ok := secret.SecretData["certificate"].(string); ok {
+		d.Set("certificate", certificate)
+	}
+	if privateKey, ok := secret.SecretData["private_key"].(string); ok {
+		d.Set("private_key", privateKey)
+	}
+	if intermediate, ok := secret.SecretData["intermediate"].(string); ok {
+		d.Set("intermediate", intermediate)
+	}
+	flattenSecretsManagerCertificate(d, secret)
+
+	return nil
+}
+
+func resourceIBMSecretsManagerPublicCertUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := updateSecretsManagerSecretMetadata(d, meta, secretsManagerPublicCertSecretType, secretsManagerSecret{}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("rotation") {
+		rotation := expandSecretsManagerPublicCertRotation(d.Get("rotation").([]interface{}))
+		if rotation == nil {
+			// Removing the policy stops the automatic renewal
+			autoRotate, rotateKeys := false, false
+			rotation = &secretsManagerSecretRotation{AutoRotate: &autoRotate, RotateKeys: &rotateKeys}
+		}
+		if err := putSecretsManagerRotationPolicy(d, meta, secretsManagerPublicCertSecretType, rotation); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSecretsManagerPublicCertRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerPublicCertDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := deleteSecretsManagerSecret(d, meta, secretsManagerPublicCertSecretType); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// waitForSecretsManagerPublicCertIssued waits for the certificate authority
+// to issue the certificate, the secret is pre-activated until then
+func waitForSecretsManagerPublicCertIssued(d *schema.ResourceData, meta interface{}, timeout time.Duration) error {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{strconv.Itoa(secretsManagerSecretStatePreActivation)},
+		Target:  []string{strconv.Itoa(secretsManagerSecretStateActive)},
+		Refresh: func() (interface{}, string, error) {
+			secret, err := readSecretsManagerSecret(d, meta, secretsManagerPublicCertSecretType, true)
+			if err != nil {
+				return nil, "", err
+			}
+			if secret == nil {
+				return nil, "", fmt.Errorf("public_cert secret %s is not found", d.Id())
+			}
+			if secret.IssuanceInfo != nil && secret.IssuanceInfo.ErrorMessage != "" {
+				return secret, "", fmt.Errorf("Error ordering public_cert secret %s: %s %s", d.Id(), secret.IssuanceInfo.ErrorCode, secret.IssuanceInfo.ErrorMessage)
+			}
+			if secret.State == nil {
+				return secret, strconv.Itoa(secretsManagerSecretStatePreActivation), nil
+			}
+			return secret, strconv.Itoa(*secret.State), nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	if _, err := stateConf.WaitForState(); err != nil {
+		return fmt.Errorf("Error waiting for public_cert secret %s to be issued: %s", d.Id(), err)
+	}
+	return nil
+}
+
+func expandSecretsManagerPublicCertRotation(l []interface{}) *secretsManagerSecretRotation {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	rotation := l[0].(map[string]interface{})
+	autoRotate := rotation["auto_rotate"].(bool)
+	rotateKeys := rotation["rotate_keys"].(bool)
+	return &secretsManagerSecretRotation{
+		AutoRotate: &autoRotate,
+		RotateKeys: &rotateKeys,
+	}
+}
+
+func flattenSecretsManagerPublicCertRotation(d *schema.ResourceData, rotation *secretsManagerSecretRotation) []interface{} {
+	autoRotate := rotation.AutoRotate != nil && *rotation.AutoRotate
+	rotateKeys := rotation.RotateKeys != nil && *rotation.RotateKeys
+	// A disabled policy is the one left when the rotation block is removed
+	if !autoRotate && !rotateKeys && len(d.Get("rotation").([]interface{})) == 0 {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"auto_rotate": autoRotate,
+			"rotate_keys": rotateKeys,
+		},
+	}
+}
@@ -0,0 +1,149 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const secretsManagerCertificateAuthoritiesConfig = "certificate_authorities"
+
+func resourceIBMSecretsManagerPublicCertCAConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerPublicCertCAConfigCreate,
+		ReadContext:   resourceIBMSecretsManagerPublicCertCAConfigRead,
+		UpdateContext: resourceIBMSecretsManagerPublicCertCAConfigUpdate,
+		DeleteContext: resourceIBMSecretsManagerPublicCertCAConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Secrets Manager instance GUID",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public",
+				ValidateFunc: InvokeValidator("ibm_secrets_manager_public_cert_ca_config", "endpoint_type"),
+				Description:  "Endpoint Type. 'public' or 'private'",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_secrets_manager_public_cert_ca_config", "name"),
+				Description:  "The name of the certificate authority configuration, used in the `ca` argument of public certificates.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_secrets_manager_public_cert_ca_config", "type"),
+				Description:  "The type of the certificate authority: letsencrypt, letsencrypt-stage.",
+			},
+			"private_key": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: suppressSecretsManagerPEMWhitespace,
+				Description:      "The PEM encoded private key of the Let's Encrypt account.",
+			},
+		},
+	}
+}
+
+func resourceIBMSecretsManagerPublicCertCAConfigValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Required:                   true,
+			Regexp:                     `^[A-Za-z0-9][A-Za-z0-9_.-]*$`,
+			MinValueLength:             2,
+			MaxValueLength:             256,
+		},
+		ValidateSchema{
+			Identifier:                 "type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "letsencrypt, letsencrypt-stage",
+		},
+		ValidateSchema{
+			Identifier:                 "endpoint_type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "public, private",
+		},
+	)
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_secrets_manager_public_cert_ca_config", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMSecretsManagerPublicCertCAConfigCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	element := secretsManagerConfigElement{
+		Name: d.Get("name").(string),
+		Type: d.Get("type").(string),
+		Config: map[string]interface{}{
+			"private_key": d.Get("private_key").(string),
+		},
+	}
+	if _, err := createSecretsManagerConfigElement(d, meta, secretsManagerPublicCertSecretType, secretsManagerCertificateAuthoritiesConfig, element); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerPublicCertCAConfigRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerPublicCertCAConfigRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	element, err := getSecretsManagerConfigElement(d, meta, secretsManagerPublicCertSecretType, secretsManagerCertificateAuthoritiesConfig)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if element == nil {
+		return nil
+	}
+
+	d.Set("type", element.Type)
+	if privateKey, ok := element.Config["private_key"].(string); ok {
+		d.Set("private_key", privateKey)
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerPublicCertCAConfigUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("private_key") {
+		element := secretsManagerConfigElement{
+			Type: d.Get("type").(string),
+			Config: map[string]interface{}{
+				"private_key": d.Get("private_key").(string),
+			},
+		}
+		if err := updateSecretsManagerConfigElement(d, meta, secretsManagerPublicCertSecretType, secretsManagerCertificateAuthoritiesConfig, element); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSecretsManagerPublicCertCAConfigRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerPublicCertCAConfigDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := deleteSecretsManagerConfigElement(d, meta, secretsManagerPublicCertSecretType, secretsManagerCertificateAuthoritiesConfig); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
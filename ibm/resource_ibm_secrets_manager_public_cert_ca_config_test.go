@@ -0,0 +1,79 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMSecretsManagerPublicCertCAConfig_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-ca-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_secrets_manager_public_cert_ca_config.ca"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMSecretsManagerConfigElementDestroy("ibm_secrets_manager_public_cert_ca_config", secretsManagerPublicCertSecretType, secretsManagerCertificateAuthoritiesConfig),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerPublicCertCAConfigConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "type", "letsencrypt-stage"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoint_type", "private_key"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerPublicCertCAConfigConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_public_cert_ca_config" "ca" {
+			instance_id = "%s"
+			name        = "%s"
+			type        = "letsencrypt-stage"
+			private_key = <<EOT
+%s
+EOT
+		}
+	`, secretsManagerInstanceID, name, secretsManagerACMEAccountPrivateKey)
+}
+
+func testAccCheckIBMSecretsManagerConfigElementDestroy(resourceType, secretType, configElement string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != resourceType {
+				continue
+			}
+			parts, err := idParts(rs.Primary.ID)
+			if err != nil {
+				return err
+			}
+			client, err := secretsManagerInstanceClient(testAccProvider.Meta(), parts[0], "public")
+			if err != nil {
+				return err
+			}
+			response, err := secretsManagerRequest(client, core.GET, fmt.Sprintf("/api/v1/config/%s/%s/%s", secretType, configElement, parts[1]), nil, nil, &secretsManagerConfigElementCollection{})
+			if err == nil {
+				return fmt.Errorf("%s configuration still exists: %s", configElement, rs.Primary.ID)
+			}
+			if response == nil || response.StatusCode != 404 {
+				return fmt.Errorf("Error checking if %s configuration (%s) has been destroyed: %s", configElement, rs.Primary.ID, err)
+			}
+		}
+		return nil
+	}
+}
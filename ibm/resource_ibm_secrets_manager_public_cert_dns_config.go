@@ -0,0 +1,176 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const secretsManagerDNSProvidersConfig = "dns_providers"
+
+func resourceIBMSecretsManagerPublicCertDNSConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerPublicCertDNSConfigCreate,
+		ReadContext:   resourceIBMSecretsManagerPublicCertDNSConfigRead,
+		UpdateContext: resourceIBMSecretsManagerPublicCertDNSConfigUpdate,
+		DeleteContext: resourceIBMSecretsManagerPublicCertDNSConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Secrets Manager instance GUID",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public",
+				ValidateFunc: InvokeValidator("ibm_secrets_manager_public_cert_dns_config", "endpoint_type"),
+				Description:  "Endpoint Type. 'public' or 'private'",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_secrets_manager_public_cert_dns_config", "name"),
+				Description:  "The name of the DNS provider configuration, used in the `dns` argument of public certificates.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: InvokeValidator("ibm_secrets_manager_public_cert_dns_config", "type"),
+				Description:  "The type of the DNS provider: cis, classic_infrastructure.",
+			},
+			"cis_crn": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"classic_infrastructure_username", "classic_infrastructure_password"},
+				Description:   "The CRN of the Cloud Internet Services instance that manages the domains. Required for the cis type.",
+			},
+			"cis_apikey": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"classic_infrastructure_username", "classic_infrastructure_password"},
+				Description:   "An IBM Cloud API key that can access the Cloud Internet Services instance. The service-to-service authorization of Secrets Manager is used when it is not set.",
+			},
+			"classic_infrastructure_username": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"classic_infrastructure_password"},
+				Description:  "The username of the classic infrastructure account that manages the domains. Required for the classic_infrastructure type.",
+			},
+			"classic_infrastructure_password": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				RequiredWith: []string{"classic_infrastructure_username"},
+				Description:  "The API key of the classic infrastructure account that manages the domains.",
+			},
+		},
+	}
+}
+
+func resourceIBMSecretsManagerPublicCertDNSConfigValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Required:                   true,
+			Regexp:                     `^[A-Za-z0-9][A-Za-z0-9_.-]*$`,
+			MinValueLength:             2,
+			MaxValueLength:             256,
+		},
+		ValidateSchema{
+			Identifier:                 "type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Required:                   true,
+			AllowedValues:              "cis, classic_infrastructure",
+		},
+		ValidateSchema{
+			Identifier:                 "endpoint_type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "public, private",
+		},
+	)
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_secrets_manager_public_cert_dns_config", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMSecretsManagerPublicCertDNSConfigCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	element := secretsManagerConfigElement{
+		Name:   d.Get("name").(string),
+		Type:   d.Get("type").(string),
+		Config: expandSecretsManagerPublicCertDNSConfig(d),
+	}
+	if _, err := createSecretsManagerConfigElement(d, meta, secretsManagerPublicCertSecretType, secretsManagerDNSProvidersConfig, element); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerPublicCertDNSConfigRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerPublicCertDNSConfigRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	element, err := getSecretsManagerConfigElement(d, meta, secretsManagerPublicCertSecretType, secretsManagerDNSProvidersConfig)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if element == nil {
+		return nil
+	}
+
+	d.Set("type", element.Type)
+	for _, attribute := range []string{"cis_crn", "cis_apikey", "classic_infrastructure_username", "classic_infrastructure_password"} {
+		if v, ok := element.Config[attribute].(string); ok {
+			d.Set(attribute, v)
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerPublicCertDNSConfigUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChanges("cis_crn", "cis_apikey", "classic_infrastructure_username", "classic_infrastructure_password") {
+		element := secretsManagerConfigElement{
+			Type:   d.Get("type").(string),
+			Config: expandSecretsManagerPublicCertDNSConfig(d),
+		}
+		if err := updateSecretsManagerConfigElement(d, meta, secretsManagerPublicCertSecretType, secretsManagerDNSProvidersConfig, element); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSecretsManagerPublicCertDNSConfigRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerPublicCertDNSConfigDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := deleteSecretsManagerConfigElement(d, meta, secretsManagerPublicCertSecretType, secretsManagerDNSProvidersConfig); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func expandSecretsManagerPublicCertDNSConfig(d *schema.ResourceData) map[string]interface{} {
+	config := map[string]interface{}{}
+	for _, attribute := range []string{"cis_crn", "cis_apikey", "classic_infrastructure_username", "classic_infrastructure_password"} {
+		if v, ok := d.GetOk(attribute); ok {
+			config[attribute] = v.(string)
+		}
+	}
+	return config
+}
@@ -0,0 +1,54 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerPublicCertDNSConfig_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-dns-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_secrets_manager_public_cert_dns_config.dns"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMSecretsManagerConfigElementDestroy("ibm_secrets_manager_public_cert_dns_config", secretsManagerPublicCertSecretType, secretsManagerDNSProvidersConfig),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerPublicCertDNSConfigConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "type", "cis"),
+					resource.TestCheckResourceAttrPair(resourceName, "cis_crn", "data.ibm_cis.cis", "id"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoint_type"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerPublicCertDNSConfigConfig(name string) string {
+	return fmt.Sprintf(`
+		data "ibm_cis" "cis" {
+			name = "%s"
+		}
+
+		resource "ibm_secrets_manager_public_cert_dns_config" "dns" {
+			instance_id = "%s"
+			name        = "%s"
+			type        = "cis"
+			cis_crn     = data.ibm_cis.cis.id
+		}
+	`, cisInstance, secretsManagerInstanceID, name)
+}
@@ -0,0 +1,57 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerPublicCert_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-public-cert-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_secrets_manager_public_cert.cert"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMSecretsManagerSecretDestroy("ibm_secrets_manager_public_cert", secretsManagerPublicCertSecretType),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerPublicCertConfig(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "common_name", secretsManagerPublicCertCommonName),
+					resource.TestCheckResourceAttr(resourceName, "state", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rotation.0.auto_rotate", "true"),
+					resource.TestCheckResourceAttrSet(resourceName, "certificate"),
+					resource.TestCheckResourceAttrSet(resourceName, "private_key"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSecretsManagerPublicCertConfig(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation.0.rotate_keys", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerPublicCertConfig(name string, rotateKeys bool) string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_public_cert" "cert" {
+			instance_id = "%s"
+			name        = "%s"
+			common_name = "%s"
+			ca          = "%s"
+			dns         = "%s"
+			rotation {
+				auto_rotate = true
+				rotate_keys = %t
+			}
+		}
+	`, secretsManagerInstanceID, name, secretsManagerPublicCertCommonName, secretsManagerPublicCertCA, secretsManagerPublicCertDNS, rotateKeys)
+}
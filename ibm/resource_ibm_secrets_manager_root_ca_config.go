@@ -0,0 +1,295 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const (
+	secretsManagerPrivateCertSecretType              = "private_cert"
+	secretsManagerRootCertificateAuthorities         = "root_certificate_authorities"
+	secretsManagerIntermediateCertificateAuthorities = "intermediate_certificate_authorities"
+)
+
+func resourceIBMSecretsManagerRootCAConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerRootCAConfigCreate,
+		ReadContext:   resourceIBMSecretsManagerRootCAConfigRead,
+		UpdateContext: resourceIBMSecretsManagerRootCAConfigUpdate,
+		DeleteContext: resourceIBMSecretsManagerRootCAConfigDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: secretsManagerPrivateCAConfigSchema("ibm_secrets_manager_root_ca_config", nil),
+	}
+}
+
+func resourceIBMSecretsManagerRootCAConfigValidator() *ResourceValidator {
+	return secretsManagerPrivateCAConfigValidator("ibm_secrets_manager_root_ca_config")
+}
+
+func resourceIBMSecretsManagerRootCAConfigCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	element := secretsManagerConfigElement{
+		Name:   d.Get("name").(string),
+		Type:   secretsManagerRootCertificateAuthorities,
+		Config: expandSecretsManagerPrivateCAConfig(d),
+	}
+	if _, err := createSecretsManagerConfigElement(d, meta, secretsManagerPrivateCertSecretType, secretsManagerRootCertificateAuthorities, element); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMSecretsManagerRootCAConfigRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerRootCAConfigRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	element, err := getSecretsManagerConfigElement(d, meta, secretsManagerPrivateCertSecretType, secretsManagerRootCertificateAuthorities)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if element == nil {
+		return nil
+	}
+
+	flattenSecretsManagerPrivateCAConfig(d, element.Config)
+
+	return nil
+}
+
+func resourceIBMSecretsManagerRootCAConfigUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChanges("max_ttl", "crl_expiry", "crl_disable") {
+		element := secretsManagerConfigElement{
+			Type:   secretsManagerRootCertificateAuthorities,
+			Config: expandSecretsManagerPrivateCAConfigUpdate(d),
+		}
+		if err := updateSecretsManagerConfigElement(d, meta, secretsManagerPrivateCertSecretType, secretsManagerRootCertificateAuthorities, element); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSecretsManagerRootCAConfigRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerRootCAConfigDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := deleteSecretsManagerConfigElement(d, meta, secretsManagerPrivateCertSecretType, secretsManagerRootCertificateAuthorities); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// secretsManagerPrivateCAConfigSchema returns the schema shared by the root
+// and the intermediate certificate authorities
+func secretsManagerPrivateCAConfigSchema(resourceName string, typeSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	caSchema := map[string]*schema.Schema{
+		"instance_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Secrets Manager instance GUID",
+		},
+		"endpoint_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "public",
+			ValidateFunc: InvokeValidator(resourceName, "endpoint_type"),
+			Description:  "Endpoint Type. 'public' or 'private'",
+		},
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: InvokeValidator(resourceName, "name"),
+			Description:  "A human-readable unique name to assign to the certificate authority configuration.",
+		},
+		"common_name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The fully qualified domain name or host domain name of the certificate authority.",
+		},
+		"alt_names": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The alternative names of the certificate authority.",
+		},
+		"max_ttl": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The maximum time-to-live (TTL) of the certificates that are signed by the certificate authority. The value is either a number of seconds or a duration, such as `8760h`.",
+		},
+		"ttl": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			ForceNew:    true,
+			Description: "The time-to-live (TTL) of the certificate authority certificate. The value is either a number of seconds or a duration, such as `8760h`.",
+		},
+		"crl_expiry": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The time until the certificate revocation list (CRL) expires. The value is either a number of seconds or a duration, such as `72h`.",
+		},
+		"crl_disable": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Default:     false,
+			Description: "Disables or enables certificate revocation list (CRL) building.",
+		},
+		"key_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			Default:      "rsa",
+			ValidateFunc: InvokeValidator(resourceName, "key_type"),
+			Description:  "The type of private key to generate: rsa, ec.",
+		},
+		"key_bits": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "The number of bits of the private key, 2048 or 4096 for rsa keys, 224, 256, 384 or 521 for ec keys.",
+		},
+		"organization": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The Organization (O) values of the subject field of the certificate authority.",
+		},
+		"ou": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The Organizational Unit (OU) values of the subject field of the certificate authority.",
+		},
+		"country": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			ForceNew:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The Country (C) values of the subject field of the certificate authority.",
+		},
+		"status": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The status of the certificate authority.",
+		},
+		"expiration_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date the certificate authority certificate expires. The date format follows RFC 3339.",
+		},
+	}
+	for k, v := range typeSchema {
+		caSchema[k] = v
+	}
+	return caSchema
+}
+
+func secretsManagerPrivateCAConfigValidator(resourceName string) *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Required:                   true,
+			Regexp:                     `^[A-Za-z0-9][A-Za-z0-9_.-]*$`,
+			MinValueLength:             2,
+			MaxValueLength:             128,
+		},
+		ValidateSchema{
+			Identifier:                 "key_type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "rsa, ec",
+		},
+		ValidateSchema{
+			Identifier:                 "signing_method",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "internal, external",
+		},
+		ValidateSchema{
+			Identifier:                 "endpoint_type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "public, private",
+		},
+	)
+
+	resourceValidator := ResourceValidator{ResourceName: resourceName, Schema: validateSchema}
+	return &resourceValidator
+}
+
+func expandSecretsManagerPrivateCAConfig(d *schema.ResourceData) map[string]interface{} {
+	config := expandSecretsManagerPrivateCAConfigUpdate(d)
+	config["common_name"] = d.Get("common_name").(string)
+	config["key_type"] = d.Get("key_type").(string)
+	if v, ok := d.GetOk("ttl"); ok {
+		config["ttl"] = v.(string)
+	}
+	if v, ok := d.GetOk("key_bits"); ok {
+		config["key_bits"] = v.(int)
+	}
+	for _, attribute := range []string{"alt_names", "organization", "ou", "country"} {
+		if v, ok := d.GetOk(attribute); ok {
+			config[attribute] = expandStringList(v.([]interface{}))
+		}
+	}
+	return config
+}
+
+// expandSecretsManagerPrivateCAConfigUpdate returns the configuration that
+// can be updated once the certificate authority is created
+func expandSecretsManagerPrivateCAConfigUpdate(d *schema.ResourceData) map[string]interface{} {
+	config := map[string]interface{}{
+		"max_ttl":     d.Get("max_ttl").(string),
+		"crl_disable": d.Get("crl_disable").(bool),
+	}
+	if v, ok := d.GetOk("crl_expiry"); ok {
+		config["crl_expiry"] = v.(string)
+	}
+	return config
+}
+
+func flattenSecretsManagerPrivateCAConfig(d *schema.ResourceData, config map[string]interface{}) {
+	if commonName, ok := config["common_name"].(string); ok {
+		d.Set("common_name", commonName)
+	}
+	if keyType, ok := config["key_type"].(string); ok {
+		d.Set("key_type", keyType)
+	}
+	if keyBits, ok := config["key_bits"].(float64); ok {
+		d.Set("key_bits", int(keyBits))
+	}
+	if crlDisable, ok := config["crl_disable"].(bool); ok {
+		d.Set("crl_disable", crlDisable)
+	}
+	// The durations are returned in seconds, keep the durations of the configuration
+	for _, attribute := range []string{"max_ttl", "crl_expiry"} {
+		if v := flattenSecretsManagerTTL(config[attribute]); v != "" {
+			if seconds, err := secretsManagerTTLSeconds(d.Get(attribute).(string)); err != nil || strconv.FormatInt(seconds, 10) != v {
+				d.Set(attribute, v)
+			}
+		}
+	}
+	if status, ok := config["status"].(string); ok {
+		d.Set("status", status)
+	}
+	if expirationDate, ok := config["expiration_date"].(string); ok {
+		d.Set("expiration_date", expirationDate)
+	}
+}
@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerRootCAConfig_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-root-ca-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_secrets_manager_root_ca_config.root"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMSecretsManagerConfigElementDestroy("ibm_secrets_manager_root_ca_config", secretsManagerPrivateCertSecretType, secretsManagerRootCertificateAuthorities),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerRootCAConfigConfig(name, "8760h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "common_name", "terraform.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "max_ttl", "8760h"),
+					resource.TestCheckResourceAttr(resourceName, "status", "configured"),
+					resource.TestCheckResourceAttrSet(resourceName, "expiration_date"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSecretsManagerRootCAConfigConfig(name, "4380h"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "max_ttl", "4380h"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoint_type", "max_ttl", "ttl", "alt_names", "organization", "ou", "country"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerRootCAConfigConfig(name, maxTTL string) string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_root_ca_config" "root" {
+			instance_id  = "%s"
+			name         = "%s"
+			common_name  = "terraform.example.com"
+			max_ttl      = "%s"
+			ttl          = "8760h"
+			organization = ["Terraform"]
+		}
+	`, secretsManagerInstanceID, name, maxTTL)
+}
@@ -0,0 +1,218 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func resourceIBMSecretsManagerSecretGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerSecretGroupCreate,
+		ReadContext:   resourceIBMSecretsManagerSecretGroupRead,
+		UpdateContext: resourceIBMSecretsManagerSecretGroupUpdate,
+		DeleteContext: resourceIBMSecretsManagerSecretGroupDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Secrets Manager instance GUID",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "public",
+				ValidateFunc: InvokeValidator("ibm_secrets_manager_secret_group", "endpoint_type"),
+				Description:  "Endpoint Type. 'public' or 'private'",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: InvokeValidator("ibm_secrets_manager_secret_group", "name"),
+				Description:  "A human-readable name to assign to your secret group.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An extended description of your secret group.",
+			},
+			"secret_group_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The v4 UUID that uniquely identifies the secret group.",
+			},
+			"creation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the secret group was created. The date format follows RFC 3339.",
+			},
+			"last_update_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the secret group was last updated. The date format follows RFC 3339.",
+			},
+		},
+	}
+}
+
+func resourceIBMSecretsManagerSecretGroupValidator() *ResourceValidator {
+	validateSchema := make([]ValidateSchema, 1)
+	validateSchema = append(validateSchema,
+		ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: ValidateRegexpLen,
+			Type:                       TypeString,
+			Required:                   true,
+			Regexp:                     `^[A-Za-z0-9][A-Za-z0-9_.-]*$`,
+			MinValueLength:             2,
+			MaxValueLength:             64,
+		},
+		ValidateSchema{
+			Identifier:                 "endpoint_type",
+			ValidateFunctionIdentifier: ValidateAllowedStringValue,
+			Type:                       TypeString,
+			Optional:                   true,
+			AllowedValues:              "public, private",
+		},
+	)
+
+	resourceValidator := ResourceValidator{ResourceName: "ibm_secrets_manager_secret_group", Schema: validateSchema}
+	return &resourceValidator
+}
+
+func resourceIBMSecretsManagerSecretGroupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID := d.Get("instance_id").(string)
+	secretsManagerClient, err := secretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	group := secretsmanagerv1.SecretGroupResource{
+		Name: core.StringPtr(d.Get("name").(string)),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		group.Description = core.StringPtr(v.(string))
+	}
+	createSecretGroupOptions := &secretsmanagerv1.CreateSecretGroupOptions{
+		Metadata: &secretsmanagerv1.CollectionMetadata{
+			CollectionType:  core.StringPtr(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretGroupJSONConst),
+			CollectionTotal: core.Int64Ptr(1),
+		},
+		Resources: []secretsmanagerv1.SecretGroupResource{group},
+	}
+
+	secretGroup, response, err := secretsManagerClient.CreateSecretGroupWithContext(context, createSecretGroupOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretGroupWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error creating secret group %s: %s", d.Get("name").(string), err))
+	}
+	if len(secretGroup.Resources) == 0 || secretGroup.Resources[0].ID == nil {
+		return diag.FromErr(fmt.Errorf("Error creating secret group %s: empty response", d.Get("name").(string)))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, *secretGroup.Resources[0].ID))
+
+	return resourceIBMSecretsManagerSecretGroupRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerSecretGroupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, instanceID, groupID, err := secretsManagerSecretClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretGroup, response, err := secretsManagerClient.GetSecretGroupWithContext(context, &secretsmanagerv1.GetSecretGroupOptions{
+		ID: &groupID,
+	})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Secret group %s is not found, removing it from state", d.Id())
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetSecretGroupWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error retrieving secret group %s: %s", d.Id(), err))
+	}
+	if len(secretGroup.Resources) == 0 {
+		return diag.FromErr(fmt.Errorf("Error retrieving secret group %s: empty response", d.Id()))
+	}
+	group := secretGroup.Resources[0]
+
+	d.Set("instance_id", instanceID)
+	d.Set("secret_group_id", groupID)
+	if group.Name != nil {
+		d.Set("name", *group.Name)
+	}
+	if group.Description != nil {
+		d.Set("description", *group.Description)
+	}
+	if group.CreationDate != nil {
+		d.Set("creation_date", group.CreationDate.String())
+	}
+	if group.LastUpdateDate != nil {
+		d.Set("last_update_date", group.LastUpdateDate.String())
+	}
+
+	return nil
+}
+
+func resourceIBMSecretsManagerSecretGroupUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChanges("name", "description") {
+		secretsManagerClient, _, groupID, err := secretsManagerSecretClient(d, meta)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		updateSecretGroupMetadataOptions := &secretsmanagerv1.UpdateSecretGroupMetadataOptions{
+			ID: &groupID,
+			Metadata: &secretsmanagerv1.CollectionMetadata{
+				CollectionType:  core.StringPtr(secretsmanagerv1.CollectionMetadataCollectionTypeApplicationVndIBMSecretsManagerSecretGroupJSONConst),
+				CollectionTotal: core.Int64Ptr(1),
+			},
+			Resources: []secretsmanagerv1.SecretGroupMetadataUpdatable{
+				{
+					Name:        core.StringPtr(d.Get("name").(string)),
+					Description: core.StringPtr(d.Get("description").(string)),
+				},
+			},
+		}
+		_, response, err := secretsManagerClient.UpdateSecretGroupMetadataWithContext(context, updateSecretGroupMetadataOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateSecretGroupMetadataWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("Error updating secret group %s: %s", d.Id(), err))
+		}
+	}
+
+	return resourceIBMSecretsManagerSecretGroupRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerSecretGroupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, _, groupID, err := secretsManagerSecretClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response, err := secretsManagerClient.DeleteSecretGroupWithContext(context, &secretsmanagerv1.DeleteSecretGroupOptions{
+		ID: &groupID,
+	})
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteSecretGroupWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("Error deleting secret group %s: %s", d.Id(), err))
+	}
+
+	d.SetId("")
+	return nil
+}
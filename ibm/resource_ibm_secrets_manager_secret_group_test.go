@@ -0,0 +1,113 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMSecretsManagerSecretGroup_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-group-%d", acctest.RandIntRange(10, 100))
+	updatedName := fmt.Sprintf("tf-group-updated-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_secrets_manager_secret_group.group"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMSecretsManagerSecretGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerSecretGroupConfig(name, "Secret group created by Terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "description", "Secret group created by Terraform"),
+					resource.TestCheckResourceAttrSet(resourceName, "secret_group_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_date"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSecretsManagerSecretGroupConfig(updatedName, "Secret group updated by Terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", updatedName),
+					resource.TestCheckResourceAttr(resourceName, "description", "Secret group updated by Terraform"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"endpoint_type"},
+			},
+		},
+	})
+}
+
+func TestAccIBMSecretsManagerSecretGroup_secret(t *testing.T) {
+	name := fmt.Sprintf("tf-group-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMSecretsManagerSecretGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerSecretGroupSecretConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("ibm_secrets_manager_arbitrary_secret.secret", "secret_group_id", "ibm_secrets_manager_secret_group.group", "secret_group_id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerSecretGroupConfig(name, description string) string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_secret_group" "group" {
+			instance_id = "%s"
+			name        = "%s"
+			description = "%s"
+		}
+	`, secretsManagerInstanceID, name, description)
+}
+
+func testAccCheckIBMSecretsManagerSecretGroupSecretConfig(name string) string {
+	return testAccCheckIBMSecretsManagerSecretGroupConfig(name, "Secret group created by Terraform") + fmt.Sprintf(`
+		resource "ibm_secrets_manager_arbitrary_secret" "secret" {
+			instance_id     = "%s"
+			name            = "%s"
+			secret_group_id = ibm_secrets_manager_secret_group.group.secret_group_id
+			payload         = "secret"
+		}
+	`, secretsManagerInstanceID, name)
+}
+
+func testAccCheckIBMSecretsManagerSecretGroupDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_secrets_manager_secret_group" {
+			continue
+		}
+		parts, err := idParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client, err := secretsManagerInstanceClient(testAccProvider.Meta(), parts[0], "public")
+		if err != nil {
+			return err
+		}
+		_, response, err := client.GetSecretGroup(&secretsmanagerv1.GetSecretGroupOptions{ID: &parts[1]})
+		if err == nil {
+			return fmt.Errorf("Secret group still exists: %s", rs.Primary.ID)
+		}
+		if response == nil || response.StatusCode != 404 {
+			return fmt.Errorf("Error checking if secret group (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+	return nil
+}
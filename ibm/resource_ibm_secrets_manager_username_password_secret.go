@@ -0,0 +1,193 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const secretsManagerUsernamePasswordSecretType = "username_password"
+
+func resourceIBMSecretsManagerUsernamePasswordSecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMSecretsManagerUsernamePasswordSecretCreate,
+		ReadContext:   resourceIBMSecretsManagerUsernamePasswordSecretRead,
+		UpdateContext: resourceIBMSecretsManagerUsernamePasswordSecretUpdate,
+		DeleteContext: resourceIBMSecretsManagerUsernamePasswordSecretDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: secretsManagerSecretSchema(map[string]*schema.Schema{
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The username to assign to this secret.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The password to assign to this secret. A new version of the secret is created when it changes. The password is generated when it is not set.",
+			},
+			"expiration_date": secretsManagerExpirationDateSchema(),
+			"rotation": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The policy that generates a new password periodically.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"auto_rotate": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "Whether the password is rotated automatically.",
+						},
+						"interval": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "The length of the rotation time interval.",
+						},
+						"unit": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validateAllowedStringValue([]string{"day", "month"}),
+							Description:  "The units of the rotation time interval: day, month.",
+						},
+					},
+				},
+			},
+			"next_rotation_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date that the secret is scheduled for automatic rotation.",
+			},
+		}),
+	}
+}
+
+func resourceIBMSecretsManagerUsernamePasswordSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret := expandSecretsManagerSecret(d)
+	secret.Username = d.Get("username").(string)
+	secret.Password = d.Get("password").(string)
+	secret.ExpirationDate = d.Get("expiration_date").(string)
+
+	if _, err := createSecretsManagerSecret(d, meta, secretsManagerUsernamePasswordSecretType, secret); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if rotation := expandSecretsManagerUsernamePasswordRotation(d.Get("rotation").([]interface{})); rotation != nil {
+		if err := putSecretsManagerRotationPolicy(d, meta, secretsManagerUsernamePasswordSecretType, rotation); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSecretsManagerUsernamePasswordSecretRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerUsernamePasswordSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secret, err := readSecretsManagerSecret(d, meta, secretsManagerUsernamePasswordSecretType, false)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if secret == nil {
+		return nil
+	}
+
+	d.Set("expiration_date", secret.ExpirationDate)
+	d.Set("next_rotation_date", secret.NextRotationDate)
+	if username, ok := secret.SecretData["username"].(string); ok {
+		d.Set("username", username)
+	}
+	if password, ok := secret.SecretData["password"].(string); ok {
+		d.Set("password", password)
+	}
+
+	rotation, err := getSecretsManagerRotationPolicy(d, meta, secretsManagerUsernamePasswordSecretType)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	// A disabled policy is the one left when the rotation block is removed
+	if rotation != nil && rotation.AutoRotate != nil && !*rotation.AutoRotate && len(d.Get("rotation").([]interface{})) == 0 {
+		rotation = nil
+	}
+	d.Set("rotation", flattenSecretsManagerUsernamePasswordRotation(rotation))
+
+	return nil
+}
+
+func resourceIBMSecretsManagerUsernamePasswordSecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	metadata := secretsManagerSecret{
+		ExpirationDate: d.Get("expiration_date").(string),
+	}
+	if err := updateSecretsManagerSecretMetadata(d, meta, secretsManagerUsernamePasswordSecretType, metadata, "expiration_date"); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChange("password") {
+		body := map[string]interface{}{
+			"password": d.Get("password").(string),
+		}
+		if err := rotateSecretsManagerSecret(d, meta, secretsManagerUsernamePasswordSecretType, body); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("rotation") {
+		rotation := expandSecretsManagerUsernamePasswordRotation(d.Get("rotation").([]interface{}))
+		if rotation == nil {
+			// Removing the policy stops the automatic rotation
+			autoRotate := false
+			rotation = &secretsManagerSecretRotation{AutoRotate: &autoRotate}
+		}
+		if err := putSecretsManagerRotationPolicy(d, meta, secretsManagerUsernamePasswordSecretType, rotation); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMSecretsManagerUsernamePasswordSecretRead(context, d, meta)
+}
+
+func resourceIBMSecretsManagerUsernamePasswordSecretDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := deleteSecretsManagerSecret(d, meta, secretsManagerUsernamePasswordSecretType); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+func expandSecretsManagerUsernamePasswordRotation(l []interface{}) *secretsManagerSecretRotation {
+	if len(l) == 0 || l[0] == nil {
+		return nil
+	}
+	rotation := l[0].(map[string]interface{})
+	autoRotate := rotation["auto_rotate"].(bool)
+	return &secretsManagerSecretRotation{
+		AutoRotate: &autoRotate,
+		Interval:   rotation["interval"].(int),
+		Unit:       rotation["unit"].(string),
+	}
+}
+
+func flattenSecretsManagerUsernamePasswordRotation(rotation *secretsManagerSecretRotation) []interface{} {
+	if rotation == nil || rotation.Interval == 0 {
+		return []interface{}{}
+	}
+	autoRotate := true
+	if rotation.AutoRotate != nil {
+		autoRotate = *rotation.AutoRotate
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"auto_rotate": autoRotate,
+			"interval":    rotation.Interval,
+			"unit":        rotation.Unit,
+		},
+	}
+}
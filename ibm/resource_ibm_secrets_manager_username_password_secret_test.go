@@ -0,0 +1,58 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMSecretsManagerUsernamePasswordSecret_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-userpass-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_secrets_manager_username_password_secret.secret"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckIBMSecretsManagerSecretDestroy("ibm_secrets_manager_username_password_secret", secretsManagerUsernamePasswordSecretType),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMSecretsManagerUsernamePasswordSecretConfig(name, "Passw0rd-v1", 30),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "username", "terraform"),
+					resource.TestCheckResourceAttr(resourceName, "password", "Passw0rd-v1"),
+					resource.TestCheckResourceAttr(resourceName, "rotation.0.interval", "30"),
+					resource.TestCheckResourceAttr(resourceName, "rotation.0.unit", "day"),
+					resource.TestCheckResourceAttrSet(resourceName, "next_rotation_date"),
+				),
+			},
+			{
+				Config: testAccCheckIBMSecretsManagerUsernamePasswordSecretConfig(name, "Passw0rd-v2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "password", "Passw0rd-v2"),
+					resource.TestCheckResourceAttr(resourceName, "rotation.0.interval", "2"),
+					resource.TestCheckResourceAttr(resourceName, "versions_total", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMSecretsManagerUsernamePasswordSecretConfig(name, password string, interval int) string {
+	return fmt.Sprintf(`
+		resource "ibm_secrets_manager_username_password_secret" "secret" {
+			instance_id = "%s"
+			name        = "%s"
+			username    = "terraform"
+			password    = "%s"
+			rotation {
+				interval = %d
+				unit     = "day"
+			}
+		}
+	`, secretsManagerInstanceID, name, password, interval)
+}
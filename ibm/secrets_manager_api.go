@@ -0,0 +1,669 @@
+// Copyright IBM Corp. 2017, 2021 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package ibm
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/secretsmanagerv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	secretsManagerSecretCollectionType = "application/vnd.ibm.secrets-manager.secret+json"
+	secretsManagerPolicyCollectionType = "application/vnd.ibm.secrets-manager.secret.policy+json"
+
+	secretsManagerSecretStatePreActivation = 0
+	secretsManagerSecretStateActive        = 1
+)
+
+type secretsManagerCollectionMetadata struct {
+	CollectionType  string `json:"collection_type"`
+	CollectionTotal int    `json:"collection_total"`
+}
+
+// secretsManagerSecretVersion is the metadata of a version of a secret
+type secretsManagerSecretVersion struct {
+	ID           string `json:"id,omitempty"`
+	CreationDate string `json:"creation_date,omitempty"`
+	CreatedBy    string `json:"created_by,omitempty"`
+	AutoRotated  bool   `json:"auto_rotated,omitempty"`
+}
+
+type secretsManagerIssuanceInfo struct {
+	State        *int   `json:"state,omitempty"`
+	ErrorCode    string `json:"error_code,omitempty"`
+	ErrorMessage string `json:"error_message,omitempty"`
+}
+
+type secretsManagerCertificateValidity struct {
+	NotBefore string `json:"not_before,omitempty"`
+	NotAfter  string `json:"not_after,omitempty"`
+}
+
+// secretsManagerSecret is a secret of any type of the Secrets Manager v1 API.
+// The pinned SDK only models the arbitrary, username_password and
+// iam_credentials secrets without custom metadata, so the secret resources
+// send their requests with the authenticator of the SDK client instead.
+type secretsManagerSecret struct {
+	ID                    string                             `json:"id,omitempty"`
+	Name                  string                             `json:"name,omitempty"`
+	Description           *string                            `json:"description,omitempty"`
+	SecretGroupID         string                             `json:"secret_group_id,omitempty"`
+	Labels                []string                           `json:"labels,omitempty"`
+	CustomMetadata        map[string]interface{}             `json:"custom_metadata,omitempty"`
+	VersionCustomMetadata map[string]interface{}             `json:"version_custom_metadata,omitempty"`
+	ExpirationDate        string                             `json:"expiration_date,omitempty"`
+	SecretType            string                             `json:"secret_type,omitempty"`
+	CRN                   string                             `json:"crn,omitempty"`
+	State                 *int                               `json:"state,omitempty"`
+	StateDescription      string                             `json:"state_description,omitempty"`
+	CreationDate          string                             `json:"creation_date,omitempty"`
+	CreatedBy             string                             `json:"created_by,omitempty"`
+	LastUpdateDate        string                             `json:"last_update_date,omitempty"`
+	VersionsTotal         int                                `json:"versions_total,omitempty"`
+	Versions              []secretsManagerSecretVersion      `json:"versions,omitempty"`
+	SecretData            map[string]interface{}             `json:"secret_data,omitempty"`
+	Payload               string                             `json:"payload,omitempty"`
+	Username              string                             `json:"username,omitempty"`
+	Password              string                             `json:"password,omitempty"`
+	NextRotationDate      string                             `json:"next_rotation_date,omitempty"`
+	TTL                   interface{}                        `json:"ttl,omitempty"`
+	AccessGroups          []string                           `json:"access_groups,omitempty"`
+	ServiceID             string                             `json:"service_id,omitempty"`
+	ReuseAPIKey           *bool                              `json:"reuse_api_key,omitempty"`
+	APIKey                string                             `json:"api_key,omitempty"`
+	Certificate           string                             `json:"certificate,omitempty"`
+	PrivateKey            string                             `json:"private_key,omitempty"`
+	Intermediate          string                             `json:"intermediate,omitempty"`
+	CommonName            string                             `json:"common_name,omitempty"`
+	AltNames              []string                           `json:"alt_names,omitempty"`
+	KeyAlgorithm          string                             `json:"key_algorithm,omitempty"`
+	CA                    string                             `json:"ca,omitempty"`
+	DNS                   string                             `json:"dns,omitempty"`
+	BundleCerts           *bool                              `json:"bundle_certs,omitempty"`
+	Rotation              *secretsManagerSecretRotation      `json:"rotation,omitempty"`
+	Issuer                string                             `json:"issuer,omitempty"`
+	SerialNumber          string                             `json:"serial_number,omitempty"`
+	Algorithm             string                             `json:"algorithm,omitempty"`
+	Validity              *secretsManagerCertificateValidity `json:"validity,omitempty"`
+	IntermediateIncluded  *bool                              `json:"intermediate_included,omitempty"`
+	PrivateKeyIncluded    *bool                              `json:"private_key_included,omitempty"`
+	IssuanceInfo          *secretsManagerIssuanceInfo        `json:"issuance_info,omitempty"`
+}
+
+type secretsManagerSecretCollection struct {
+	Metadata  secretsManagerCollectionMetadata `json:"metadata"`
+	Resources []secretsManagerSecret           `json:"resources"`
+}
+
+// secretsManagerSecretRotation is the rotation policy of a secret
+type secretsManagerSecretRotation struct {
+	AutoRotate *bool  `json:"auto_rotate,omitempty"`
+	RotateKeys *bool  `json:"rotate_keys,omitempty"`
+	Interval   int    `json:"interval,omitempty"`
+	Unit       string `json:"unit,omitempty"`
+}
+
+type secretsManagerPolicy struct {
+	Type     string                        `json:"type"`
+	Rotation *secretsManagerSecretRotation `json:"rotation"`
+}
+
+type secretsManagerPolicyCollection struct {
+	Metadata  secretsManagerCollectionMetadata `json:"metadata"`
+	Resources []secretsManagerPolicy           `json:"resources"`
+}
+
+// secretsManagerInstanceClient returns a copy of the Secrets Manager client
+// that targets the endpoint of the instance, so the shared client keeps its URL
+func secretsManagerInstanceClient(meta interface{}, instanceID, endpointType string) (*secretsmanagerv1.SecretsManagerV1, error) {
+	bluemixSession, err := meta.(ClientSession).BluemixSession()
+	if err != nil {
+		return nil, err
+	}
+	region := bluemixSession.Config.Region
+
+	secretsManagerClient, err := meta.(ClientSession).SecretsManagerV1()
+	if err != nil {
+		return nil, err
+	}
+	rContollerClient, err := meta.(ClientSession).ResourceControllerAPIV2()
+	if err != nil {
+		return nil, err
+	}
+
+	instanceData, err := rContollerClient.ResourceServiceInstanceV2().GetInstance(instanceID)
+	if err != nil {
+		return nil, err
+	}
+	crnData := strings.Split(instanceData.Crn.String(), ":")
+	if len(crnData) < 5 || crnData[4] != "secrets-manager" {
+		return nil, fmt.Errorf("Invalid or unsupported service Instance")
+	}
+
+	var smEndpointURL string
+	if endpointType == "private" {
+		smEndpointURL = "https://" + instanceID + ".private." + region + ".secrets-manager.appdomain.cloud"
+	} else {
+		smEndpointURL = "https://" + instanceID + "." + region + ".secrets-manager.appdomain.cloud"
+	}
+
+	client := secretsManagerClient.Clone()
+	err = client.SetServiceURL(envFallBack([]string{"IBMCLOUD_SECRETS_MANAGER_API_ENDPOINT"}, smEndpointURL))
+	if err != nil {
+		return nil, err
+	}
+	return client, nil
+}
+
+// secretsManagerRequest sends a request to the Secrets Manager v1 API of the
+// instance targeted by the client, decoding the response into result
+func secretsManagerRequest(client *secretsmanagerv1.SecretsManagerV1, method, path string, query map[string]string, body interface{}, result interface{}) (*core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	_, err := builder.ResolveRequestURL(client.Service.Options.URL, path, nil)
+	if err != nil {
+		return nil, err
+	}
+	builder.AddHeader("Accept", "application/json")
+	for k, v := range query {
+		builder.AddQuery(k, v)
+	}
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		_, err = builder.SetBodyContentJSON(body)
+		if err != nil {
+			return nil, err
+		}
+	}
+	request, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return client.Service.Request(request, result)
+}
+
+// secretsManagerSecretSchema returns the schema shared by the secret
+// resources merged with the schema of the secret type
+func secretsManagerSecretSchema(typeSchema map[string]*schema.Schema) map[string]*schema.Schema {
+	secretSchema := map[string]*schema.Schema{
+		"instance_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "Secrets Manager instance GUID",
+		},
+		"endpoint_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "public",
+			ValidateFunc: validateAllowedStringValue([]string{"public", "private"}),
+			Description:  "Endpoint Type. 'public' or 'private'",
+		},
+		"secret_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The v4 UUID that uniquely identifies the secret.",
+		},
+		"name": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "A human-readable alias to assign to your secret.",
+		},
+		"description": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "An extended description of your secret.",
+		},
+		"secret_group_id": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Computed:    true,
+			ForceNew:    true,
+			Description: "The v4 UUID that uniquely identifies the secret group to assign to this secret. If you omit this parameter, your secret is assigned to the `default` secret group.",
+		},
+		"labels": {
+			Type:        schema.TypeList,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "Labels that you can use to filter for secrets in your instance.",
+		},
+		"custom_metadata": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The custom metadata of the secret.",
+		},
+		"version_custom_metadata": {
+			Type:        schema.TypeMap,
+			Optional:    true,
+			Elem:        &schema.Schema{Type: schema.TypeString},
+			Description: "The custom metadata of the secret version, applied to the versions created by Terraform.",
+		},
+		"crn": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The Cloud Resource Name (CRN) that uniquely identifies your Secrets Manager resource.",
+		},
+		"state": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The secret state based on NIST SP 800-57. States are integers and correspond to the Pre-activation = 0, Active = 1,  Suspended = 2, Deactivated = 3, and Destroyed = 5 values.",
+		},
+		"state_description": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "A text representation of the secret state.",
+		},
+		"creation_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The date the secret was created. The date format follows RFC 3339.",
+		},
+		"last_update_date": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "Updates when the actual secret is modified. The date format follows RFC 3339.",
+		},
+		"versions_total": {
+			Type:        schema.TypeInt,
+			Computed:    true,
+			Description: "The number of versions of the secret.",
+		},
+		"versions": {
+			Type:        schema.TypeList,
+			Computed:    true,
+			Description: "An array that contains metadata for each secret version.",
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The ID of the secret version.",
+					},
+					"creation_date": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The date that the version of the secret was created.",
+					},
+					"created_by": {
+						Type:        schema.TypeString,
+						Computed:    true,
+						Description: "The unique identifier for the entity that created the secret version.",
+					},
+					"auto_rotated": {
+						Type:        schema.TypeBool,
+						Computed:    true,
+						Description: "Indicates whether the version of the secret was created by automatic rotation.",
+					},
+				},
+			},
+		},
+	}
+	for k, v := range typeSchema {
+		secretSchema[k] = v
+	}
+	return secretSchema
+}
+
+// secretsManagerExpirationDateSchema is the expiration date of the secret
+// types that support it
+func secretsManagerExpirationDateSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeString,
+		Optional:         true,
+		ValidateFunc:     validation.IsRFC3339Time,
+		DiffSuppressFunc: suppressEquivalentRFC3339Time,
+		Description:      "The date the secret material expires. The date format follows RFC 3339.",
+	}
+}
+
+// expandSecretsManagerSecret returns the attributes shared by all the secret
+// types to create the secret
+func expandSecretsManagerSecret(d *schema.ResourceData) secretsManagerSecret {
+	secret := secretsManagerSecret{
+		Name:          d.Get("name").(string),
+		SecretGroupID: d.Get("secret_group_id").(string),
+		Labels:        expandStringList(d.Get("labels").([]interface{})),
+	}
+	if v, ok := d.GetOk("description"); ok {
+		description := v.(string)
+		secret.Description = &description
+	}
+	if v, ok := d.GetOk("custom_metadata"); ok {
+		secret.CustomMetadata = v.(map[string]interface{})
+	}
+	if v, ok := d.GetOk("version_custom_metadata"); ok {
+		secret.VersionCustomMetadata = v.(map[string]interface{})
+	}
+	return secret
+}
+
+func secretsManagerSecretClient(d *schema.ResourceData, meta interface{}) (*secretsmanagerv1.SecretsManagerV1, string, string, error) {
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return nil, "", "", err
+	}
+	if len(parts) < 2 {
+		return nil, "", "", fmt.Errorf("Incorrect ID %s: ID should be a combination of instanceID/secretID", d.Id())
+	}
+	client, err := secretsManagerInstanceClient(meta, parts[0], d.Get("endpoint_type").(string))
+	if err != nil {
+		return nil, "", "", err
+	}
+	return client, parts[0], parts[1], nil
+}
+
+// createSecretsManagerSecret creates the secret and sets the ID of the resource
+func createSecretsManagerSecret(d *schema.ResourceData, meta interface{}, secretType string, secret secretsManagerSecret) (*secretsManagerSecret, error) {
+	instanceID := d.Get("instance_id").(string)
+	client, err := secretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	body := secretsManagerSecretCollection{
+		Metadata: secretsManagerCollectionMetadata{
+			CollectionType:  secretsManagerSecretCollectionType,
+			CollectionTotal: 1,
+		},
+		Resources: []secretsManagerSecret{secret},
+	}
+	result := &secretsManagerSecretCollection{}
+	response, err := secretsManagerRequest(client, core.POST, fmt.Sprintf("/api/v1/secrets/%s", secretType), nil, body, result)
+	if err != nil {
+		log.Printf("[DEBUG] Create %s secret failed %s\n%s", secretType, err, response)
+		return nil, fmt.Errorf("Error creating %s secret %s: %s", secretType, secret.Name, err)
+	}
+	if len(result.Resources) == 0 {
+		return nil, fmt.Errorf("Error creating %s secret %s: empty response", secretType, secret.Name)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, result.Resources[0].ID))
+	return &result.Resources[0], nil
+}
+
+// readSecretsManagerSecret gets the secret and sets the attributes shared by
+// all the secret types. It returns nil when the secret no longer exists.
+// Only the metadata is read when metadataOnly is set, for the secret types
+// whose payload is generated on each read.
+func readSecretsManagerSecret(d *schema.ResourceData, meta interface{}, secretType string, metadataOnly bool) (*secretsManagerSecret, error) {
+	client, instanceID, secretID, err := secretsManagerSecretClient(d, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	path := fmt.Sprintf("/api/v1/secrets/%s/%s", secretType, secretID)
+	if metadataOnly {
+		path = path + "/metadata"
+	}
+	result := &secretsManagerSecretCollection{}
+	response, err := secretsManagerRequest(client, core.GET, path, nil, nil, result)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] %s secret %s is not found, removing it from state", secretType, d.Id())
+			d.SetId("")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error retrieving %s secret %s: %s", secretType, d.Id(), err)
+	}
+	if len(result.Resources) == 0 {
+		return nil, fmt.Errorf("Error retrieving %s secret %s: empty response", secretType, d.Id())
+	}
+	secret := &result.Resources[0]
+
+	d.Set("instance_id", instanceID)
+	d.Set("secret_id", secret.ID)
+	d.Set("name", secret.Name)
+	if secret.Description != nil {
+		d.Set("description", *secret.Description)
+	}
+	d.Set("secret_group_id", secret.SecretGroupID)
+	d.Set("labels", flattenStringList(secret.Labels))
+	d.Set("custom_metadata", flattenMapInterfaceVal(secret.CustomMetadata))
+	d.Set("crn", secret.CRN)
+	if secret.State != nil {
+		d.Set("state", *secret.State)
+	}
+	d.Set("state_description", secret.StateDescription)
+	d.Set("creation_date", secret.CreationDate)
+	d.Set("last_update_date", secret.LastUpdateDate)
+	d.Set("versions_total", secret.VersionsTotal)
+	versions := make([]map[string]interface{}, 0, len(secret.Versions))
+	for _, version := range secret.Versions {
+		versions = append(versions, map[string]interface{}{
+			"id":            version.ID,
+			"creation_date": version.CreationDate,
+			"created_by":    version.CreatedBy,
+			"auto_rotated":  version.AutoRotated,
+		})
+	}
+	d.Set("versions", versions)
+
+	return secret, nil
+}
+
+// updateSecretsManagerSecretMetadata updates the metadata of the secret when
+// one of the shared attributes or of the attributes of the secret type
+// changed. The secret holds the metadata of the secret type.
+func updateSecretsManagerSecretMetadata(d *schema.ResourceData, meta interface{}, secretType string, secret secretsManagerSecret, attributes ...string) error {
+	attributes = append(attributes, "name", "description", "labels", "custom_metadata")
+	if !d.HasChanges(attributes...) {
+		return nil
+	}
+	client, _, secretID, err := secretsManagerSecretClient(d, meta)
+	if err != nil {
+		return err
+	}
+
+	description := d.Get("description").(string)
+	secret.Name = d.Get("name").(string)
+	secret.Description = &description
+	secret.Labels = expandStringList(d.Get("labels").([]interface{}))
+	secret.CustomMetadata = d.Get("custom_metadata").(map[string]interface{})
+	body := secretsManagerSecretCollection{
+		Metadata: secretsManagerCollectionMetadata{
+			CollectionType:  secretsManagerSecretCollectionType,
+			CollectionTotal: 1,
+		},
+		Resources: []secretsManagerSecret{secret},
+	}
+	response, err := secretsManagerRequest(client, core.PUT, fmt.Sprintf("/api/v1/secrets/%s/%s/metadata", secretType, secretID), nil, body, nil)
+	if err != nil {
+		log.Printf("[DEBUG] Update %s secret metadata failed %s\n%s", secretType, err, response)
+		return fmt.Errorf("Error updating %s secret %s: %s", secretType, d.Id(), err)
+	}
+	return nil
+}
+
+// rotateSecretsManagerSecret creates a new version of the secret with the
+// secret data of the body
+func rotateSecretsManagerSecret(d *schema.ResourceData, meta interface{}, secretType string, body map[string]interface{}) error {
+	client, _, secretID, err := secretsManagerSecretClient(d, meta)
+	if err != nil {
+		return err
+	}
+	if v, ok := d.GetOk("version_custom_metadata"); ok {
+		body["version_custom_metadata"] = v.(map[string]interface{})
+	}
+	response, err := secretsManagerRequest(client, core.POST, fmt.Sprintf("/api/v1/secrets/%s/%s", secretType, secretID), map[string]string{"action": "rotate"}, body, nil)
+	if err != nil {
+		log.Printf("[DEBUG] Rotate %s secret failed %s\n%s", secretType, err, response)
+		return fmt.Errorf("Error rotating %s secret %s: %s", secretType, d.Id(), err)
+	}
+	return nil
+}
+
+// putSecretsManagerRotationPolicy sets the rotation policy of the secret
+func putSecretsManagerRotationPolicy(d *schema.ResourceData, meta interface{}, secretType string, rotation *secretsManagerSecretRotation) error {
+	client, _, secretID, err := secretsManagerSecretClient(d, meta)
+	if err != nil {
+		return err
+	}
+	body := secretsManagerPolicyCollection{
+		Metadata: secretsManagerCollectionMetadata{
+			CollectionType:  secretsManagerPolicyCollectionType,
+			CollectionTotal: 1,
+		},
+		Resources: []secretsManagerPolicy{
+			{
+				Type:     secretsManagerPolicyCollectionType,
+				Rotation: rotation,
+			},
+		},
+	}
+	response, err := secretsManagerRequest(client, core.PUT, fmt.Sprintf("/api/v1/secrets/%s/%s/policies", secretType, secretID), map[string]string{"policy": "rotation"}, body, nil)
+	if err != nil {
+		log.Printf("[DEBUG] Put %s secret rotation policy failed %s\n%s", secretType, err, response)
+		return fmt.Errorf("Error setting the rotation policy of %s secret %s: %s", secretType, d.Id(), err)
+	}
+	return nil
+}
+
+// getSecretsManagerRotationPolicy returns the rotation policy of the secret,
+// nil when it has none
+func getSecretsManagerRotationPolicy(d *schema.ResourceData, meta interface{}, secretType string) (*secretsManagerSecretRotation, error) {
+	client, _, secretID, err := secretsManagerSecretClient(d, meta)
+	if err != nil {
+		return nil, err
+	}
+	result := &secretsManagerPolicyCollection{}
+	_, err = secretsManagerRequest(client, core.GET, fmt.Sprintf("/api/v1/secrets/%s/%s/policies", secretType, secretID), map[string]string{"policy": "rotation"}, nil, result)
+	if err != nil {
+		return nil, fmt.Errorf("Error retrieving the rotation policy of %s secret %s: %s", secretType, d.Id(), err)
+	}
+	if len(result.Resources) == 0 {
+		return nil, nil
+	}
+	return result.Resources[0].Rotation, nil
+}
+
+func deleteSecretsManagerSecret(d *schema.ResourceData, meta interface{}, secretType string) error {
+	client, _, secretID, err := secretsManagerSecretClient(d, meta)
+	if err != nil {
+		return err
+	}
+	response, err := secretsManagerRequest(client, core.DELETE, fmt.Sprintf("/api/v1/secrets/%s/%s", secretType, secretID), nil, nil, nil)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] Delete %s secret failed %s\n%s", secretType, err, response)
+		return fmt.Errorf("Error deleting %s secret %s: %s", secretType, d.Id(), err)
+	}
+	d.SetId("")
+	return nil
+}
+
+// secretsManagerConfigElement is a configuration of a secrets engine, such as
+// a certificate authority or a DNS provider of the public certificates
+type secretsManagerConfigElement struct {
+	Name   string                 `json:"name,omitempty"`
+	Type   string                 `json:"type,omitempty"`
+	Config map[string]interface{} `json:"config,omitempty"`
+}
+
+type secretsManagerConfigElementCollection struct {
+	Metadata  secretsManagerCollectionMetadata `json:"metadata"`
+	Resources []secretsManagerConfigElement    `json:"resources"`
+}
+
+// secretsManagerConfigElementClient returns the client of the instance and
+// the name of the configuration of a resource whose ID is instanceID/name
+func secretsManagerConfigElementClient(d *schema.ResourceData, meta interface{}) (*secretsmanagerv1.SecretsManagerV1, string, string, error) {
+	parts, err := idParts(d.Id())
+	if err != nil {
+		return nil, "", "", err
+	}
+	if len(parts) < 2 {
+		return nil, "", "", fmt.Errorf("Incorrect ID %s: ID should be a combination of instanceID/name", d.Id())
+	}
+	client, err := secretsManagerInstanceClient(meta, parts[0], d.Get("endpoint_type").(string))
+	if err != nil {
+		return nil, "", "", err
+	}
+	return client, parts[0], parts[1], nil
+}
+
+// createSecretsManagerConfigElement creates the configuration of the secret
+// type and sets the ID of the resource
+func createSecretsManagerConfigElement(d *schema.ResourceData, meta interface{}, secretType, configElement string, element secretsManagerConfigElement) (*secretsManagerConfigElement, error) {
+	instanceID := d.Get("instance_id").(string)
+	client, err := secretsManagerInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return nil, err
+	}
+
+	result := &secretsManagerConfigElementCollection{}
+	response, err := secretsManagerRequest(client, core.POST, fmt.Sprintf("/api/v1/config/%s/%s", secretType, configElement), nil, element, result)
+	if err != nil {
+		log.Printf("[DEBUG] Create %s %s config failed %s\n%s", secretType, configElement, err, response)
+		return nil, fmt.Errorf("Error creating %s configuration %s: %s", configElement, element.Name, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceID, element.Name))
+	if len(result.Resources) == 0 {
+		return &element, nil
+	}
+	return &result.Resources[0], nil
+}
+
+// getSecretsManagerConfigElement gets the configuration of the secret type.
+// It returns nil when the configuration no longer exists.
+func getSecretsManagerConfigElement(d *schema.ResourceData, meta interface{}, secretType, configElement string) (*secretsManagerConfigElement, error) {
+	client, instanceID, name, err := secretsManagerConfigElementClient(d, meta)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &secretsManagerConfigElementCollection{}
+	response, err := secretsManagerRequest(client, core.GET, fmt.Sprintf("/api/v1/config/%s/%s/%s", secretType, configElement, name), nil, nil, result)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] %s configuration %s is not found, removing it from state", configElement, d.Id())
+			d.SetId("")
+			return nil, nil
+		}
+		return nil, fmt.Errorf("Error retrieving %s configuration %s: %s", configElement, d.Id(), err)
+	}
+	if len(result.Resources) == 0 {
+		return nil, fmt.Errorf("Error retrieving %s configuration %s: empty response", configElement, d.Id())
+	}
+
+	d.Set("instance_id", instanceID)
+	d.Set("name", name)
+	return &result.Resources[0], nil
+}
+
+func updateSecretsManagerConfigElement(d *schema.ResourceData, meta interface{}, secretType, configElement string, element secretsManagerConfigElement) error {
+	client, _, name, err := secretsManagerConfigElementClient(d, meta)
+	if err != nil {
+		return err
+	}
+	response, err := secretsManagerRequest(client, core.PUT, fmt.Sprintf("/api/v1/config/%s/%s/%s", secretType, configElement, name), nil, element, nil)
+	if err != nil {
+		log.Printf("[DEBUG] Update %s %s config failed %s\n%s", secretType, configElement, err, response)
+		return fmt.Errorf("Error updating %s configuration %s: %s", configElement, d.Id(), err)
+	}
+	return nil
+}
+
+func deleteSecretsManagerConfigElement(d *schema.ResourceData, meta interface{}, secretType, configElement string) error {
+	client, _, name, err := secretsManagerConfigElementClient(d, meta)
+	if err != nil {
+		return err
+	}
+	response, err := secretsManagerRequest(client, core.DELETE, fmt.Sprintf("/api/v1/config/%s/%s/%s", secretType, configElement, name), nil, nil, nil)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] Delete %s %s config failed %s\n%s", secretType, configElement, err, response)
+		return fmt.Errorf("Error deleting %s configuration %s: %s", configElement, d.Id(), err)
+	}
+	d.SetId("")
+	return nil
+}
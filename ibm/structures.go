@@ -19,6 +19,7 @@ import (
 
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/ibm-cos-sdk-go-config/resourceconfigurationv1"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
 	kp "github.com/IBM/keyprotect-go-client"
 	"github.com/IBM/platform-services-go-sdk/globaltaggingv1"
@@ -780,6 +781,196 @@ func flattenCosObejctVersioning(in *s3.GetBucketVersioningOutput) []interface{}
 	return versioning
 }
 
+// lifecycleRulesGet splits the rules of the lifecycle configuration between
+// archive_rule, expire_rule and lifecycle_rule. The rules with the ID of a
+// rule of lifecycleList stay in lifecycle_rule, the others go to archive_rule
+// and expire_rule when they fit, so imported buckets keep using them.
+func lifecycleRulesGet(in *cosLifecycleConfiguration, lifecycleList []interface{}) ([]interface{}, []interface{}, []interface{}) {
+	lifecycleIDs := make(map[string]bool)
+	for _, l := range lifecycleList {
+		if ruleMap, ok := l.(map[string]interface{}); ok {
+			lifecycleIDs[ruleMap["rule_id"].(string)] = true
+		}
+	}
+	var archiveRules, expireRules []*s3.LifecycleRule
+	var lifecycleRules []*cosLifecycleRule
+	if in != nil {
+		for _, r := range in.Rules {
+			switch {
+			case lifecycleIDs[aws.StringValue(r.ID)]:
+				lifecycleRules = append(lifecycleRules, r)
+			case r.isArchiveRule() && len(archiveRules) == 0:
+				// archive_rule has a single rule
+				archiveRules = append(archiveRules, r.s3LifecycleRule())
+			case r.isExpireRule():
+				expireRules = append(expireRules, r.s3LifecycleRule())
+			default:
+				lifecycleRules = append(lifecycleRules, r)
+			}
+		}
+	}
+	return archiveRuleGet(archiveRules), expireRuleGet(expireRules), lifecycleRuleGet(lifecycleRules)
+}
+
+func lifecycleRuleGet(in []*cosLifecycleRule) []interface{} {
+	rules := make([]interface{}, 0)
+	for _, r := range in {
+		rule := make(map[string]interface{})
+		rule["rule_id"] = aws.StringValue(r.ID)
+		rule["enable"] = aws.StringValue(r.Status) == "Enabled"
+		if r.Filter != nil {
+			rule["prefix"] = aws.StringValue(r.Filter.Prefix)
+		}
+		if r.Expiration != nil {
+			expiration := map[string]interface{}{
+				"days":                         int(aws.Int64Value(r.Expiration.Days)),
+				"expired_object_delete_marker": aws.BoolValue(r.Expiration.ExpiredObjectDeleteMarker),
+			}
+			if r.Expiration.Date != nil {
+				expiration["date"] = r.Expiration.Date.UTC().Format("2006-01-02")
+			}
+			rule["expiration"] = []interface{}{expiration}
+		}
+		for _, t := range r.Transitions {
+			transition := map[string]interface{}{
+				"days":          int(aws.Int64Value(t.Days)),
+				"storage_class": aws.StringValue(t.StorageClass),
+			}
+			if t.Date != nil {
+				transition["date"] = t.Date.UTC().Format("2006-01-02")
+			}
+			rule["transition"] = []interface{}{transition}
+		}
+		if r.NoncurrentVersionExpiration != nil {
+			rule["noncurrent_version_expiration"] = []interface{}{
+				map[string]interface{}{
+					"noncurrent_days": int(aws.Int64Value(r.NoncurrentVersionExpiration.NoncurrentDays)),
+				},
+			}
+		}
+		if r.AbortIncompleteMultipartUpload != nil {
+			rule["abort_incomplete_multipart_upload_days"] = int(aws.Int64Value(r.AbortIncompleteMultipartUpload.DaysAfterInitiation))
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func replicationRuleGet(in *cosReplicationConfiguration) []interface{} {
+	rules := make([]interface{}, 0)
+	for _, r := range in.Rules {
+		rule := make(map[string]interface{})
+		rule["rule_id"] = aws.StringValue(r.ID)
+		rule["enable"] = aws.StringValue(r.Status) == "Enabled"
+		rule["priority"] = int(aws.Int64Value(r.Priority))
+		if r.Filter != nil {
+			rule["prefix"] = aws.StringValue(r.Filter.Prefix)
+		}
+		if r.Destination != nil {
+			rule["destination_bucket_crn"] = aws.StringValue(r.Destination.Bucket)
+		}
+		if r.DeleteMarkerReplication != nil {
+			rule["delete_marker_replication"] = aws.StringValue(r.DeleteMarkerReplication.Status) == "Enabled"
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// objectLockConfigurationGet returns the Object Lock configuration of the
+// bucket. A bucket without Object Lock is read as enable = false when the
+// configuration has a block, so that block has no diff.
+func objectLockConfigurationGet(in *cosObjectLockConfiguration, objectLockList []interface{}) []interface{} {
+	objectLock := make([]interface{}, 0, 1)
+	if aws.StringValue(in.ObjectLockEnabled) != "Enabled" {
+		if len(objectLockList) > 0 {
+			objectLock = append(objectLock, map[string]interface{}{
+				"enable": false,
+			})
+		}
+		return objectLock
+	}
+	att := map[string]interface{}{
+		"enable": true,
+	}
+	if in.Rule != nil && in.Rule.DefaultRetention != nil {
+		att["default_retention"] = []interface{}{
+			map[string]interface{}{
+				"mode":  aws.StringValue(in.Rule.DefaultRetention.Mode),
+				"days":  int(aws.Int64Value(in.Rule.DefaultRetention.Days)),
+				"years": int(aws.Int64Value(in.Rule.DefaultRetention.Years)),
+			},
+		}
+	}
+	objectLock = append(objectLock, att)
+	return objectLock
+}
+
+func corsRuleGet(in []*s3.CORSRule) []interface{} {
+	rules := make([]interface{}, 0, len(in))
+	for _, r := range in {
+		rule := make(map[string]interface{})
+		rule["allowed_headers"] = aws.StringValueSlice(r.AllowedHeaders)
+		rule["allowed_methods"] = aws.StringValueSlice(r.AllowedMethods)
+		rule["allowed_origins"] = aws.StringValueSlice(r.AllowedOrigins)
+		rule["expose_headers"] = aws.StringValueSlice(r.ExposeHeaders)
+		if r.MaxAgeSeconds != nil {
+			rule["max_age_seconds"] = int(*r.MaxAgeSeconds)
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func websiteGet(in *s3.GetBucketWebsiteOutput) []interface{} {
+	website := make([]interface{}, 0, 1)
+	if in == nil || (in.IndexDocument == nil && in.ErrorDocument == nil && in.RedirectAllRequestsTo == nil && len(in.RoutingRules) == 0) {
+		return website
+	}
+	att := make(map[string]interface{})
+	if in.IndexDocument != nil && in.IndexDocument.Suffix != nil {
+		att["index_document"] = *in.IndexDocument.Suffix
+	}
+	if in.ErrorDocument != nil && in.ErrorDocument.Key != nil {
+		att["error_document"] = *in.ErrorDocument.Key
+	}
+	if in.RedirectAllRequestsTo != nil {
+		att["redirect_all_requests_to"] = []interface{}{
+			map[string]interface{}{
+				"host_name": aws.StringValue(in.RedirectAllRequestsTo.HostName),
+				"protocol":  aws.StringValue(in.RedirectAllRequestsTo.Protocol),
+			},
+		}
+	}
+	routingRules := make([]interface{}, 0, len(in.RoutingRules))
+	for _, r := range in.RoutingRules {
+		rule := make(map[string]interface{})
+		if r.Condition != nil {
+			rule["condition"] = []interface{}{
+				map[string]interface{}{
+					"http_error_code_returned_equals": aws.StringValue(r.Condition.HttpErrorCodeReturnedEquals),
+					"key_prefix_equals":               aws.StringValue(r.Condition.KeyPrefixEquals),
+				},
+			}
+		}
+		if r.Redirect != nil {
+			rule["redirect"] = []interface{}{
+				map[string]interface{}{
+					"host_name":               aws.StringValue(r.Redirect.HostName),
+					"http_redirect_code":      aws.StringValue(r.Redirect.HttpRedirectCode),
+					"protocol":                aws.StringValue(r.Redirect.Protocol),
+					"replace_key_prefix_with": aws.StringValue(r.Redirect.ReplaceKeyPrefixWith),
+					"replace_key_with":        aws.StringValue(r.Redirect.ReplaceKeyWith),
+				},
+			}
+		}
+		routingRules = append(routingRules, rule)
+	}
+	att["routing_rule"] = routingRules
+	website = append(website, att)
+	return website
+}
+
 func flattenLimits(in *whisk.Limits) []interface{} {
 	att := make(map[string]interface{})
 	if in.Timeout != nil {
@@ -875,6 +1066,13 @@ func ptrToString(s string) *string {
 	return &s
 }
 
+func stringValue(s *string) (v string) {
+	if s != nil {
+		v = *s
+	}
+	return
+}
+
 func intValue(i64 *int64) (i int) {
 	if i64 != nil {
 		i = int(*i64)
@@ -2156,6 +2354,35 @@ func IgnoreSystemLabels(labels map[string]string) map[string]string {
 	return result
 }
 
+// expandWorkerPoolTaints converts the taints of a worker pool to the key to
+// value:effect map of the API
+func expandWorkerPoolTaints(taints []interface{}) map[string]string {
+	result := make(map[string]string)
+	for _, t := range taints {
+		taint := t.(map[string]interface{})
+		result[taint["key"].(string)] = fmt.Sprintf("%s:%s", taint["value"].(string), taint["effect"].(string))
+	}
+	return result
+}
+
+func flattenWorkerPoolTaints(taints map[string]string) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(taints))
+	for k, v := range taints {
+		value := ""
+		effect := v
+		if i := strings.LastIndex(v, ":"); i >= 0 {
+			value = v[:i]
+			effect = v[i+1:]
+		}
+		result = append(result, map[string]interface{}{
+			"key":    k,
+			"value":  value,
+			"effect": effect,
+		})
+	}
+	return result
+}
+
 // expandCosConfig ..
 func expandCosConfig(cos []interface{}) *kubernetesserviceapiv1.COSBucket {
 	if len(cos) == 0 || cos[0] == nil {
@@ -2205,6 +2432,24 @@ func flatterSatelliteZones(zones *schema.Set) []string {
 	return zoneList
 }
 
+// expandStorageClassParameters ..
+func expandStorageClassParameters(params []interface{}) []map[string]string {
+	result := make([]map[string]string, 0, len(params))
+	for _, p := range params {
+		result = append(result, expandStringMap(p))
+	}
+	return result
+}
+
+// flattenStorageClassParameters ..
+func flattenStorageClassParameters(params []map[string]string) []interface{} {
+	result := make([]interface{}, 0, len(params))
+	for _, p := range params {
+		result = append(result, p)
+	}
+	return result
+}
+
 // error object
 type ServiceErrorResponse struct {
 	Message    string
@@ -4,6 +4,7 @@
 package ibm
 
 import (
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -12,6 +13,7 @@ import (
 	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
@@ -768,6 +770,25 @@ func validateMinute(start, end int) func(v interface{}, k string) (ws []string,
 	return f
 }
 
+// validateCOSSSECustomerKey checks that an SSE-C key is a base64 encoded 256-bit key
+func validateCOSSSECustomerKey(v interface{}, k string) (ws []string, errors []error) {
+	key, err := base64.StdEncoding.DecodeString(v.(string))
+	if err != nil || len(key) != 32 {
+		errors = append(errors, fmt.Errorf(
+			"%q must be a base64 encoded 256-bit key", k))
+	}
+	return
+}
+
+func validateCOSLifecycleDate(v interface{}, k string) (ws []string, errors []error) {
+	value := v.(string)
+	if _, err := time.Parse("2006-01-02", value); err != nil {
+		errors = append(errors, fmt.Errorf(
+			"%q (%s) must be a date in the format YYYY-MM-DD", k, value))
+	}
+	return
+}
+
 func validateDatacenterOption(v []interface{}, allowedValues []string) error {
 	for _, option := range v {
 		if option == nil {